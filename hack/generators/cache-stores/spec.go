@@ -23,10 +23,19 @@ var supportedTypes = []cacheStoreSupportedType{
 		Type:    "Secret",
 		Package: "corev1",
 	},
+	{
+		Type:    "ConfigMap",
+		Package: "corev1",
+	},
 	{
 		Type:    "EndpointSlice",
 		Package: "discoveryv1",
 	},
+	{
+		Type:    "Namespace",
+		Package: "corev1",
+		KeyFunc: clusterWideKeyFunc,
+	},
 	// Gateway API types
 	{
 		Type:    "HTTPRoute",
@@ -56,6 +65,10 @@ var supportedTypes = []cacheStoreSupportedType{
 		Type:    "Gateway",
 		Package: "gatewayapi",
 	},
+	{
+		Type:    "BackendTLSPolicy",
+		Package: "gatewayapi",
+	},
 	// Kong types
 	{
 		Type:       "KongPlugin",
@@ -103,6 +116,10 @@ var supportedTypes = []cacheStoreSupportedType{
 		Type:    "KongServiceFacade",
 		Package: "incubatorv1alpha1",
 	},
+	{
+		Type:    "KongUpstreamTarget",
+		Package: "incubatorv1alpha1",
+	},
 	{
 		Type:    "KongVault",
 		Package: "kongv1alpha1",
@@ -33,15 +33,20 @@ const (
 // when you run `make controllers`.
 var inputControllersNeeded = &typesNeeded{
 	typeNeeded{
-		Group:                             "\"\"",
-		Version:                           "v1",
-		Kind:                              "Service",
-		PackageImportAlias:                "corev1",
-		PackageAlias:                      "CoreV1",
-		Package:                           corev1,
-		Plural:                            "services",
-		CacheType:                         "Service",
-		NeedsStatusPermissions:            true,
+		Group:                            "\"\"",
+		Version:                          "v1",
+		Kind:                             "Service",
+		PackageImportAlias:               "corev1",
+		PackageAlias:                     "CoreV1",
+		Package:                          corev1,
+		Plural:                           "services",
+		CacheType:                        "Service",
+		NeedsStatusPermissions:           true,
+		ConfigStatusNotificationsEnabled: true,
+		ProgrammedCondition: ProgrammedConditionConfiguration{
+			UpdatesEnabled:   true,
+			SkipUnreferenced: true,
+		},
 		AcceptsIngressClassNameAnnotation: false,
 		AcceptsIngressClassNameSpec:       false,
 		NeedsUpdateReferences:             true,
@@ -62,21 +67,38 @@ var inputControllersNeeded = &typesNeeded{
 		RBACVerbs:                         []string{"list", "watch"},
 	},
 	typeNeeded{
-		Group:                             "networking.k8s.io",
+		Group:                             "\"\"",
 		Version:                           "v1",
-		Kind:                              "Ingress",
-		PackageImportAlias:                "netv1",
-		PackageAlias:                      "NetV1",
-		Package:                           netv1,
-		Plural:                            "ingresses",
-		CacheType:                         "IngressV1",
-		NeedsStatusPermissions:            true,
-		ConfigStatusNotificationsEnabled:  true,
-		IngressAddressUpdatesEnabled:      true,
+		Kind:                              "Namespace",
+		PackageImportAlias:                "corev1",
+		PackageAlias:                      "CoreV1",
+		Package:                           corev1,
+		Plural:                            "namespaces",
+		CacheType:                         "Namespace",
+		NeedsStatusPermissions:            false,
+		AcceptsIngressClassNameAnnotation: false,
+		AcceptsIngressClassNameSpec:       false,
+		RBACVerbs:                         []string{"get", "list", "watch"},
+	},
+	typeNeeded{
+		Group:                            "networking.k8s.io",
+		Version:                          "v1",
+		Kind:                             "Ingress",
+		PackageImportAlias:               "netv1",
+		PackageAlias:                     "NetV1",
+		Package:                          netv1,
+		Plural:                           "ingresses",
+		CacheType:                        "IngressV1",
+		NeedsStatusPermissions:           true,
+		ConfigStatusNotificationsEnabled: true,
+		IngressAddressUpdatesEnabled:     true,
+		ProgrammedCondition: ProgrammedConditionConfiguration{
+			UseAnnotations: true,
+		},
 		AcceptsIngressClassNameAnnotation: true,
 		AcceptsIngressClassNameSpec:       true,
 		NeedsUpdateReferences:             true,
-		RBACVerbs:                         []string{"get", "list", "watch"},
+		RBACVerbs:                         []string{"get", "list", "watch", "update"},
 	},
 	typeNeeded{
 		Group:                             "networking.k8s.io",
@@ -116,9 +138,10 @@ var inputControllersNeeded = &typesNeeded{
 		Plural:                           "kongplugins",
 		CacheType:                        "Plugin",
 		NeedsStatusPermissions:           true,
-		ConfigStatusNotificationsEnabled: false, // TODO: https://github.com/Kong/kubernetes-ingress-controller/issues/4578
+		ConfigStatusNotificationsEnabled: true,
 		ProgrammedCondition: ProgrammedConditionConfiguration{
-			UpdatesEnabled: false, // TODO: https://github.com/Kong/kubernetes-ingress-controller/issues/4578
+			UpdatesEnabled:       true,
+			CustomUnknownMessage: "Found no references to this resource in Ingress or similar resources.",
 		},
 		AcceptsIngressClassNameAnnotation: false,
 		AcceptsIngressClassNameSpec:       false,
@@ -135,9 +158,10 @@ var inputControllersNeeded = &typesNeeded{
 		Plural:                           "kongclusterplugins",
 		CacheType:                        "ClusterPlugin",
 		NeedsStatusPermissions:           true,
-		ConfigStatusNotificationsEnabled: false, // TODO true after https://github.com/Kong/kubernetes-ingress-controller/issues/4578
+		ConfigStatusNotificationsEnabled: true,
 		ProgrammedCondition: ProgrammedConditionConfiguration{
-			UpdatesEnabled: false, // TODO: https://github.com/Kong/kubernetes-ingress-controller/issues/4578
+			UpdatesEnabled:       true,
+			CustomUnknownMessage: "Found no references to this resource in Ingress or similar resources.",
 		},
 		AcceptsIngressClassNameAnnotation: true,
 		AcceptsIngressClassNameSpec:       false,
@@ -183,34 +207,40 @@ var inputControllersNeeded = &typesNeeded{
 		RBACVerbs:                         []string{"get", "list", "watch"},
 	},
 	typeNeeded{
-		Group:                             "configuration.konghq.com",
-		Version:                           "v1beta1",
-		Kind:                              "TCPIngress",
-		PackageImportAlias:                "kongv1beta1",
-		PackageAlias:                      "KongV1Beta1",
-		Package:                           kongv1beta1,
-		Plural:                            "tcpingresses",
-		CacheType:                         "TCPIngress",
-		NeedsStatusPermissions:            true,
-		ConfigStatusNotificationsEnabled:  true,
-		IngressAddressUpdatesEnabled:      true,
+		Group:                            "configuration.konghq.com",
+		Version:                          "v1beta1",
+		Kind:                             "TCPIngress",
+		PackageImportAlias:               "kongv1beta1",
+		PackageAlias:                     "KongV1Beta1",
+		Package:                          kongv1beta1,
+		Plural:                           "tcpingresses",
+		CacheType:                        "TCPIngress",
+		NeedsStatusPermissions:           true,
+		ConfigStatusNotificationsEnabled: true,
+		IngressAddressUpdatesEnabled:     true,
+		ProgrammedCondition: ProgrammedConditionConfiguration{
+			UpdatesEnabled: true,
+		},
 		AcceptsIngressClassNameAnnotation: true,
 		AcceptsIngressClassNameSpec:       false,
 		NeedsUpdateReferences:             true,
 		RBACVerbs:                         []string{"get", "list", "watch"},
 	},
 	typeNeeded{
-		Group:                             "configuration.konghq.com",
-		Version:                           "v1beta1",
-		Kind:                              "UDPIngress",
-		PackageImportAlias:                "kongv1beta1",
-		PackageAlias:                      "KongV1Beta1",
-		Package:                           kongv1beta1,
-		Plural:                            "udpingresses",
-		CacheType:                         "UDPIngress",
-		NeedsStatusPermissions:            true,
-		ConfigStatusNotificationsEnabled:  true,
-		IngressAddressUpdatesEnabled:      true,
+		Group:                            "configuration.konghq.com",
+		Version:                          "v1beta1",
+		Kind:                             "UDPIngress",
+		PackageImportAlias:               "kongv1beta1",
+		PackageAlias:                     "KongV1Beta1",
+		Package:                          kongv1beta1,
+		Plural:                           "udpingresses",
+		CacheType:                        "UDPIngress",
+		NeedsStatusPermissions:           true,
+		ConfigStatusNotificationsEnabled: true,
+		IngressAddressUpdatesEnabled:     true,
+		ProgrammedCondition: ProgrammedConditionConfiguration{
+			UpdatesEnabled: true,
+		},
 		AcceptsIngressClassNameAnnotation: true,
 		AcceptsIngressClassNameSpec:       false,
 		RBACVerbs:                         []string{"get", "list", "watch"},
@@ -247,6 +277,24 @@ var inputControllersNeeded = &typesNeeded{
 		AcceptsIngressClassNameAnnotation: true,
 		RBACVerbs:                         []string{"get", "list", "watch"},
 	},
+	typeNeeded{
+		Group:                            "incubator.ingress-controller.konghq.com",
+		Version:                          "v1alpha1",
+		Kind:                             "KongUpstreamTarget",
+		PackageImportAlias:               "incubatorv1alpha1",
+		PackageAlias:                     "IncubatorV1Alpha1",
+		Package:                          incubatorv1alpha1,
+		Plural:                           "kongupstreamtargets",
+		CacheType:                        "KongUpstreamTarget",
+		NeedsStatusPermissions:           true,
+		ConfigStatusNotificationsEnabled: true,
+		ProgrammedCondition: ProgrammedConditionConfiguration{
+			UpdatesEnabled:       true,
+			CustomUnknownMessage: "Found no references to this resource in Gateway API routes.",
+		},
+		AcceptsIngressClassNameAnnotation: true,
+		RBACVerbs:                         []string{"get", "list", "watch"},
+	},
 	typeNeeded{
 		Group:                            "configuration.konghq.com",
 		Version:                          "v1alpha1",
@@ -423,6 +471,14 @@ type ProgrammedConditionConfiguration struct {
 
 	// CustomUnknownMessage is the message to use for the Programmed condition when the configuration status is Unknown.
 	CustomUnknownMessage string
+
+	// SkipUnreferenced indicates that the Programmed condition should not be added to objects that are not used in
+	// the configuration (their configuration status is Unknown and they have no Programmed condition yet).
+	SkipUnreferenced bool
+
+	// UseAnnotations indicates that the Programmed condition should be reflected in the object's annotations
+	// as its status has no conditions.
+	UseAnnotations bool
 }
 
 func (t *typeNeeded) generate(contents *bytes.Buffer) error {
@@ -550,7 +606,8 @@ func (r *{{.PackageAlias}}{{.Kind}}Reconciler) SetupWithManager(mgr ctrl.Manager
 		blder.WatchesRawSource(
 			source.Channel(
 				r.StatusQueue.Subscribe(schema.GroupVersionKind{
-					Group:   "{{.Group}}",
+					{{- /* The core API group is represented as an empty string in the RBAC markers. */}}
+					Group:   {{ if eq .Group "\"\"" }}""{{ else }}"{{.Group}}"{{ end }},
 					Version: "{{.Version}}",
 					Kind:    "{{.Kind}}",
 				}),
@@ -695,19 +752,64 @@ func (r *{{.PackageAlias}}{{.Kind}}Reconciler) Reconcile(ctx context.Context, re
 {{- end }}
 
 {{- /* For ProgrammedCondition.UpdatesEnabled we do not update references before status is updated because in case of
-       a reference to non-existing object, the status update would never happen. */ -}}
-{{- if and .NeedsUpdateReferences (not .ProgrammedCondition.UpdatesEnabled) }}
+       a reference to non-existing object, the status update would never happen. The exception are the objects with
+       IngressAddressUpdatesEnabled which are requeued until configured and need their references (e.g. Secrets)
+       to get configured. */ -}}
+{{- if and .NeedsUpdateReferences (or (not .ProgrammedCondition.UpdatesEnabled) .IngressAddressUpdatesEnabled) }}
 	{{- template "updateReferences" . }}
 {{- end }}
 
 {{- if .ConfigStatusNotificationsEnabled }}
 	// if status updates are enabled report the status for the object
 	if r.DataplaneClient.AreKubernetesObjectReportsEnabled() {
+		{{- if .ProgrammedCondition.UseAnnotations }}
+		log.V(util.DebugLevel).Info("Updating programmed annotations", "namespace", req.Namespace, "name", req.Name)
+		if annotations, updateNeeded := ctrlutils.EnsureProgrammedAnnotations(
+			r.DataplaneClient.KubernetesObjectConfigurationStatus(obj),
+			obj.Generation,
+			r.DataplaneClient.KubernetesObjectsConfigurationHash(),
+			obj.GetAnnotations(),
+		); updateNeeded {
+			obj.SetAnnotations(annotations)
+			return ctrl.Result{}, r.Update(ctx, obj)
+		}
+		{{- end }}
+
+		{{- if .ProgrammedCondition.UpdatesEnabled }}
+		log.V(util.DebugLevel).Info("Updating programmed condition status", "namespace", req.Namespace, "name", req.Name)
+		configurationStatus := r.DataplaneClient.KubernetesObjectConfigurationStatus(obj)
+		{{- if .ProgrammedCondition.SkipUnreferenced }}
+		programmedConditionNeeded := ctrlutils.IsProgrammedConditionNeeded(configurationStatus, obj.Status.Conditions)
+		{{- end }}
+		conditions, updateNeeded := ctrlutils.EnsureProgrammedCondition(
+			configurationStatus, 
+			obj.Generation, 
+			obj.Status.Conditions,
+			ctrlutils.WithConfigurationHash(r.DataplaneClient.KubernetesObjectsConfigurationHash()),
+		{{- if .ProgrammedCondition.CustomUnknownMessage }}
+			ctrlutils.WithUnknownMessage("{{ .ProgrammedCondition.CustomUnknownMessage }}"),
+		{{- end }}
+		)
+		{{- if .ProgrammedCondition.SkipUnreferenced }}
+		if !programmedConditionNeeded {
+			conditions, updateNeeded = obj.Status.Conditions, false
+		}
+		{{- end }}
+		obj.Status.Conditions = conditions
+		{{- end }}
+
 		{{- if .IngressAddressUpdatesEnabled }}
 		log.V(util.DebugLevel).Info("Determining whether data-plane configuration has succeeded", "namespace", req.Namespace, "name", req.Name)
 
 		if  !r.DataplaneClient.KubernetesObjectIsConfigured(obj) {
 			log.V(util.DebugLevel).Info("Resource not yet configured in the data-plane", "namespace", req.Namespace, "name", req.Name)
+			{{- if .ProgrammedCondition.UpdatesEnabled }}
+			if updateNeeded {
+				if err := r.Status().Update(ctx, obj); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+			{{- end }}
 			return ctrl.Result{Requeue: true}, nil // requeue until the object has been properly configured
 		}
 
@@ -718,24 +820,18 @@ func (r *{{.PackageAlias}}{{.Kind}}Reconciler) Reconcile(ctx context.Context, re
 		}
 
 		log.V(util.DebugLevel).Info("Found addresses for data-plane updating object status", "namespace", req.Namespace, "name", req.Name)
+		{{- if .ProgrammedCondition.UpdatesEnabled }}
+		addressesUpdateNeeded, err := ctrlutils.UpdateLoadBalancerIngress(obj, addrs)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update load balancer address: %w", err)
+		}
+		updateNeeded = updateNeeded || addressesUpdateNeeded
+		{{- else }}
 		updateNeeded, err := ctrlutils.UpdateLoadBalancerIngress(obj, addrs)
 		if err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to update load balancer address: %w", err)
 		}
 		{{- end }}
-
-		{{- if .ProgrammedCondition.UpdatesEnabled }}
-		log.V(util.DebugLevel).Info("Updating programmed condition status", "namespace", req.Namespace, "name", req.Name)
-		configurationStatus := r.DataplaneClient.KubernetesObjectConfigurationStatus(obj)
-		conditions, updateNeeded := ctrlutils.EnsureProgrammedCondition(
-			configurationStatus, 
-			obj.Generation, 
-			obj.Status.Conditions,
-		{{- if .ProgrammedCondition.CustomUnknownMessage }}
-			ctrlutils.WithUnknownMessage("{{ .ProgrammedCondition.CustomUnknownMessage }}"),
-		{{- end }}
-		)
-		obj.Status.Conditions = conditions
 		{{- end }}
 		if updateNeeded {
 			return ctrl.Result{}, r.Status().Update(ctx, obj)
@@ -746,7 +842,7 @@ func (r *{{.PackageAlias}}{{.Kind}}Reconciler) Reconcile(ctx context.Context, re
 
 {{- /* For ProgrammedCondition.UpdatesEnabled we update references after status is updated because otherwise in case of
        a reference to non-existing object, the status update would never happen. */ -}}
-{{- if and .NeedsUpdateReferences .ProgrammedCondition.UpdatesEnabled }}
+{{- if and .NeedsUpdateReferences .ProgrammedCondition.UpdatesEnabled (not .IngressAddressUpdatesEnabled) }}
 	{{- template "updateReferences" . }}
 {{- end }}
 
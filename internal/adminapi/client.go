@@ -193,7 +193,11 @@ func NewClientFactoryForWorkspace(workspace string, httpClientOpts HTTPClientOpt
 }
 
 func (cf ClientFactory) CreateAdminAPIClient(ctx context.Context, discoveredAdminAPI DiscoveredAdminAPI) (*Client, error) {
-	httpclient, err := MakeHTTPClient(&cf.httpClientOpts, cf.adminToken)
+	httpClientOpts := cf.httpClientOpts
+	if !discoveredAdminAPI.TLS.IsZero() {
+		httpClientOpts = httpClientOpts.WithAdminAPITLS(discoveredAdminAPI.TLS)
+	}
+	httpclient, err := MakeHTTPClient(&httpClientOpts, cf.adminToken)
 	if err != nil {
 		return nil, err
 	}
@@ -204,3 +208,12 @@ func (cf ClientFactory) CreateAdminAPIClient(ctx context.Context, discoveredAdmi
 	cl.AttachPodReference(discoveredAdminAPI.PodRef)
 	return cl, nil
 }
+
+// CreateAdminAPIClientForWorkspace creates an Admin API client scoped to the given workspace instead of the one
+// the factory was created for. The workspace is created if it doesn't exist yet.
+func (cf ClientFactory) CreateAdminAPIClientForWorkspace(
+	ctx context.Context, workspace string, discoveredAdminAPI DiscoveredAdminAPI,
+) (*Client, error) {
+	cf.workspace = workspace
+	return cf.CreateAdminAPIClient(ctx, discoveredAdminAPI)
+}
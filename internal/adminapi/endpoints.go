@@ -19,6 +19,8 @@ import (
 type DiscoveredAdminAPI struct {
 	Address string
 	PodRef  k8stypes.NamespacedName
+	// TLS overrides the TLS configuration used to connect with the Admin API when set.
+	TLS AdminAPITLS
 }
 
 type Discoverer struct {
@@ -128,6 +128,20 @@ type HTTPClientOpts struct {
 	TLSClient TLSClientConfig
 }
 
+// WithAdminAPITLS returns a copy of the options with the TLS configuration replaced by the given one.
+// Headers are preserved.
+func (opts HTTPClientOpts) WithAdminAPITLS(tls AdminAPITLS) HTTPClientOpts {
+	return HTTPClientOpts{
+		TLSServerName: tls.ServerName,
+		CACert:        tls.CACert,
+		Headers:       opts.Headers,
+		TLSClient: TLSClientConfig{
+			Cert: tls.ClientCert,
+			Key:  tls.ClientKey,
+		},
+	}
+}
+
 const (
 	HeaderNameAdminToken = "Kong-Admin-Token"
 )
@@ -104,6 +104,28 @@ func TestMakeHTTPClientWithTLSOptsAndFilePaths(t *testing.T) {
 	})
 }
 
+func TestHTTPClientOptsWithAdminAPITLS(t *testing.T) {
+	cert, key := certificate.MustGenerateSelfSignedCertPEMFormat()
+	opts := adminapi.HTTPClientOpts{
+		TLSSkipVerify: true,
+		CACertPath:    "/etc/kong/ca.crt",
+		Headers:       []string{"foo:bar"},
+		TLSClient:     adminapi.TLSClientConfig{CertFile: "/etc/kong/tls.crt", KeyFile: "/etc/kong/tls.key"},
+	}
+
+	require.Equal(t, adminapi.HTTPClientOpts{
+		TLSServerName: "kong-admin.default.svc",
+		CACert:        string(cert),
+		Headers:       []string{"foo:bar"},
+		TLSClient:     adminapi.TLSClientConfig{Cert: string(cert), Key: string(key)},
+	}, opts.WithAdminAPITLS(adminapi.AdminAPITLS{
+		CACert:     string(cert),
+		ServerName: "kong-admin.default.svc",
+		ClientCert: string(cert),
+		ClientKey:  string(key),
+	}), "TLS options should be replaced and headers preserved")
+}
+
 func TestNewKongClientForWorkspace(t *testing.T) {
 	const testWorkspace = "workspace"
 
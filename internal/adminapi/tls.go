@@ -22,3 +22,21 @@ type TLSClientConfig struct {
 func (c TLSClientConfig) IsZero() bool {
 	return c == TLSClientConfig{}
 }
+
+// AdminAPITLS contains PEM-encoded TLS material used to connect with an Admin API whose TLS configuration is
+// provisioned by the controller (e.g. the data plane of a managed Gateway) instead of the one configured with
+// --kong-admin-* flags.
+type AdminAPITLS struct {
+	// CACert is a CA certificate to verify the Admin API's certificate with.
+	CACert string
+	// ServerName is the name to verify the Admin API's certificate against.
+	ServerName string
+	// ClientCert is a client certificate to authenticate with.
+	ClientCert string
+	// ClientKey is a client key to authenticate with.
+	ClientKey string
+}
+
+func (t AdminAPITLS) IsZero() bool {
+	return t == AdminAPITLS{}
+}
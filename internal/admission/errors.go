@@ -9,13 +9,20 @@ const (
 	ErrTextConsumerGroupUnsupported           = "consumer group support requires Kong Enterprise"
 	ErrTextConsumerGroupUnlicensed            = "consumer group support requires a valid Kong Enterprise license"
 	ErrTextConsumerGroupUnexpected            = "unexpected error during checking support for consumer group"
+	ErrTextConsumerGroupNameDuplicate         = "consumer group name %q is already used by KongConsumerGroup %s/%s"
 	ErrTextCustomEntityFieldsUnmarshalFailed  = "failed to unmarshal fields of custom entity: %v"
 	ErrTextCustomEntityGetSchemaFailed        = "failed to get schema of Kong entity type '%s': %v"
 	ErrTextFailedToRetrieveSecret             = "could not retrieve secrets from the kubernetes API" //nolint:revive,gosec
 	ErrTextPluginConfigInvalid                = "could not parse plugin configuration"
+	ErrTextPluginConfigMapConfigUnretrievable = "could not load ConfigMap plugin configuration"
 	ErrTextPluginConfigValidationFailed       = "unable to validate plugin schema"
 	ErrTextPluginConfigViolatesSchema         = "plugin failed schema validation: %s"
 	ErrTextPluginSecretConfigUnretrievable    = "could not load secret plugin configuration"
+	ErrTextUpstreamPolicyInvalid              = "KongUpstreamPolicy spec is invalid: %s"
+	ErrTextUpstreamPolicyConflicted           = "KongUpstreamPolicy cannot be applied to Services used in HTTPRoute rules together with backends not using the same KongUpstreamPolicy: %s"
+	ErrTextUpstreamPolicyConflictsUnresolved  = "unable to check KongUpstreamPolicy for conflicts"
+	ErrTextUpstreamPolicyUnableToValidate     = "unable to validate KongUpstreamPolicy on Kong gateway"
+	ErrTextUpstreamPolicyViolatesSchema       = "KongUpstreamPolicy failed schema validation: %s"
 	ErrTextVaultConfigUnmarshalFailed         = "failed to unmarshal vault configuration: %v"
 	ErrTextVaultUnableToValidate              = "unable to validate vault on Kong gateway"
 	ErrTextVaultConfigValidationResultInvalid = "vault configuration in invalid: %s"
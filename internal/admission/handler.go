@@ -20,6 +20,7 @@ import (
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 const (
@@ -98,6 +99,11 @@ var (
 		Version:  kongv1.SchemeGroupVersion.Version,
 		Resource: "kongingresses",
 	}
+	kongUpstreamPolicyGVResource = metav1.GroupVersionResource{
+		Group:    kongv1beta1.SchemeGroupVersion.Group,
+		Version:  kongv1beta1.SchemeGroupVersion.Version,
+		Resource: "kongupstreampolicies",
+	}
 	kongVaultGVResource = metav1.GroupVersionResource{
 		Group:    kongv1alpha1.SchemeGroupVersion.Group,
 		Version:  kongv1alpha1.SchemeGroupVersion.Version,
@@ -118,6 +124,11 @@ var (
 		Version:  netv1.SchemeGroupVersion.Version,
 		Resource: "ingresses",
 	}
+	kongServiceFacadeGVResource = metav1.GroupVersionResource{
+		Group:    incubatorv1alpha1.SchemeGroupVersion.Group,
+		Version:  incubatorv1alpha1.SchemeGroupVersion.Version,
+		Resource: "kongservicefacades",
+	}
 	serviceGVResource = metav1.GroupVersionResource{
 		Group:    corev1.SchemeGroupVersion.Group,
 		Version:  corev1.SchemeGroupVersion.Version,
@@ -145,14 +156,26 @@ func (h RequestHandler) handleValidation(ctx context.Context, request admissionv
 		return h.handleGateway(ctx, request, responseBuilder)
 	case gatewayapi.V1HTTPRouteGVResource, gatewayapi.V1beta1HTTPRouteGVResource:
 		return h.handleHTTPRoute(ctx, request, responseBuilder)
+	case gatewayapi.V1GRPCRouteGVResource, gatewayapi.V1alpha2GRPCRouteGVResource:
+		return h.handleGRPCRoute(ctx, request, responseBuilder)
+	case gatewayapi.V1alpha2TCPRouteGVResource:
+		return h.handleTCPRoute(ctx, request, responseBuilder)
+	case gatewayapi.V1alpha2UDPRouteGVResource:
+		return h.handleUDPRoute(ctx, request, responseBuilder)
+	case gatewayapi.V1alpha2TLSRouteGVResource:
+		return h.handleTLSRoute(ctx, request, responseBuilder)
 	case kongIngressGVResource:
 		return h.handleKongIngress(ctx, request, responseBuilder)
+	case kongUpstreamPolicyGVResource:
+		return h.handleKongUpstreamPolicy(ctx, request, responseBuilder)
 	case kongVaultGVResource:
 		return h.handleKongVault(ctx, request, responseBuilder)
 	case kongCustomEntityGVResource:
 		return h.handleKongCustomEntity(ctx, request, responseBuilder)
+	case kongServiceFacadeGVResource:
+		return h.handleKongServiceFacade(ctx, request, responseBuilder)
 	case serviceGVResource:
-		return h.handleService(request, responseBuilder)
+		return h.handleService(ctx, request, responseBuilder)
 	case ingressGVResource:
 		return h.handleIngress(ctx, request, responseBuilder)
 	default:
@@ -402,6 +425,97 @@ func (h RequestHandler) handleHTTPRoute(
 	if err != nil {
 		return nil, err
 	}
+	if !ok {
+		return responseBuilder.Allowed(ok).WithMessage(message).Build(), nil
+	}
+
+	var oldHTTPRoute *gatewayapi.HTTPRoute
+	if request.Operation == admissionv1.Update {
+		oldHTTPRoute = &gatewayapi.HTTPRoute{}
+		if _, _, err := codecs.UniversalDeserializer().Decode(request.OldObject.Raw, nil, oldHTTPRoute); err != nil {
+			return nil, err
+		}
+	}
+	ok, message, err = h.Validator.ValidateUpstreamPolicyConflicts(ctx, &httproute, oldHTTPRoute)
+	if err != nil {
+		return nil, err
+	}
+	return responseBuilder.Allowed(ok).WithMessage(message).Build(), nil
+}
+
+// +kubebuilder:webhook:verbs=create;update,groups=gateway.networking.k8s.io,resources=grpcroutes,versions=v1;v1alpha2,name=grpcroutes.validation.ingress-controller.konghq.com,path=/,webhookVersions=v1,matchPolicy=equivalent,mutating=false,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1
+
+func (h RequestHandler) handleGRPCRoute(
+	ctx context.Context,
+	request admissionv1.AdmissionRequest,
+	responseBuilder *ResponseBuilder,
+) (*admissionv1.AdmissionResponse, error) {
+	grpcroute := gatewayapi.GRPCRoute{}
+	_, _, err := codecs.UniversalDeserializer().Decode(request.Object.Raw, nil, &grpcroute)
+	if err != nil {
+		return nil, err
+	}
+	ok, message, err := h.Validator.ValidateGRPCRoute(ctx, grpcroute)
+	if err != nil {
+		return nil, err
+	}
+	return responseBuilder.Allowed(ok).WithMessage(message).Build(), nil
+}
+
+// +kubebuilder:webhook:verbs=create;update,groups=gateway.networking.k8s.io,resources=tcproutes,versions=v1alpha2,name=tcproutes.validation.ingress-controller.konghq.com,path=/,webhookVersions=v1,matchPolicy=equivalent,mutating=false,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1
+
+func (h RequestHandler) handleTCPRoute(
+	ctx context.Context,
+	request admissionv1.AdmissionRequest,
+	responseBuilder *ResponseBuilder,
+) (*admissionv1.AdmissionResponse, error) {
+	tcproute := gatewayapi.TCPRoute{}
+	_, _, err := codecs.UniversalDeserializer().Decode(request.Object.Raw, nil, &tcproute)
+	if err != nil {
+		return nil, err
+	}
+	ok, message, err := h.Validator.ValidateTCPRoute(ctx, tcproute)
+	if err != nil {
+		return nil, err
+	}
+	return responseBuilder.Allowed(ok).WithMessage(message).Build(), nil
+}
+
+// +kubebuilder:webhook:verbs=create;update,groups=gateway.networking.k8s.io,resources=udproutes,versions=v1alpha2,name=udproutes.validation.ingress-controller.konghq.com,path=/,webhookVersions=v1,matchPolicy=equivalent,mutating=false,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1
+
+func (h RequestHandler) handleUDPRoute(
+	ctx context.Context,
+	request admissionv1.AdmissionRequest,
+	responseBuilder *ResponseBuilder,
+) (*admissionv1.AdmissionResponse, error) {
+	udproute := gatewayapi.UDPRoute{}
+	_, _, err := codecs.UniversalDeserializer().Decode(request.Object.Raw, nil, &udproute)
+	if err != nil {
+		return nil, err
+	}
+	ok, message, err := h.Validator.ValidateUDPRoute(ctx, udproute)
+	if err != nil {
+		return nil, err
+	}
+	return responseBuilder.Allowed(ok).WithMessage(message).Build(), nil
+}
+
+// +kubebuilder:webhook:verbs=create;update,groups=gateway.networking.k8s.io,resources=tlsroutes,versions=v1alpha2,name=tlsroutes.validation.ingress-controller.konghq.com,path=/,webhookVersions=v1,matchPolicy=equivalent,mutating=false,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1
+
+func (h RequestHandler) handleTLSRoute(
+	ctx context.Context,
+	request admissionv1.AdmissionRequest,
+	responseBuilder *ResponseBuilder,
+) (*admissionv1.AdmissionResponse, error) {
+	tlsroute := gatewayapi.TLSRoute{}
+	_, _, err := codecs.UniversalDeserializer().Decode(request.Object.Raw, nil, &tlsroute)
+	if err != nil {
+		return nil, err
+	}
+	ok, message, err := h.Validator.ValidateTLSRoute(ctx, tlsroute)
+	if err != nil {
+		return nil, err
+	}
 	return responseBuilder.Allowed(ok).WithMessage(message).Build(), nil
 }
 
@@ -446,7 +560,7 @@ const (
 
 // +kubebuilder:webhook:verbs=create;update,groups=core,resources=services,versions=v1,name=services.validation.ingress-controller.konghq.com,path=/,webhookVersions=v1,matchPolicy=equivalent,mutating=false,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1
 
-func (h RequestHandler) handleService(request admissionv1.AdmissionRequest, responseBuilder *ResponseBuilder) (*admissionv1.AdmissionResponse, error) {
+func (h RequestHandler) handleService(ctx context.Context, request admissionv1.AdmissionRequest, responseBuilder *ResponseBuilder) (*admissionv1.AdmissionResponse, error) {
 	service := corev1.Service{}
 	_, _, err := codecs.UniversalDeserializer().Decode(request.Object.Raw, nil, &service)
 	if err != nil {
@@ -460,7 +574,41 @@ func (h RequestHandler) handleService(request admissionv1.AdmissionRequest, resp
 		responseBuilder = responseBuilder.WithWarning(warning)
 	}
 
-	return responseBuilder.Allowed(true).Build(), nil
+	var oldService *corev1.Service
+	if request.Operation == admissionv1.Update {
+		oldService = &corev1.Service{}
+		if _, _, err := codecs.UniversalDeserializer().Decode(request.OldObject.Raw, nil, oldService); err != nil {
+			return nil, err
+		}
+	}
+	ok, message, err := h.Validator.ValidateUpstreamPolicyConflicts(ctx, &service, oldService)
+	if err != nil {
+		return nil, err
+	}
+	return responseBuilder.Allowed(ok).WithMessage(message).Build(), nil
+}
+
+// +kubebuilder:webhook:verbs=create;update,groups=incubator.ingress-controller.konghq.com,resources=kongservicefacades,versions=v1alpha1,name=kongservicefacades.validation.ingress-controller.konghq.com,path=/,webhookVersions=v1,matchPolicy=equivalent,mutating=false,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1
+
+func (h RequestHandler) handleKongServiceFacade(ctx context.Context, request admissionv1.AdmissionRequest, responseBuilder *ResponseBuilder) (*admissionv1.AdmissionResponse, error) {
+	serviceFacade := incubatorv1alpha1.KongServiceFacade{}
+	_, _, err := codecs.UniversalDeserializer().Decode(request.Object.Raw, nil, &serviceFacade)
+	if err != nil {
+		return nil, err
+	}
+
+	var oldServiceFacade *incubatorv1alpha1.KongServiceFacade
+	if request.Operation == admissionv1.Update {
+		oldServiceFacade = &incubatorv1alpha1.KongServiceFacade{}
+		if _, _, err := codecs.UniversalDeserializer().Decode(request.OldObject.Raw, nil, oldServiceFacade); err != nil {
+			return nil, err
+		}
+	}
+	ok, message, err := h.Validator.ValidateUpstreamPolicyConflicts(ctx, &serviceFacade, oldServiceFacade)
+	if err != nil {
+		return nil, err
+	}
+	return responseBuilder.Allowed(ok).WithMessage(message).Build(), nil
 }
 
 // +kubebuilder:webhook:verbs=create;update,groups=networking.k8s.io,resources=ingresses,versions=v1,name=ingresses.validation.ingress-controller.konghq.com,path=/,webhookVersions=v1,matchPolicy=equivalent,mutating=false,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1
@@ -479,6 +627,29 @@ func (h RequestHandler) handleIngress(ctx context.Context, request admissionv1.A
 	return responseBuilder.Allowed(ok).WithMessage(message).Build(), nil
 }
 
+// +kubebuilder:webhook:verbs=create;update,groups=configuration.konghq.com,resources=kongupstreampolicies,versions=v1beta1,name=kongupstreampolicies.validation.ingress-controller.konghq.com,path=/,webhookVersions=v1,matchPolicy=equivalent,mutating=false,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1
+
+func (h RequestHandler) handleKongUpstreamPolicy(ctx context.Context, request admissionv1.AdmissionRequest, responseBuilder *ResponseBuilder) (*admissionv1.AdmissionResponse, error) {
+	kongUpstreamPolicy := kongv1beta1.KongUpstreamPolicy{}
+	_, _, err := codecs.UniversalDeserializer().Decode(request.Object.Raw, nil, &kongUpstreamPolicy)
+	if err != nil {
+		return nil, err
+	}
+	var oldKongUpstreamPolicy *kongv1beta1.KongUpstreamPolicy
+	if request.Operation == admissionv1.Update {
+		oldKongUpstreamPolicy = &kongv1beta1.KongUpstreamPolicy{}
+		if _, _, err := codecs.UniversalDeserializer().Decode(request.OldObject.Raw, nil, oldKongUpstreamPolicy); err != nil {
+			return nil, err
+		}
+	}
+	ok, message, err := h.Validator.ValidateUpstreamPolicy(ctx, kongUpstreamPolicy, oldKongUpstreamPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return responseBuilder.Allowed(ok).WithMessage(message).Build(), nil
+}
+
 // +kubebuilder:webhook:verbs=create;update,groups=configuration.konghq.com,resources=kongvaults,versions=v1alpha1,name=kongvaults.validation.ingress-controller.konghq.com,path=/,webhookVersions=v1,matchPolicy=equivalent,mutating=false,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1
 
 func (h RequestHandler) handleKongVault(ctx context.Context, request admissionv1.AdmissionRequest, responseBuilder *ResponseBuilder) (*admissionv1.AdmissionResponse, error) {
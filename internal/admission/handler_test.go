@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -21,6 +22,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	ctrlref "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/reference"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/labels"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
@@ -240,14 +242,16 @@ func TestHandleService(t *testing.T) {
 					Raw:    raw,
 				},
 			}
+			validator := tt.validator
+			validator.Storer = lo.Must(store.NewFakeStore(store.FakeObjects{}))
 			handler := RequestHandler{
-				Validator: tt.validator,
+				Validator: validator,
 				Logger:    logr.Discard(),
 			}
 
 			responseBuilder := NewResponseBuilder(k8stypes.UID(""))
 
-			got, err := handler.handleService(request, responseBuilder)
+			got, err := handler.handleService(context.Background(), request, responseBuilder)
 			require.NoError(t, err)
 			require.Equal(t, tt.isAllowed, got.Allowed)
 			require.Equal(t, tt.wantWarnings, got.Warnings)
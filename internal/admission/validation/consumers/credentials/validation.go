@@ -6,6 +6,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/labels"
@@ -68,6 +69,34 @@ func ValidateCredentials(secret *corev1.Secret) error {
 		return fmt.Errorf("some fields were invalid due to missing data: %s", strings.Join(missingDataFields, ", "))
 	}
 
+	// verify that vault references are well-formed and used only in fields that support them
+	if _, err := extractVaultReferences(credentialType, secret); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateVaultReferences verifies that the credential's fields set to vault references support them
+// and that the references point to vaults known by vaultExists. Values of such fields are resolved by
+// Kong gateway, so they can't be validated any further.
+func ValidateVaultReferences(secret *corev1.Secret, vaultExists func(prefix string) bool) error {
+	credentialType, err := util.ExtractKongCredentialType(secret)
+	if err != nil {
+		return fmt.Errorf("secret has no credential type, add a %s label", labels.CredentialTypeLabel)
+	}
+
+	references, err := extractVaultReferences(credentialType, secret)
+	if err != nil {
+		return err
+	}
+	fields := lo.Keys(references)
+	slices.Sort(fields)
+	for _, field := range fields {
+		if prefix := references[field]; !vaultExists(prefix) {
+			return fmt.Errorf("field %s references vault with prefix %q that is not configured by any KongVault", field, prefix)
+		}
+	}
 	return nil
 }
 
@@ -149,6 +178,11 @@ func (cs Index) ValidateCredentialsForUniqueKeyConstraints(secret *corev1.Secret
 // -----------------------------------------------------------------------------
 
 func (cs Index) add(newCred Credential) error {
+	// vault references are resolved by Kong gateway, their actual values can't be compared here
+	if _, isReference, _ := util.ParseVaultReference(newCred.Value); isReference {
+		return nil
+	}
+
 	// retrieve all the keys which are constrained for this type
 	constraints, ok := uniqueKeyConstraints[newCred.Type]
 	if !ok {
@@ -179,6 +213,28 @@ func (cs Index) add(newCred Credential) error {
 	return nil
 }
 
+// extractVaultReferences returns a map of the credential's fields set to vault references to the
+// prefixes of the referenced vaults.
+func extractVaultReferences(credentialType string, secret *corev1.Secret) (map[string]string, error) {
+	fields := lo.Keys(secret.Data)
+	slices.Sort(fields)
+	references := make(map[string]string)
+	for _, field := range fields {
+		prefix, isReference, err := util.ParseVaultReference(string(secret.Data[field]))
+		if !isReference {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field, err)
+		}
+		if !slices.Contains(VaultReferenceableFields[credentialType], field) {
+			return nil, fmt.Errorf("field %s of %s credential does not support vault references", field, credentialType)
+		}
+		references[field] = prefix
+	}
+	return references, nil
+}
+
 func algoIsHMAC(algo string) bool {
 	return slices.Contains([]string{"HS256", "HS384", "HS512"}, algo)
 }
@@ -237,6 +237,40 @@ func TestValidateCredentials(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("some fields were invalid due to missing data: key"),
 		},
+		{
+			name: "valid credential with vault reference",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret",
+					Namespace: "default",
+					Labels: map[string]string{
+						labels.CredentialTypeLabel: "basic-auth",
+					},
+				},
+				Data: map[string][]byte{
+					"username": []byte("batman"),
+					"password": []byte("{vault://env/batman-password}"),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "vault reference in field not supporting it",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret",
+					Namespace: "default",
+					Labels: map[string]string{
+						labels.CredentialTypeLabel: "hmac-auth",
+					},
+				},
+				Data: map[string][]byte{
+					"username": []byte("batman"),
+					"secret":   []byte("{vault://env/batman-secret}"),
+				},
+			},
+			wantErr: fmt.Errorf("field secret of hmac-auth credential does not support vault references"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -250,3 +284,41 @@ func TestValidateCredentials(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateVaultReferences(t *testing.T) {
+	vaultExists := func(prefix string) bool { return prefix == "env" }
+	keyAuthSecret := func(key string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "secret",
+				Namespace: "default",
+				Labels: map[string]string{
+					labels.CredentialTypeLabel: "key-auth",
+				},
+			},
+			Data: map[string][]byte{
+				"key": []byte(key),
+			},
+		}
+	}
+
+	require.NoError(t, ValidateVaultReferences(keyAuthSecret("little-rabbits-be-good"), vaultExists))
+	require.NoError(t, ValidateVaultReferences(keyAuthSecret("{vault://env/key}"), vaultExists))
+	require.EqualError(t, ValidateVaultReferences(keyAuthSecret("{vault://aws/key}"), vaultExists),
+		`field key references vault with prefix "aws" that is not configured by any KongVault`)
+	require.EqualError(t, ValidateVaultReferences(keyAuthSecret("{vault://env}"), vaultExists),
+		`field key: malformed vault reference "{vault://env}", expected {vault://<prefix>/<path>}`)
+}
+
+func TestUniqueConstraintsValidationWithVaultReferences(t *testing.T) {
+	index := make(Index)
+	reference := Credential{
+		Key:   "key",
+		Value: "{vault://env/key}",
+		Type:  "key-auth",
+	}
+	require.NoError(t, index.add(reference))
+
+	t.Log("Verifying that vault references are not checked for constraints as their values are resolved by Kong gateway")
+	require.NoError(t, index.add(reference))
+}
@@ -27,6 +27,14 @@ var (
 	ACLAuthFields    = []string{"group"}
 )
 
+// VaultReferenceableFields indicates the credential fields that can be set to a vault reference
+// ({vault://<prefix>/<path>}) instead of a literal value. Such values are resolved by Kong gateway.
+var VaultReferenceableFields = map[string][]string{
+	"key-auth":   {"key"},
+	"basic-auth": {"password"},
+	"jwt":        {"secret"},
+}
+
 var CredTypeToFields = map[string][]string{
 	"key-auth":             KeyAuthFields,
 	"keyauth_credential":   KeyAuthFields,
@@ -0,0 +1,114 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kong/go-kong/kong"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/admission/validation"
+	gatewaycontroller "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/gateway"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+)
+
+// -----------------------------------------------------------------------------
+// Validation - GRPCRoute - Public Functions
+// -----------------------------------------------------------------------------
+
+// ValidateGRPCRoute provides a suite of validation for a given GRPCRoute and
+// any number of Gateway resources it's attached to that the caller wants to
+// have it validated against. It checks supported features, linked objects,
+// and uses provided routesValidator to validate the route against Kong Gateway
+// validation endpoint.
+func ValidateGRPCRoute(
+	ctx context.Context,
+	routesValidator routeValidator,
+	translatorFeatures translator.FeatureFlags,
+	grpcroute *gatewayapi.GRPCRoute,
+	managerClient client.Client,
+	storer store.Storer,
+) (bool, string, error) {
+	// Check if route is managed by this controller. If not, we don't need to validate it.
+	routeIsManaged, err := ensureRouteIsManagedByController(ctx, grpcroute.Namespace, grpcroute.Spec.ParentRefs, managerClient)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to determine whether GRPCRoute is managed by %q controller: %w",
+			gatewaycontroller.GetControllerName(), err)
+	}
+	if !routeIsManaged {
+		return true, "", nil
+	}
+
+	// Validate that no unsupported features are in use.
+	if err := validateGRPCRouteFeatures(grpcroute, translatorFeatures); err != nil {
+		return false, fmt.Sprintf("GRPCRoute spec did not pass validation: %s", err), nil
+	}
+
+	// Validate that the route uses only supported annotations.
+	if err := validation.ValidateRouteSourceAnnotations(grpcroute); err != nil {
+		return false, fmt.Sprintf("GRPCRoute has invalid Kong annotations: %s", err), nil
+	}
+
+	// Validate that the route is valid against Kong Gateway.
+	var kongRoutes []kong.Route
+	for ruleNumber := range grpcroute.Spec.Rules {
+		var routes []kongstate.Route
+		if translatorFeatures.ExpressionRoutes {
+			routes = subtranslator.GenerateKongExpressionRoutesFromGRPCRouteRule(grpcroute, ruleNumber)
+		} else {
+			routes = subtranslator.GenerateKongRoutesFromGRPCRouteRule(grpcroute, ruleNumber, storer)
+		}
+		for _, r := range routes {
+			kongRoutes = append(kongRoutes, r.Route)
+		}
+	}
+	ok, msg := validateRoutesWithKongGateway(ctx, routesValidator, "GRPCRoute", kongRoutes)
+	return ok, msg, nil
+}
+
+// -----------------------------------------------------------------------------
+// Validation - GRPCRoute - Private Functions
+// -----------------------------------------------------------------------------
+
+// validateGRPCRouteFeatures checks for features that are not supported by this
+// GRPCRoute implementation and validates that the provided object is not using
+// any of those unsupported features.
+func validateGRPCRouteFeatures(grpcroute *gatewayapi.GRPCRoute, translatorFeatures translator.FeatureFlags) error {
+	for ruleIndex, rule := range grpcroute.Spec.Rules {
+		for refIndex, ref := range rule.BackendRefs {
+			// Specifying filters in backendRef is not supported.
+			if len(ref.Filters) != 0 {
+				return fmt.Errorf("rules[%d].backendRefs[%d]: filters in backendRef is unsupported",
+					ruleIndex, refIndex)
+			}
+
+			if err := validateRouteBackendRefGroupKind("grpcroute", ref.BackendRef, translatorFeatures); err != nil {
+				return fmt.Errorf("rules[%d].backendRefs[%d]: %w", ruleIndex, refIndex, err)
+			}
+		}
+
+		for matchIndex, match := range rule.Matches {
+			if match.Method != nil && match.Method.Type != nil {
+				switch *match.Method.Type {
+				case gatewayapi.GRPCMethodMatchExact, gatewayapi.GRPCMethodMatchRegularExpression:
+				default:
+					return fmt.Errorf("rules[%d].matches[%d]: method match type %s is unsupported",
+						ruleIndex, matchIndex, *match.Method.Type)
+				}
+			}
+
+			for headerIndex, header := range match.Headers {
+				// Traditional router matches headers by their exact values only.
+				if header.Type != nil && *header.Type == gatewayapi.HeaderMatchRegularExpression && !translatorFeatures.ExpressionRoutes {
+					return fmt.Errorf("rules[%d].matches[%d].headers[%d]: regular expression header matching is supported with expression router only",
+						ruleIndex, matchIndex, headerIndex)
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	gatewaycontroller "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/gateway"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/scheme"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+)
+
+// managedGatewayObjects returns a GatewayClass managed by this controller and a Gateway using it.
+func managedGatewayObjects(listeners ...gatewayapi.Listener) (*gatewayapi.GatewayClass, *gatewayapi.Gateway) {
+	gatewayClass := &gatewayapi.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "kong",
+		},
+		Spec: gatewayapi.GatewayClassSpec{
+			ControllerName: gatewaycontroller.GetControllerName(),
+		},
+	}
+	gateway := &gatewayapi.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: corev1.NamespaceDefault,
+			Name:      "testing-gateway",
+		},
+		Spec: gatewayapi.GatewaySpec{
+			GatewayClassName: gatewayapi.ObjectName(gatewayClass.Name),
+			Listeners:        listeners,
+		},
+	}
+	return gatewayClass, gateway
+}
+
+// recordingRoutesValidator records validated routes and rejects those with names listed in invalidRoutes.
+type recordingRoutesValidator struct {
+	invalidRoutes map[string]string
+	validated     []kong.Route
+}
+
+func (v *recordingRoutesValidator) Validate(_ context.Context, r *kong.Route) (bool, string, error) {
+	v.validated = append(v.validated, *r)
+	if msg, ok := v.invalidRoutes[*r.Name]; ok {
+		return false, msg, nil
+	}
+	return true, "", nil
+}
+
+func TestValidateGRPCRoute(t *testing.T) {
+	gatewayClass, gateway := managedGatewayObjects(builder.NewListener("grpc").HTTP().WithPort(80).Build())
+	parentRefs := []gatewayapi.ParentReference{{Name: gatewayapi.ObjectName(gateway.Name)}}
+	serviceBackendRefs := []gatewayapi.GRPCBackendRef{{BackendRef: builder.NewBackendRef("svc").WithPort(80).Build()}}
+
+	testCases := []struct {
+		name                     string
+		route                    *gatewayapi.GRPCRoute
+		features                 translator.FeatureFlags
+		invalidRoutes            map[string]string
+		expectedValid            bool
+		expectedMsg              string
+		expectedValidatedRoutes  int
+		expectedRouteExpressions []string
+	}{
+		{
+			name: "route not managed by the controller is accepted with no validations",
+			route: &gatewayapi.GRPCRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: corev1.NamespaceDefault, Name: "grpcroute"},
+				Spec: gatewayapi.GRPCRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{
+						ParentRefs: []gatewayapi.ParentReference{{Name: "not-existing-gateway"}},
+					},
+				},
+			},
+			expectedValid: true,
+		},
+		{
+			name: "valid route is validated against Kong Gateway",
+			route: &gatewayapi.GRPCRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: corev1.NamespaceDefault, Name: "grpcroute"},
+				Spec: gatewayapi.GRPCRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{ParentRefs: parentRefs},
+					Hostnames:       []gatewayapi.Hostname{"example.com"},
+					Rules: []gatewayapi.GRPCRouteRule{
+						{
+							Matches: []gatewayapi.GRPCRouteMatch{
+								{Method: &gatewayapi.GRPCMethodMatch{Service: lo.ToPtr("grpcbin.GRPCBin"), Method: lo.ToPtr("DummyUnary")}},
+								{Method: &gatewayapi.GRPCMethodMatch{Service: lo.ToPtr("grpcbin.GRPCBin")}},
+							},
+							BackendRefs: serviceBackendRefs,
+						},
+					},
+				},
+			},
+			expectedValid:           true,
+			expectedValidatedRoutes: 2,
+		},
+		{
+			name: "route rejected by Kong Gateway is rejected",
+			route: &gatewayapi.GRPCRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: corev1.NamespaceDefault, Name: "grpcroute"},
+				Spec: gatewayapi.GRPCRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{ParentRefs: parentRefs},
+					Rules: []gatewayapi.GRPCRouteRule{
+						{
+							Matches: []gatewayapi.GRPCRouteMatch{
+								{
+									Method: &gatewayapi.GRPCMethodMatch{
+										Type:    lo.ToPtr(gatewayapi.GRPCMethodMatchRegularExpression),
+										Service: lo.ToPtr("grpcbin.GRPCBin("),
+									},
+								},
+							},
+							BackendRefs: serviceBackendRefs,
+						},
+					},
+				},
+			},
+			features:                 translator.FeatureFlags{ExpressionRoutes: true},
+			invalidRoutes:            map[string]string{"grpcroute.default.grpcroute.0.0": "invalid regex"},
+			expectedValid:            false,
+			expectedMsg:              "GRPCRoute failed schema validation: invalid regex",
+			expectedValidatedRoutes:  1,
+			expectedRouteExpressions: []string{`http.path ~ "^/grpcbin.GRPCBin(/.+"`},
+		},
+		{
+			name: "regular expression header match is rejected with traditional router",
+			route: &gatewayapi.GRPCRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: corev1.NamespaceDefault, Name: "grpcroute"},
+				Spec: gatewayapi.GRPCRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{ParentRefs: parentRefs},
+					Rules: []gatewayapi.GRPCRouteRule{
+						{
+							Matches: []gatewayapi.GRPCRouteMatch{
+								{
+									Headers: []gatewayapi.GRPCHeaderMatch{
+										{Type: lo.ToPtr(gatewayapi.HeaderMatchRegularExpression), Name: "x-foo", Value: "ba.*"},
+									},
+								},
+							},
+							BackendRefs: serviceBackendRefs,
+						},
+					},
+				},
+			},
+			expectedValid: false,
+			expectedMsg: "GRPCRoute spec did not pass validation: rules[0].matches[0].headers[0]: " +
+				"regular expression header matching is supported with expression router only",
+		},
+		{
+			name: "regular expression header match is accepted with expression router",
+			route: &gatewayapi.GRPCRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: corev1.NamespaceDefault, Name: "grpcroute"},
+				Spec: gatewayapi.GRPCRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{ParentRefs: parentRefs},
+					Rules: []gatewayapi.GRPCRouteRule{
+						{
+							Matches: []gatewayapi.GRPCRouteMatch{
+								{
+									Headers: []gatewayapi.GRPCHeaderMatch{
+										{Type: lo.ToPtr(gatewayapi.HeaderMatchRegularExpression), Name: "x-foo", Value: "ba.*"},
+									},
+								},
+							},
+							BackendRefs: serviceBackendRefs,
+						},
+					},
+				},
+			},
+			features:                translator.FeatureFlags{ExpressionRoutes: true},
+			expectedValid:           true,
+			expectedValidatedRoutes: 1,
+		},
+		{
+			name: "unsupported backendRef kind is rejected",
+			route: &gatewayapi.GRPCRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: corev1.NamespaceDefault, Name: "grpcroute"},
+				Spec: gatewayapi.GRPCRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{ParentRefs: parentRefs},
+					Rules: []gatewayapi.GRPCRouteRule{
+						{
+							BackendRefs: []gatewayapi.GRPCBackendRef{
+								{BackendRef: builder.NewBackendRef("pod").WithKind("Pod").Build()},
+							},
+						},
+					},
+				},
+			},
+			expectedValid: false,
+			expectedMsg: "GRPCRoute spec did not pass validation: rules[0].backendRefs[0]: " +
+				"Pod is not a supported kind for grpcroute backendRefs, only Service is supported",
+		},
+		{
+			name: "invalid Kong annotations are rejected",
+			route: &gatewayapi.GRPCRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: corev1.NamespaceDefault,
+					Name:      "grpcroute",
+					Annotations: map[string]string{
+						annotations.AnnotationPrefix + annotations.ProtocolsKey: "ohno",
+					},
+				},
+				Spec: gatewayapi.GRPCRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{ParentRefs: parentRefs},
+					Rules: []gatewayapi.GRPCRouteRule{
+						{BackendRefs: serviceBackendRefs},
+					},
+				},
+			},
+			expectedValid: false,
+			expectedMsg:   "GRPCRoute has invalid Kong annotations: invalid konghq.com/protocols value: ohno",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fakeclient.
+				NewClientBuilder().
+				WithScheme(lo.Must(scheme.Get())).
+				WithObjects([]client.Object{gatewayClass, gateway}...).
+				Build()
+			storer, err := store.NewFakeStore(store.FakeObjects{Gateways: []*gatewayapi.Gateway{gateway}})
+			require.NoError(t, err)
+			routesValidator := &recordingRoutesValidator{invalidRoutes: tc.invalidRoutes}
+
+			valid, msg, err := ValidateGRPCRoute(context.Background(), routesValidator, tc.features, tc.route, fakeClient, storer)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedValid, valid)
+			assert.Equal(t, tc.expectedMsg, msg)
+			assert.Len(t, routesValidator.validated, tc.expectedValidatedRoutes)
+			for i, expression := range tc.expectedRouteExpressions {
+				assert.Equal(t, expression, *routesValidator.validated[i].Expression)
+			}
+		})
+	}
+}
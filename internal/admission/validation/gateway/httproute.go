@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -14,6 +15,8 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/featuregates"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 type routeValidator interface {
@@ -37,7 +40,7 @@ func ValidateHTTPRoute(
 	managerClient client.Client,
 ) (bool, string, error) {
 	// Check if route is managed by this controller. If not, we don't need to validate it.
-	routeIsManaged, err := ensureHTTPRouteIsManagedByController(ctx, httproute, managerClient)
+	routeIsManaged, err := ensureRouteIsManagedByController(ctx, httproute.Namespace, httproute.Spec.ParentRefs, managerClient)
 	if err != nil {
 		return false, "", fmt.Errorf("failed to determine whether HTTPRoute is managed by %q controller: %w",
 			gatewaycontroller.GetControllerName(), err)
@@ -46,10 +49,6 @@ func ValidateHTTPRoute(
 		return true, "", nil
 	}
 
-	if err := validateHTTPRouteTimeoutBackendRequest(httproute); err != nil {
-		return false, fmt.Sprintf("HTTPRoute spec did not pass validation: %s", err), nil
-	}
-
 	// Validate that no unsupported features are in use.
 	if err := validateHTTPRouteFeatures(httproute, translatorFeatures); err != nil {
 		return false, fmt.Sprintf("HTTPRoute spec did not pass validation: %s", err), nil
@@ -59,6 +58,9 @@ func ValidateHTTPRoute(
 	if err := validation.ValidateRouteSourceAnnotations(httproute); err != nil {
 		return false, fmt.Sprintf("HTTPRoute has invalid Kong annotations: %s", err), nil
 	}
+	if _, err := translator.HTTPRouteRetries(httproute); err != nil {
+		return false, fmt.Sprintf("HTTPRoute has invalid Kong annotations: %s", err), nil
+	}
 
 	// Validate that the route is valid against Kong Gateway.
 	ok, msg := validateWithKongGateway(ctx, routesValidator, translatorFeatures, httproute)
@@ -77,11 +79,14 @@ func parentRefIsGateway(parentRef gatewayapi.ParentReference) bool {
 		(parentRef.Kind == nil || (*parentRef.Kind == "" || *parentRef.Kind == KindGateway))
 }
 
-// ensureHTTPRouteIsManagedByController checks whether the provided HTTPRoute is managed by this controller implementation.
-func ensureHTTPRouteIsManagedByController(ctx context.Context, httproute *gatewayapi.HTTPRoute, managerClient client.Client) (bool, error) {
-	// In order to be sure whether an HTTPRoute resource is managed by this
+// ensureRouteIsManagedByController checks whether a route from the provided namespace with the provided parentRefs
+// is managed by this controller implementation.
+func ensureRouteIsManagedByController(
+	ctx context.Context, routeNamespace string, parentRefs []gatewayapi.ParentReference, managerClient client.Client,
+) (bool, error) {
+	// In order to be sure whether a route resource is managed by this
 	// controller we ignore references to Gateway resources that do not exist.
-	for _, parentRef := range httproute.Spec.ParentRefs {
+	for _, parentRef := range parentRefs {
 		// Skip the parentRefs that are not Gateways because they cannot refer to the controller.
 		// https://github.com/Kong/kubernetes-ingress-controller/issues/5912
 		if !parentRefIsGateway(parentRef) {
@@ -90,7 +95,7 @@ func ensureHTTPRouteIsManagedByController(ctx context.Context, httproute *gatewa
 
 		// Determine the namespace of the gateway referenced via parentRef. If no
 		// explicit namespace is provided, assume the namespace of the route.
-		namespace := httproute.Namespace
+		namespace := routeNamespace
 		if parentRef.Namespace != nil {
 			namespace = string(*parentRef.Namespace)
 		}
@@ -123,7 +128,7 @@ func ensureHTTPRouteIsManagedByController(ctx context.Context, httproute *gatewa
 		}
 	}
 
-	// If we get here, the HTTPRoute is not managed by this controller.
+	// If we get here, the route is not managed by this controller.
 	return false, nil
 }
 
@@ -131,23 +136,7 @@ func ensureHTTPRouteIsManagedByController(ctx context.Context, httproute *gatewa
 // HTTPRoute implementation and validates that the provided object is not using
 // any of those unsupported features.
 func validateHTTPRouteFeatures(httproute *gatewayapi.HTTPRoute, translatorFeatures translator.FeatureFlags) error {
-	unsupportedFilterMap := map[gatewayapi.HTTPRouteFilterType]struct{}{
-		gatewayapi.HTTPRouteFilterRequestMirror: {},
-	}
-	const (
-		KindService = gatewayapi.Kind("Service")
-	)
-
 	for ruleIndex, rule := range httproute.Spec.Rules {
-		// Filter RequestMirror is not supported.
-
-		for filterIndex, filter := range rule.Filters {
-			if _, unsupported := unsupportedFilterMap[filter.Type]; unsupported {
-				return fmt.Errorf("rules[%d].filters[%d]: filter type %s is unsupported",
-					ruleIndex, filterIndex, filter.Type)
-			}
-		}
-
 		for refIndex, ref := range rule.BackendRefs {
 			// Specifying filters in backendRef is not supported.
 			if len(ref.Filters) != 0 {
@@ -155,14 +144,9 @@ func validateHTTPRouteFeatures(httproute *gatewayapi.HTTPRoute, translatorFeatur
 					ruleIndex, refIndex)
 			}
 
-			// We don't support any backendRef types except Kubernetes Services.
-			if ref.BackendRef.Group != nil && *ref.BackendRef.Group != "core" && *ref.BackendRef.Group != "" {
-				return fmt.Errorf("rules[%d].backendRefs[%d]: %s is not a supported group for httproute backendRefs, only core is supported",
-					ruleIndex, refIndex, *ref.BackendRef.Group)
-			}
-			if ref.BackendRef.Kind != nil && *ref.BackendRef.Kind != KindService {
-				return fmt.Errorf("rules[%d].backendRefs[%d]: %s is not a supported kind for httproute backendRefs, only %s is supported",
-					ruleIndex, refIndex, *ref.BackendRef.Kind, KindService)
+			// We don't support any backendRef types except Kubernetes Services, KongServiceFacades and KongUpstreamTargets.
+			if err := validateHTTPRouteBackendRefGroupKind(ref.BackendRef, translatorFeatures); err != nil {
+				return fmt.Errorf("rules[%d].backendRefs[%d]: %w", ruleIndex, refIndex, err)
 			}
 		}
 
@@ -183,6 +167,48 @@ func validateHTTPRouteFeatures(httproute *gatewayapi.HTTPRoute, translatorFeatur
 // Validation - HTTPRoute - Private Utility Functions
 // -----------------------------------------------------------------------------
 
+// validateHTTPRouteBackendRefGroupKind checks whether the backendRef refers to a supported Group and Kind. KongServiceFacade
+// and KongUpstreamTarget are supported only when their respective feature gates are enabled.
+func validateHTTPRouteBackendRefGroupKind(ref gatewayapi.BackendRef, translatorFeatures translator.FeatureFlags) error {
+	return validateRouteBackendRefGroupKind("httproute", ref, translatorFeatures)
+}
+
+// validateRouteBackendRefGroupKind checks whether the backendRef of a route of the given kind refers to a supported
+// Group and Kind.
+func validateRouteBackendRefGroupKind(routeKind string, ref gatewayapi.BackendRef, translatorFeatures translator.FeatureFlags) error {
+	const (
+		KindService = gatewayapi.Kind("Service")
+	)
+
+	if ref.Group != nil && *ref.Group == gatewayapi.Group(incubatorv1alpha1.GroupVersion.Group) {
+		kind := lo.FromPtr(ref.Kind)
+		switch kind {
+		case incubatorv1alpha1.KongServiceFacadeKind:
+			if !translatorFeatures.KongServiceFacade {
+				return fmt.Errorf("%s backendRefs require the %q feature gate to be enabled", kind, featuregates.KongServiceFacade)
+			}
+		case incubatorv1alpha1.KongUpstreamTargetKind:
+			if !translatorFeatures.KongUpstreamTarget {
+				return fmt.Errorf("%s backendRefs require the %q feature gate to be enabled", kind, featuregates.KongUpstreamTarget)
+			}
+		default:
+			return fmt.Errorf("%s is not a supported kind for %s backendRefs of group %s, only %s and %s are supported",
+				kind, routeKind, *ref.Group, incubatorv1alpha1.KongServiceFacadeKind, incubatorv1alpha1.KongUpstreamTargetKind)
+		}
+		return nil
+	}
+
+	if ref.Group != nil && *ref.Group != "core" && *ref.Group != "" {
+		return fmt.Errorf("%s is not a supported group for %s backendRefs, only core and %s are supported",
+			*ref.Group, routeKind, incubatorv1alpha1.GroupVersion.Group)
+	}
+	if ref.Kind != nil && *ref.Kind != KindService {
+		return fmt.Errorf("%s is not a supported kind for %s backendRefs, only %s is supported",
+			*ref.Kind, routeKind, KindService)
+	}
+	return nil
+}
+
 func validateWithKongGateway(
 	ctx context.Context, routesValidator routeValidator, translatorFeatures translator.FeatureFlags, httproute *gatewayapi.HTTPRoute,
 ) (bool, string) {
@@ -208,47 +234,33 @@ func validateWithKongGateway(
 		}
 	}
 	if len(errMsgs) > 0 {
-		return false, validationMsg(errMsgs)
+		return false, validationMsg("HTTPRoute", errMsgs)
 	}
-	// Validate by using feature of Kong Gateway.
+	return validateRoutesWithKongGateway(ctx, routesValidator, "HTTPRoute", kongRoutes)
+}
+
+// validateRoutesWithKongGateway validates Kong Routes translated from a route of the given kind against
+// the Kong Gateway's routes schema.
+func validateRoutesWithKongGateway(
+	ctx context.Context, routesValidator routeValidator, routeKind string, kongRoutes []kong.Route,
+) (bool, string) {
+	var errMsgs []string
 	for _, kg := range kongRoutes {
 		kg := kg
 		ok, msg, err := routesValidator.Validate(ctx, &kg)
 		if err != nil {
-			return false, fmt.Sprintf("Unable to validate HTTPRoute schema: %s", err.Error())
+			return false, fmt.Sprintf("Unable to validate %s schema: %s", routeKind, err.Error())
 		}
 		if !ok {
 			errMsgs = append(errMsgs, msg)
 		}
 	}
 	if len(errMsgs) > 0 {
-		return false, validationMsg(errMsgs)
+		return false, validationMsg(routeKind, errMsgs)
 	}
 	return true, ""
 }
 
-func validationMsg(errMsgs []string) string {
-	return fmt.Sprintf("HTTPRoute failed schema validation: %s", strings.Join(errMsgs, ", "))
-}
-
-func validateHTTPRouteTimeoutBackendRequest(httproute *gatewayapi.HTTPRoute) error {
-	// TODO: remove the validate after we figure out how to handle granular timeout settings
-	// (allowing setting timeouts per rule and not enforcing the same timeout for every HTTPRoute's rule).
-	// https://github.com/Kong/kubernetes-ingress-controller/issues/5451
-
-	var firstTimeoutFound *gatewayapi.Duration
-	for _, rule := range httproute.Spec.Rules {
-		if firstTimeoutFound != nil {
-			if rule.Timeouts == nil {
-				return fmt.Errorf("timeout is set for one of the rules, but not set for another")
-			}
-			if rule.Timeouts != nil && *rule.Timeouts.BackendRequest != *firstTimeoutFound {
-				return fmt.Errorf("timeout is set for one of the rules, but a different value is set in another rule")
-			}
-		} else if rule.Timeouts != nil && rule.Timeouts.BackendRequest != nil {
-			firstTimeoutFound = rule.Timeouts.BackendRequest
-		}
-	}
-
-	return nil
+func validationMsg(routeKind string, errMsgs []string) string {
+	return fmt.Sprintf("%s failed schema validation: %s", routeKind, strings.Join(errMsgs, ", "))
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/kong/go-kong/kong"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,7 +18,9 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/scheme"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 func TestValidateHTTPRoute(t *testing.T) {
@@ -398,7 +401,7 @@ func TestValidateHTTPRoute(t *testing.T) {
 				},
 			},
 			valid:         false,
-			validationMsg: "HTTPRoute spec did not pass validation: rules[0].backendRefs[0]: example is not a supported group for httproute backendRefs, only core is supported",
+			validationMsg: "HTTPRoute spec did not pass validation: rules[0].backendRefs[0]: example is not a supported group for httproute backendRefs, only core and incubator.ingress-controller.konghq.com are supported",
 		},
 		{
 			msg: "we don't support any core kind except Service for backendRefs",
@@ -461,7 +464,7 @@ func TestValidateHTTPRoute(t *testing.T) {
 			validationMsg: "HTTPRoute spec did not pass validation: rules[0].backendRefs[0]: Pod is not a supported kind for httproute backendRefs, only Service is supported",
 		},
 		{
-			msg: "we do not support RequestMirror filter",
+			msg: "RequestMirror filter targeting a Service is accepted",
 			route: &gatewayapi.HTTPRoute{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: corev1.NamespaceDefault,
@@ -492,6 +495,82 @@ func TestValidateHTTPRoute(t *testing.T) {
 						Filters: []gatewayapi.HTTPRouteFilter{
 							{
 								Type: gatewayapi.HTTPRouteFilterRequestMirror,
+								RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+									BackendRef: gatewayapi.BackendObjectReference{
+										Name: "service2",
+										Kind: lo.ToPtr(gatewayapi.Kind("Service")),
+										Port: lo.ToPtr(gatewayapi.PortNumber(80)),
+									},
+								},
+							},
+						},
+					}},
+				},
+			},
+			cachedObjects: []client.Object{
+				gatewayClass,
+				&gatewayapi.Gateway{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: corev1.NamespaceDefault,
+						Name:      "testing-gateway",
+					},
+					Spec: gatewayapi.GatewaySpec{
+						GatewayClassName: gatewayClassName,
+						Listeners: []gatewayapi.Listener{{
+							Name:     "http",
+							Port:     80,
+							Protocol: (gatewayapi.HTTPProtocolType),
+							AllowedRoutes: &gatewayapi.AllowedRoutes{
+								Kinds: []gatewayapi.RouteGroupKind{{
+									Group: &group,
+									Kind:  "HTTPRoute",
+								}},
+							},
+						}},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			msg: "RequestMirror filter targeting an unsupported kind is rejected",
+			route: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: corev1.NamespaceDefault,
+					Name:      "testing-httproute",
+				},
+				Spec: gatewayapi.HTTPRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{
+						ParentRefs: []gatewayapi.ParentReference{{
+							Name: "testing-gateway",
+						}},
+					},
+					Rules: []gatewayapi.HTTPRouteRule{{
+						Matches: []gatewayapi.HTTPRouteMatch{{
+							Headers: []gatewayapi.HTTPHeaderMatch{{
+								Name:  "Content-Type",
+								Value: "audio/vorbis",
+							}},
+						}},
+						BackendRefs: []gatewayapi.HTTPBackendRef{
+							{
+								BackendRef: gatewayapi.BackendRef{
+									BackendObjectReference: gatewayapi.BackendObjectReference{
+										Name: "service1",
+									},
+								},
+							},
+						},
+						Filters: []gatewayapi.HTTPRouteFilter{
+							{
+								Type: gatewayapi.HTTPRouteFilterRequestMirror,
+								RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+									BackendRef: gatewayapi.BackendObjectReference{
+										Name: "service2",
+										Kind: &podKind,
+										Port: lo.ToPtr(gatewayapi.PortNumber(80)),
+									},
+								},
 							},
 						},
 					}},
@@ -521,10 +600,10 @@ func TestValidateHTTPRoute(t *testing.T) {
 				},
 			},
 			valid:         false,
-			validationMsg: "HTTPRoute spec did not pass validation: rules[0].filters[0]: filter type RequestMirror is unsupported",
+			validationMsg: "HTTPRoute failed schema validation: RequestMirror backendRef kind Pod unsupported",
 		},
 		{
-			msg: "we only support setting the timeout to the same value",
+			msg: "setting the timeout to the same value in all rules",
 			route: &gatewayapi.HTTPRoute{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: corev1.NamespaceDefault,
@@ -602,7 +681,7 @@ func TestValidateHTTPRoute(t *testing.T) {
 			valid: true,
 		},
 		{
-			msg: "we don't support setting the timeout to different value",
+			msg: "we support setting the timeout to different values in different rules",
 			route: &gatewayapi.HTTPRoute{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: corev1.NamespaceDefault,
@@ -678,8 +757,7 @@ func TestValidateHTTPRoute(t *testing.T) {
 					},
 				},
 			},
-			valid:         false,
-			validationMsg: "HTTPRoute spec did not pass validation: timeout is set for one of the rules, but a different value is set in another rule",
+			valid: true,
 		},
 		{
 			msg: "we do not support filters in backendRefs",
@@ -789,6 +867,51 @@ func TestValidateHTTPRoute(t *testing.T) {
 			valid:         false,
 			validationMsg: "HTTPRoute has invalid Kong annotations: invalid konghq.com/protocols value: ohno",
 		},
+		{
+			msg: "invalid retries",
+			route: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: corev1.NamespaceDefault,
+					Name:      "testing-httproute",
+					Annotations: map[string]string{
+						annotations.AnnotationPrefix + annotations.RetriesKey: "many",
+					},
+				},
+				Spec: gatewayapi.HTTPRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{
+						ParentRefs: []gatewayapi.ParentReference{{
+							Name: "testing-gateway",
+						}},
+					},
+					Rules: []gatewayapi.HTTPRouteRule{},
+				},
+			},
+			cachedObjects: []client.Object{
+				gatewayClass,
+				&gatewayapi.Gateway{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: corev1.NamespaceDefault,
+						Name:      "testing-gateway",
+					},
+					Spec: gatewayapi.GatewaySpec{
+						GatewayClassName: gatewayClassName,
+						Listeners: []gatewayapi.Listener{{
+							Name:     "http",
+							Port:     80,
+							Protocol: (gatewayapi.HTTPProtocolType),
+							AllowedRoutes: &gatewayapi.AllowedRoutes{
+								Kinds: []gatewayapi.RouteGroupKind{{
+									Group: &group,
+									Kind:  "HTTPRoute",
+								}},
+							},
+						}},
+					},
+				},
+			},
+			valid:         false,
+			validationMsg: `HTTPRoute has invalid Kong annotations: konghq.com/retries annotation must be an integer between 0 and 32767, got "many"`,
+		},
 		{
 			msg: "HTTPRoute URLRewrite ReplaceFullPath",
 			route: &gatewayapi.HTTPRoute{
@@ -970,6 +1093,75 @@ func TestValidateHTTPRoute(t *testing.T) {
 	}
 }
 
+func TestValidateHTTPRouteBackendRefGroupKind(t *testing.T) {
+	incubatorGroup := gatewayapi.Group(incubatorv1alpha1.GroupVersion.Group)
+	testCases := []struct {
+		name          string
+		ref           gatewayapi.BackendRef
+		features      translator.FeatureFlags
+		expectedError string
+	}{
+		{
+			name: "Service is accepted",
+			ref:  builder.NewBackendRef("svc").WithPort(80).Build(),
+		},
+		{
+			name: "KongServiceFacade is accepted when feature flag is enabled",
+			ref: builder.NewBackendRef("facade").
+				WithGroup(string(incubatorGroup)).
+				WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+				Build(),
+			features: translator.FeatureFlags{KongServiceFacade: true},
+		},
+		{
+			name: "KongServiceFacade is rejected when feature flag is disabled",
+			ref: builder.NewBackendRef("facade").
+				WithGroup(string(incubatorGroup)).
+				WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+				Build(),
+			expectedError: `KongServiceFacade backendRefs require the "KongServiceFacade" feature gate to be enabled`,
+		},
+		{
+			name: "KongUpstreamTarget is accepted when feature flag is enabled",
+			ref: builder.NewBackendRef("targets").
+				WithGroup(string(incubatorGroup)).
+				WithKind(incubatorv1alpha1.KongUpstreamTargetKind).
+				Build(),
+			features: translator.FeatureFlags{KongUpstreamTarget: true},
+		},
+		{
+			name: "KongUpstreamTarget is rejected when feature flag is disabled",
+			ref: builder.NewBackendRef("targets").
+				WithGroup(string(incubatorGroup)).
+				WithKind(incubatorv1alpha1.KongUpstreamTargetKind).
+				Build(),
+			features:      translator.FeatureFlags{KongServiceFacade: true},
+			expectedError: `KongUpstreamTarget backendRefs require the "KongUpstreamTarget" feature gate to be enabled`,
+		},
+		{
+			name: "unknown kind of incubator group is rejected",
+			ref: builder.NewBackendRef("unknown").
+				WithGroup(string(incubatorGroup)).
+				WithKind("Unknown").
+				Build(),
+			features: translator.FeatureFlags{KongServiceFacade: true, KongUpstreamTarget: true},
+			expectedError: "Unknown is not a supported kind for httproute backendRefs of group " +
+				"incubator.ingress-controller.konghq.com, only KongServiceFacade and KongUpstreamTarget are supported",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateHTTPRouteBackendRefGroupKind(tc.ref, tc.features)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
 type mockRoutesValidator struct{}
 
 func (mockRoutesValidator) Validate(_ context.Context, _ *kong.Route) (bool, string, error) {
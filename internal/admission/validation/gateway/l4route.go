@@ -0,0 +1,153 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/admission/validation"
+	gatewaycontroller "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/gateway"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+)
+
+// -----------------------------------------------------------------------------
+// Validation - TCPRoute, UDPRoute and TLSRoute - Public Functions
+// -----------------------------------------------------------------------------
+
+// ValidateTCPRoute provides a suite of validation for a given TCPRoute. It checks
+// supported features and uses provided routesValidator to validate the route against
+// Kong Gateway validation endpoint.
+func ValidateTCPRoute(
+	ctx context.Context,
+	routesValidator routeValidator,
+	translatorFeatures translator.FeatureFlags,
+	tcproute *gatewayapi.TCPRoute,
+	managerClient client.Client,
+	logger logr.Logger,
+	storer store.Storer,
+) (bool, string, error) {
+	return validateL4Route(ctx, routesValidator, translatorFeatures, managerClient, l4Route{
+		kind:       "TCPRoute",
+		object:     tcproute,
+		parentRefs: tcproute.Spec.ParentRefs,
+		rulesBackendRefs: lo.Map(tcproute.Spec.Rules, func(r gatewayapi.TCPRouteRule, _ int) []gatewayapi.BackendRef {
+			return r.BackendRefs
+		}),
+		generateRoutes: func() ([]kongstate.Route, error) {
+			return translator.GenerateKongRoutesFromL4Route(logger, tcproute, storer, translatorFeatures.ExpressionRoutes)
+		},
+	})
+}
+
+// ValidateUDPRoute provides a suite of validation for a given UDPRoute. It checks
+// supported features and uses provided routesValidator to validate the route against
+// Kong Gateway validation endpoint.
+func ValidateUDPRoute(
+	ctx context.Context,
+	routesValidator routeValidator,
+	translatorFeatures translator.FeatureFlags,
+	udproute *gatewayapi.UDPRoute,
+	managerClient client.Client,
+	logger logr.Logger,
+	storer store.Storer,
+) (bool, string, error) {
+	return validateL4Route(ctx, routesValidator, translatorFeatures, managerClient, l4Route{
+		kind:       "UDPRoute",
+		object:     udproute,
+		parentRefs: udproute.Spec.ParentRefs,
+		rulesBackendRefs: lo.Map(udproute.Spec.Rules, func(r gatewayapi.UDPRouteRule, _ int) []gatewayapi.BackendRef {
+			return r.BackendRefs
+		}),
+		generateRoutes: func() ([]kongstate.Route, error) {
+			return translator.GenerateKongRoutesFromL4Route(logger, udproute, storer, translatorFeatures.ExpressionRoutes)
+		},
+	})
+}
+
+// ValidateTLSRoute provides a suite of validation for a given TLSRoute. It checks
+// supported features and uses provided routesValidator to validate the route against
+// Kong Gateway validation endpoint.
+func ValidateTLSRoute(
+	ctx context.Context,
+	routesValidator routeValidator,
+	translatorFeatures translator.FeatureFlags,
+	tlsroute *gatewayapi.TLSRoute,
+	managerClient client.Client,
+	logger logr.Logger,
+	storer store.Storer,
+) (bool, string, error) {
+	return validateL4Route(ctx, routesValidator, translatorFeatures, managerClient, l4Route{
+		kind:       "TLSRoute",
+		object:     tlsroute,
+		parentRefs: tlsroute.Spec.ParentRefs,
+		rulesBackendRefs: lo.Map(tlsroute.Spec.Rules, func(r gatewayapi.TLSRouteRule, _ int) []gatewayapi.BackendRef {
+			return r.BackendRefs
+		}),
+		generateRoutes: func() ([]kongstate.Route, error) {
+			return translator.GenerateKongRoutesFromL4Route(logger, tlsroute, storer, translatorFeatures.ExpressionRoutes)
+		},
+	})
+}
+
+// -----------------------------------------------------------------------------
+// Validation - TCPRoute, UDPRoute and TLSRoute - Private Functions
+// -----------------------------------------------------------------------------
+
+// l4Route holds properties of a TCPRoute, UDPRoute or TLSRoute needed for its validation.
+type l4Route struct {
+	kind             string
+	object           client.Object
+	parentRefs       []gatewayapi.ParentReference
+	rulesBackendRefs [][]gatewayapi.BackendRef
+	generateRoutes   func() ([]kongstate.Route, error)
+}
+
+func validateL4Route(
+	ctx context.Context,
+	routesValidator routeValidator,
+	translatorFeatures translator.FeatureFlags,
+	managerClient client.Client,
+	route l4Route,
+) (bool, string, error) {
+	// Check if route is managed by this controller. If not, we don't need to validate it.
+	routeIsManaged, err := ensureRouteIsManagedByController(ctx, route.object.GetNamespace(), route.parentRefs, managerClient)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to determine whether %s is managed by %q controller: %w",
+			route.kind, gatewaycontroller.GetControllerName(), err)
+	}
+	if !routeIsManaged {
+		return true, "", nil
+	}
+
+	// Validate that no unsupported backendRefs are in use.
+	for ruleIndex, backendRefs := range route.rulesBackendRefs {
+		for refIndex, ref := range backendRefs {
+			if err := validateRouteBackendRefGroupKind(strings.ToLower(route.kind), ref, translatorFeatures); err != nil {
+				return false, fmt.Sprintf("%s spec did not pass validation: rules[%d].backendRefs[%d]: %s",
+					route.kind, ruleIndex, refIndex, err), nil
+			}
+		}
+	}
+
+	// Validate that the route uses only supported annotations.
+	if err := validation.ValidateRouteSourceAnnotations(route.object); err != nil {
+		return false, fmt.Sprintf("%s has invalid Kong annotations: %s", route.kind, err), nil
+	}
+
+	// Validate that the route is valid against Kong Gateway.
+	routes, err := route.generateRoutes()
+	if err != nil {
+		return false, fmt.Sprintf("%s spec did not pass validation: %s", route.kind, err), nil
+	}
+	kongRoutes := lo.Map(routes, func(r kongstate.Route, _ int) kong.Route { return r.Route })
+	ok, msg := validateRoutesWithKongGateway(ctx, routesValidator, route.kind, kongRoutes)
+	return ok, msg, nil
+}
@@ -0,0 +1,261 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/scheme"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+)
+
+func TestValidateL4Routes(t *testing.T) {
+	gatewayClass, gateway := managedGatewayObjects(
+		builder.NewListener("tcp").TCP().WithPort(9000).Build(),
+		builder.NewListener("udp").UDP().WithPort(9001).Build(),
+		builder.NewListener("tls").TLS().WithPort(9443).Build(),
+		builder.NewListener("tls-passthrough").TLS().WithPort(9444).WithTLSConfig(&gatewayapi.GatewayTLSConfig{
+			Mode: lo.ToPtr(gatewayapi.TLSModePassthrough),
+		}).Build(),
+	)
+	parentRefs := []gatewayapi.ParentReference{{Name: gatewayapi.ObjectName(gateway.Name)}}
+	backendRefs := []gatewayapi.BackendRef{builder.NewBackendRef("svc").WithPort(80).Build()}
+	objectMeta := metav1.ObjectMeta{Namespace: corev1.NamespaceDefault, Name: "route"}
+
+	type validateFunc func(context.Context, routeValidator, translator.FeatureFlags, client.Client, store.Storer) (bool, string, error)
+	tcpRoute := func(route *gatewayapi.TCPRoute) validateFunc {
+		return func(ctx context.Context, v routeValidator, f translator.FeatureFlags, c client.Client, s store.Storer) (bool, string, error) {
+			return ValidateTCPRoute(ctx, v, f, route, c, logr.Discard(), s)
+		}
+	}
+	udpRoute := func(route *gatewayapi.UDPRoute) validateFunc {
+		return func(ctx context.Context, v routeValidator, f translator.FeatureFlags, c client.Client, s store.Storer) (bool, string, error) {
+			return ValidateUDPRoute(ctx, v, f, route, c, logr.Discard(), s)
+		}
+	}
+	tlsRoute := func(route *gatewayapi.TLSRoute) validateFunc {
+		return func(ctx context.Context, v routeValidator, f translator.FeatureFlags, c client.Client, s store.Storer) (bool, string, error) {
+			return ValidateTLSRoute(ctx, v, f, route, c, logr.Discard(), s)
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		validate       validateFunc
+		features       translator.FeatureFlags
+		invalidRoutes  map[string]string
+		expectedValid  bool
+		expectedMsg    string
+		expectedRoutes []kong.Route
+	}{
+		{
+			name: "TCPRoute not managed by the controller is accepted with no validations",
+			validate: tcpRoute(&gatewayapi.TCPRoute{
+				ObjectMeta: objectMeta,
+				Spec: gatewayapi.TCPRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{
+						ParentRefs: []gatewayapi.ParentReference{{Name: "not-existing-gateway"}},
+					},
+				},
+			}),
+			expectedValid: true,
+		},
+		{
+			name: "valid TCPRoute is validated against Kong Gateway with Gateway's listening ports",
+			validate: tcpRoute(&gatewayapi.TCPRoute{
+				ObjectMeta: objectMeta,
+				Spec: gatewayapi.TCPRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{ParentRefs: parentRefs},
+					Rules:           []gatewayapi.TCPRouteRule{{BackendRefs: backendRefs}},
+				},
+			}),
+			expectedValid: true,
+			expectedRoutes: []kong.Route{
+				{
+					Name:         kong.String("tcproute.default.route.0.0"),
+					Protocols:    kong.StringSlice("tcp"),
+					Destinations: []*kong.CIDRPort{{Port: kong.Int(9000)}},
+				},
+			},
+		},
+		{
+			name: "TCPRoute is validated as expression route with expression router",
+			validate: tcpRoute(&gatewayapi.TCPRoute{
+				ObjectMeta: objectMeta,
+				Spec: gatewayapi.TCPRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{ParentRefs: parentRefs},
+					Rules:           []gatewayapi.TCPRouteRule{{BackendRefs: backendRefs}},
+				},
+			}),
+			features:      translator.FeatureFlags{ExpressionRoutes: true},
+			expectedValid: true,
+			expectedRoutes: []kong.Route{
+				{
+					Name:       kong.String("tcproute.default.route.0.0"),
+					Protocols:  kong.StringSlice("tcp"),
+					Expression: kong.String("net.dst.port == 9000"),
+				},
+			},
+		},
+		{
+			name: "TCPRoute rule without backendRefs is rejected",
+			validate: tcpRoute(&gatewayapi.TCPRoute{
+				ObjectMeta: objectMeta,
+				Spec: gatewayapi.TCPRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{ParentRefs: parentRefs},
+					Rules:           []gatewayapi.TCPRouteRule{{}},
+				},
+			}),
+			expectedValid: false,
+			expectedMsg:   "TCPRoute spec did not pass validation: TCPRoute rules must include at least one backendRef",
+		},
+		{
+			name: "UDPRoute without rules is rejected",
+			validate: udpRoute(&gatewayapi.UDPRoute{
+				ObjectMeta: objectMeta,
+				Spec: gatewayapi.UDPRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{ParentRefs: parentRefs},
+				},
+			}),
+			expectedValid: false,
+			expectedMsg:   "UDPRoute spec did not pass validation: no rules provided",
+		},
+		{
+			name: "UDPRoute rejected by Kong Gateway is rejected",
+			validate: udpRoute(&gatewayapi.UDPRoute{
+				ObjectMeta: objectMeta,
+				Spec: gatewayapi.UDPRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{ParentRefs: parentRefs},
+					Rules:           []gatewayapi.UDPRouteRule{{BackendRefs: backendRefs}},
+				},
+			}),
+			invalidRoutes: map[string]string{"udproute.default.route.0.0": "schema violation"},
+			expectedValid: false,
+			expectedMsg:   "UDPRoute failed schema validation: schema violation",
+			expectedRoutes: []kong.Route{
+				{
+					Name:         kong.String("udproute.default.route.0.0"),
+					Protocols:    kong.StringSlice("udp"),
+					Destinations: []*kong.CIDRPort{{Port: kong.Int(9001)}},
+				},
+			},
+		},
+		{
+			name: "valid TLSRoute is validated against Kong Gateway",
+			validate: tlsRoute(&gatewayapi.TLSRoute{
+				ObjectMeta: objectMeta,
+				Spec: gatewayapi.TLSRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{ParentRefs: parentRefs},
+					Hostnames:       []gatewayapi.Hostname{"example.com"},
+					Rules:           []gatewayapi.TLSRouteRule{{BackendRefs: backendRefs}},
+				},
+			}),
+			expectedValid: true,
+			expectedRoutes: []kong.Route{
+				{
+					Name:      kong.String("tlsroute.default.route.0.0"),
+					Protocols: kong.StringSlice("tls"),
+					SNIs:      kong.StringSlice("example.com"),
+				},
+			},
+		},
+		{
+			name: "TLSRoute attached to a Passthrough listener is validated with tls_passthrough protocol",
+			validate: tlsRoute(&gatewayapi.TLSRoute{
+				ObjectMeta: objectMeta,
+				Spec: gatewayapi.TLSRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{ParentRefs: parentRefs},
+					Hostnames:       []gatewayapi.Hostname{"example.com"},
+					Rules:           []gatewayapi.TLSRouteRule{{BackendRefs: backendRefs}},
+				},
+				Status: gatewayapi.TLSRouteStatus{
+					RouteStatus: gatewayapi.RouteStatus{
+						Parents: []gatewayapi.RouteParentStatus{
+							{
+								ParentRef: gatewayapi.ParentReference{
+									Name:        gatewayapi.ObjectName(gateway.Name),
+									SectionName: lo.ToPtr(gatewayapi.SectionName("tls-passthrough")),
+								},
+							},
+						},
+					},
+				},
+			}),
+			expectedValid: true,
+			expectedRoutes: []kong.Route{
+				{
+					Name:      kong.String("tlsroute.default.route.0.0"),
+					Protocols: kong.StringSlice("tls_passthrough"),
+					SNIs:      kong.StringSlice("example.com"),
+				},
+			},
+		},
+		{
+			name: "TLSRoute without hostnames is rejected",
+			validate: tlsRoute(&gatewayapi.TLSRoute{
+				ObjectMeta: objectMeta,
+				Spec: gatewayapi.TLSRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{ParentRefs: parentRefs},
+					Rules:           []gatewayapi.TLSRouteRule{{BackendRefs: backendRefs}},
+				},
+			}),
+			expectedValid: false,
+			expectedMsg:   "TLSRoute spec did not pass validation: no hostnames provided",
+		},
+		{
+			name: "TLSRoute with unsupported backendRef group is rejected",
+			validate: tlsRoute(&gatewayapi.TLSRoute{
+				ObjectMeta: objectMeta,
+				Spec: gatewayapi.TLSRouteSpec{
+					CommonRouteSpec: gatewayapi.CommonRouteSpec{ParentRefs: parentRefs},
+					Hostnames:       []gatewayapi.Hostname{"example.com"},
+					Rules: []gatewayapi.TLSRouteRule{
+						{BackendRefs: []gatewayapi.BackendRef{builder.NewBackendRef("svc").WithGroup("example").Build()}},
+					},
+				},
+			}),
+			expectedValid: false,
+			expectedMsg: "TLSRoute spec did not pass validation: rules[0].backendRefs[0]: " +
+				"example is not a supported group for tlsroute backendRefs, only core and incubator.ingress-controller.konghq.com are supported",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fakeclient.
+				NewClientBuilder().
+				WithScheme(lo.Must(scheme.Get())).
+				WithObjects(gatewayClass, gateway).
+				Build()
+			storer, err := store.NewFakeStore(store.FakeObjects{Gateways: []*gatewayapi.Gateway{gateway}})
+			require.NoError(t, err)
+			routesValidator := &recordingRoutesValidator{invalidRoutes: tc.invalidRoutes}
+
+			valid, msg, err := tc.validate(context.Background(), routesValidator, tc.features, fakeClient, storer)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedValid, valid)
+			assert.Equal(t, tc.expectedMsg, msg)
+			require.Len(t, routesValidator.validated, len(tc.expectedRoutes))
+			for i, expected := range tc.expectedRoutes {
+				actual := routesValidator.validated[i]
+				assert.Equal(t, expected.Name, actual.Name)
+				assert.Equal(t, expected.Destinations, actual.Destinations)
+				assert.Equal(t, expected.SNIs, actual.SNIs)
+				assert.Equal(t, expected.Protocols, actual.Protocols)
+				assert.Equal(t, expected.Expression, actual.Expression)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package admission
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -19,6 +20,7 @@ import (
 	gatewayvalidation "github.com/kong/kubernetes-ingress-controller/v3/internal/admission/validation/gateway"
 	ingressvalidation "github.com/kong/kubernetes-ingress-controller/v3/internal/admission/validation/ingress"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/configuration"
 	gatewaycontroller "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/gateway"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
@@ -28,6 +30,7 @@ import (
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
 	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 // KongValidator validates Kong entities.
@@ -37,10 +40,16 @@ type KongValidator interface {
 	ValidatePlugin(ctx context.Context, plugin kongv1.KongPlugin, overrideSecrets []*corev1.Secret) (bool, string, error)
 	ValidateClusterPlugin(ctx context.Context, plugin kongv1.KongClusterPlugin, overrideSecrets []*corev1.Secret) (bool, string, error)
 	ValidateVault(ctx context.Context, vault kongv1alpha1.KongVault) (bool, string, error)
+	ValidateUpstreamPolicy(ctx context.Context, policy kongv1beta1.KongUpstreamPolicy, oldPolicy *kongv1beta1.KongUpstreamPolicy) (bool, string, error)
+	ValidateUpstreamPolicyConflicts(ctx context.Context, obj client.Object, oldObj client.Object) (bool, string, error)
 	ValidateCustomEntity(ctx context.Context, entity kongv1alpha1.KongCustomEntity) (bool, string, error)
 	ValidateCredential(ctx context.Context, secret corev1.Secret) (bool, string)
 	ValidateGateway(ctx context.Context, gateway gatewayapi.Gateway) (bool, string, error)
 	ValidateHTTPRoute(ctx context.Context, httproute gatewayapi.HTTPRoute) (bool, string, error)
+	ValidateGRPCRoute(ctx context.Context, grpcroute gatewayapi.GRPCRoute) (bool, string, error)
+	ValidateTCPRoute(ctx context.Context, tcproute gatewayapi.TCPRoute) (bool, string, error)
+	ValidateUDPRoute(ctx context.Context, udproute gatewayapi.UDPRoute) (bool, string, error)
+	ValidateTLSRoute(ctx context.Context, tlsroute gatewayapi.TLSRoute) (bool, string, error)
 	ValidateIngress(ctx context.Context, ingress netv1.Ingress) (bool, string, error)
 }
 
@@ -98,11 +107,20 @@ func NewSecretGetterWithOverride(s kongstate.SecretGetter, overrideSecrets []*co
 	}
 }
 
+// pluginConfigSourceGetter fetches Secrets and ConfigMaps plugin configuration is sourced from.
+type pluginConfigSourceGetter struct {
+	kongstate.SecretGetter
+	kongstate.ConfigMapGetter
+}
+
+var _ kongstate.ConfigSourceGetter = pluginConfigSourceGetter{}
+
 // KongHTTPValidator implements KongValidator interface to validate Kong
 // entities using the Admin API of Kong.
 type KongHTTPValidator struct {
 	Logger                   logr.Logger
 	SecretGetter             kongstate.SecretGetter
+	ConfigMapGetter          kongstate.ConfigMapGetter
 	ConsumerGetter           ConsumerGetter
 	Storer                   store.Storer
 	ManagerClient            client.Client
@@ -128,6 +146,7 @@ func NewKongHTTPValidator(
 	return KongHTTPValidator{
 		Logger:                   logger,
 		SecretGetter:             &managerClientSecretGetter{managerClient: managerClient},
+		ConfigMapGetter:          &managerClientConfigMapGetter{managerClient: managerClient},
 		ConsumerGetter:           &managerClientConsumerGetter{managerClient: managerClient},
 		Storer:                   storer,
 		ManagerClient:            managerClient,
@@ -189,6 +208,11 @@ func (validator KongHTTPValidator) ValidateConsumer(
 			return false, fmt.Sprintf("%s: %s", ErrTextConsumerCredentialValidationFailed, err), nil
 		}
 
+		// verify that credential fields referencing vaults use the ones configured by KongVaults
+		if err := credsvalidation.ValidateVaultReferences(secret, validator.vaultExists); err != nil {
+			return false, fmt.Sprintf("%s: %s", ErrTextConsumerCredentialValidationFailed, err), nil
+		}
+
 		// if valid, store it so we can index it for upcoming constraints validation
 		credentials = append(credentials, secret)
 
@@ -231,6 +255,16 @@ func (validator KongHTTPValidator) ValidateConsumerGroup(
 		return true, "", nil
 	}
 
+	// list existing KongConsumerGroups and reject if another one resolves to the same name in Kong.
+	name := kongstate.ConsumerGroupKongName(&consumerGroup)
+	dupeConsumerGroup, hasDupe := lo.Find(validator.Storer.ListKongConsumerGroups(), func(cg *kongv1beta1.KongConsumerGroup) bool {
+		return kongstate.ConsumerGroupKongName(cg) == name &&
+			(cg.Namespace != consumerGroup.Namespace || cg.Name != consumerGroup.Name)
+	})
+	if hasDupe {
+		return false, fmt.Sprintf(ErrTextConsumerGroupNameDuplicate, name, dupeConsumerGroup.Namespace, dupeConsumerGroup.Name), nil
+	}
+
 	infoSvc, ok := validator.AdminAPIServicesProvider.GetInfoService()
 	if !ok {
 		return true, "", nil
@@ -247,22 +281,38 @@ func (validator KongHTTPValidator) ValidateConsumerGroup(
 		return false, ErrTextConsumerGroupUnsupported, nil
 	}
 
-	cgs, ok := validator.AdminAPIServicesProvider.GetConsumerGroupsService()
-	if !ok {
-		return true, "", nil
+	if cgs, ok := validator.AdminAPIServicesProvider.GetConsumerGroupsService(); ok {
+		// This check forbids consumer group creation if the license is invalid or missing.
+		// There is no other way to robustly check the validity of a license than actually trying an enterprise feature.
+		if _, _, err := cgs.List(ctx, &kong.ListOpt{Size: 0}); err != nil {
+			switch {
+			case kong.IsNotFoundErr(err):
+				// This is the case when consumer group is not supported (Kong OSS) and previous version
+				// check (if !version.IsKongGatewayEnterprise()) has been omitted due to a parsing error.
+				return false, ErrTextConsumerGroupUnsupported, nil
+			case kong.IsForbiddenErr(err):
+				return false, ErrTextConsumerGroupUnlicensed, nil
+			default:
+				return false, fmt.Sprintf("%s: %s", ErrTextConsumerGroupUnexpected, err), nil
+			}
+		}
 	}
-	// This check forbids consumer group creation if the license is invalid or missing.
-	// There is no other way to robustly check the validity of a license than actually trying an enterprise feature.
-	if _, _, err := cgs.List(ctx, &kong.ListOpt{Size: 0}); err != nil {
-		switch {
-		case kong.IsNotFoundErr(err):
-			// This is the case when consumer group is not supported (Kong OSS) and previous version
-			// check (if !version.IsKongGatewayEnterprise()) has been omitted due to a parsing error.
-			return false, ErrTextConsumerGroupUnsupported, nil
-		case kong.IsForbiddenErr(err):
-			return false, ErrTextConsumerGroupUnlicensed, nil
-		default:
-			return false, fmt.Sprintf("%s: %s", ErrTextConsumerGroupUnexpected, err), nil
+
+	// Validate the plugins scoped to the consumer group the same way as KongPlugins.
+	for _, p := range consumerGroup.Spec.Plugins {
+		plugin := kong.Plugin{Name: kong.String(p.Name)}
+		plugin.Config, err = kongstate.RawConfigurationWithPatchesToConfiguration(nil, consumerGroup.Namespace, p.Config, nil)
+		if err != nil {
+			return false, fmt.Sprintf("%s: plugin %q: %s", ErrTextPluginConfigInvalid, p.Name, err), nil
+		}
+		errText, err := validator.validatePluginAgainstGatewaySchema(ctx, plugin)
+		if err != nil || errText != "" {
+			validator.Logger.Info("validate KongConsumerGroup plugin on Kong gateway failed",
+				"consumergroup", fmt.Sprintf("%s/%s", consumerGroup.Namespace, consumerGroup.Name),
+				"plugin", p.Name,
+				"error", err,
+			)
+			return false, fmt.Sprintf("plugin %q: %s", p.Name, errText), err
 		}
 	}
 	return true, "", nil
@@ -303,6 +353,11 @@ func (validator KongHTTPValidator) ValidateCredential(ctx context.Context, secre
 		return true, ""
 	}
 
+	// Vault references used by the credential must point to vaults configured by KongVaults.
+	if err := credsvalidation.ValidateVaultReferences(&secret, validator.vaultExists); err != nil {
+		return false, fmt.Sprintf("%s: %s", ErrTextConsumerCredentialValidationFailed, err)
+	}
+
 	// If base-level validation passed and the credential is referenced by a consumer,
 	// we move on to create an index of all managed credentials so that we can verify that
 	// the updates to this secret are not in violation of any unique key constraints.
@@ -335,10 +390,13 @@ func (validator KongHTTPValidator) ValidatePlugin(
 	plugin.Name = kong.String(k8sPlugin.PluginName)
 	var err error
 
-	secretGetter := NewSecretGetterWithOverride(validator.SecretGetter, overrideSecrets)
+	configSourceGetter := pluginConfigSourceGetter{
+		SecretGetter:    NewSecretGetterWithOverride(validator.SecretGetter, overrideSecrets),
+		ConfigMapGetter: validator.ConfigMapGetter,
+	}
 
 	plugin.Config, err = kongstate.RawConfigurationWithPatchesToConfiguration(
-		secretGetter,
+		configSourceGetter,
 		k8sPlugin.Namespace,
 		k8sPlugin.Config,
 		k8sPlugin.ConfigPatches,
@@ -347,9 +405,9 @@ func (validator KongHTTPValidator) ValidatePlugin(
 		return false, fmt.Sprintf("%s: %s", ErrTextPluginConfigInvalid, err), nil
 	}
 	if k8sPlugin.ConfigFrom != nil {
-		config, err := kongstate.SecretToConfiguration(secretGetter, k8sPlugin.ConfigFrom.SecretValue, k8sPlugin.Namespace)
+		config, err := kongstate.ConfigSourceToConfiguration(configSourceGetter, *k8sPlugin.ConfigFrom, k8sPlugin.Namespace)
 		if err != nil {
-			return false, fmt.Sprintf("%s: %s", ErrTextPluginSecretConfigUnretrievable, err), nil
+			return false, fmt.Sprintf("%s: %s", configFromUnretrievableErrText(k8sPlugin.ConfigFrom.ConfigMapValue != nil), err), nil
 		}
 		plugin.Config = config
 	}
@@ -382,9 +440,12 @@ func (validator KongHTTPValidator) ValidateClusterPlugin(
 	plugin.Name = kong.String(k8sPlugin.PluginName)
 	var err error
 
-	secretGetter := NewSecretGetterWithOverride(validator.SecretGetter, overrideSecrets)
+	configSourceGetter := pluginConfigSourceGetter{
+		SecretGetter:    NewSecretGetterWithOverride(validator.SecretGetter, overrideSecrets),
+		ConfigMapGetter: validator.ConfigMapGetter,
+	}
 	plugin.Config, err = kongstate.RawConfigurationWithNamespacedPatchesToConfiguration(
-		secretGetter,
+		configSourceGetter,
 		k8sPlugin.Config,
 		k8sPlugin.ConfigPatches,
 	)
@@ -393,9 +454,9 @@ func (validator KongHTTPValidator) ValidateClusterPlugin(
 	}
 
 	if k8sPlugin.ConfigFrom != nil {
-		config, err := kongstate.NamespacedSecretToConfiguration(secretGetter, k8sPlugin.ConfigFrom.SecretValue)
+		config, err := kongstate.NamespacedConfigSourceToConfiguration(configSourceGetter, *k8sPlugin.ConfigFrom)
 		if err != nil {
-			return false, fmt.Sprintf("%s: %s", ErrTextPluginSecretConfigUnretrievable, err), nil
+			return false, fmt.Sprintf("%s: %s", configFromUnretrievableErrText(k8sPlugin.ConfigFrom.ConfigMapValue != nil), err), nil
 		}
 		plugin.Config = config
 	}
@@ -442,12 +503,40 @@ func (validator KongHTTPValidator) ValidateGateway(
 func (validator KongHTTPValidator) ValidateHTTPRoute(
 	ctx context.Context, httproute gatewayapi.HTTPRoute,
 ) (bool, string, error) {
-	var routeValidator routeValidator = noOpRoutesValidator{}
-	if routesSvc, ok := validator.AdminAPIServicesProvider.GetRoutesService(); ok {
-		routeValidator = routesSvc
-	}
 	return gatewayvalidation.ValidateHTTPRoute(
-		ctx, routeValidator, validator.TranslatorFeatures, &httproute, validator.ManagerClient,
+		ctx, validator.routesValidator(), validator.TranslatorFeatures, &httproute, validator.ManagerClient,
+	)
+}
+
+func (validator KongHTTPValidator) ValidateGRPCRoute(
+	ctx context.Context, grpcroute gatewayapi.GRPCRoute,
+) (bool, string, error) {
+	return gatewayvalidation.ValidateGRPCRoute(
+		ctx, validator.routesValidator(), validator.TranslatorFeatures, &grpcroute, validator.ManagerClient, validator.Storer,
+	)
+}
+
+func (validator KongHTTPValidator) ValidateTCPRoute(
+	ctx context.Context, tcproute gatewayapi.TCPRoute,
+) (bool, string, error) {
+	return gatewayvalidation.ValidateTCPRoute(
+		ctx, validator.routesValidator(), validator.TranslatorFeatures, &tcproute, validator.ManagerClient, validator.Logger, validator.Storer,
+	)
+}
+
+func (validator KongHTTPValidator) ValidateUDPRoute(
+	ctx context.Context, udproute gatewayapi.UDPRoute,
+) (bool, string, error) {
+	return gatewayvalidation.ValidateUDPRoute(
+		ctx, validator.routesValidator(), validator.TranslatorFeatures, &udproute, validator.ManagerClient, validator.Logger, validator.Storer,
+	)
+}
+
+func (validator KongHTTPValidator) ValidateTLSRoute(
+	ctx context.Context, tlsroute gatewayapi.TLSRoute,
+) (bool, string, error) {
+	return gatewayvalidation.ValidateTLSRoute(
+		ctx, validator.routesValidator(), validator.TranslatorFeatures, &tlsroute, validator.ManagerClient, validator.Logger, validator.Storer,
 	)
 }
 
@@ -460,11 +549,7 @@ func (validator KongHTTPValidator) ValidateIngress(
 		return true, "", nil
 	}
 
-	var routeValidator routeValidator = noOpRoutesValidator{}
-	if routesSvc, ok := validator.AdminAPIServicesProvider.GetRoutesService(); ok {
-		routeValidator = routesSvc
-	}
-	return ingressvalidation.ValidateIngress(ctx, routeValidator, validator.TranslatorFeatures, &ingress, validator.Logger, validator.Storer)
+	return ingressvalidation.ValidateIngress(ctx, validator.routesValidator(), validator.TranslatorFeatures, &ingress, validator.Logger, validator.Storer)
 }
 
 type routeValidator interface {
@@ -473,6 +558,15 @@ type routeValidator interface {
 
 type noOpRoutesValidator struct{}
 
+// routesValidator returns the Kong Gateway routes service to validate routes with. If it's not available,
+// a no-op validator is returned.
+func (validator KongHTTPValidator) routesValidator() routeValidator {
+	if routesSvc, ok := validator.AdminAPIServicesProvider.GetRoutesService(); ok {
+		return routesSvc
+	}
+	return noOpRoutesValidator{}
+}
+
 func (noOpRoutesValidator) Validate(_ context.Context, _ *kong.Route) (bool, string, error) {
 	return true, "", nil
 }
@@ -512,6 +606,68 @@ func (validator KongHTTPValidator) ValidateVault(ctx context.Context, k8sKongVau
 	return true, "", nil
 }
 
+// ValidateUpstreamPolicy checks if the KongUpstreamPolicy can be applied to Services and KongServiceFacades
+// referencing it and if the Kong upstream it translates to is valid against Kong gateway's schema. oldPolicy is
+// the policy being updated, nil when the policy is created.
+func (validator KongHTTPValidator) ValidateUpstreamPolicy(
+	ctx context.Context,
+	policy kongv1beta1.KongUpstreamPolicy,
+	oldPolicy *kongv1beta1.KongUpstreamPolicy,
+) (bool, string, error) {
+	upstream := kongstate.TranslateKongUpstreamPolicy(policy.Spec)
+	if err := validateUpstreamHashing(upstream); err != nil {
+		return false, fmt.Sprintf(ErrTextUpstreamPolicyInvalid, err), nil
+	}
+
+	// Conflicts depend only on Services and KongServiceFacades referencing the policy and HTTPRoutes using them,
+	// not on the policy's spec, so an update can't introduce a new one.
+	if oldPolicy == nil {
+		users, err := validator.listUpstreamPolicyUsers()
+		if err != nil {
+			return false, ErrTextUpstreamPolicyConflictsUnresolved, err
+		}
+		policyKey := k8stypes.NamespacedName{Namespace: policy.Namespace, Name: policy.Name}
+		if conflicts := users.conflicts(policyKey); len(conflicts) > 0 {
+			return false, fmt.Sprintf(ErrTextUpstreamPolicyConflicted, strings.Join(conflicts, ", ")), nil
+		}
+	}
+
+	// Upstreams require a name, the one used here is only a placeholder.
+	upstream.Name = kong.String(fmt.Sprintf("%s.%s.svc", policy.Name, policy.Namespace))
+	errText, err := validator.validateUpstreamAgainstGatewaySchema(ctx, *upstream)
+	if err != nil || errText != "" {
+		return false, errText, err
+	}
+	return true, "", nil
+}
+
+// ValidateUpstreamPolicyConflicts checks that creating or updating an HTTPRoute, Service or KongServiceFacade
+// doesn't make a Service or KongServiceFacade referencing a KongUpstreamPolicy used in an HTTPRoute rule together
+// with backends not using the same KongUpstreamPolicy. oldObj is the object being updated, nil when the object is
+// created. Conflicts that existed before the change are not reported not to block unrelated updates.
+func (validator KongHTTPValidator) ValidateUpstreamPolicyConflicts(
+	_ context.Context,
+	obj client.Object,
+	oldObj client.Object,
+) (bool, string, error) {
+	users, err := validator.listUpstreamPolicyUsers()
+	if err != nil {
+		return false, ErrTextUpstreamPolicyConflictsUnresolved, err
+	}
+
+	key := client.ObjectKeyFromObject(obj)
+	users.set(obj, key, oldObj)
+	policies := users.referencedPolicies(obj, oldObj)
+	previousConflicts := users.conflicts(policies...)
+	users.set(obj, key, obj)
+	policies = lo.Uniq(append(policies, users.referencedPolicies(obj, oldObj)...))
+	conflicts := lo.Without(users.conflicts(policies...), previousConflicts...)
+	if len(conflicts) > 0 {
+		return false, fmt.Sprintf(ErrTextUpstreamPolicyConflicted, strings.Join(conflicts, ", ")), nil
+	}
+	return true, "", nil
+}
+
 // -----------------------------------------------------------------------------
 // KongHTTPValidator - Private Methods
 // -----------------------------------------------------------------------------
@@ -539,6 +695,13 @@ func (validator KongHTTPValidator) listManagedConsumers(ctx context.Context) ([]
 	return managedConsumers, nil
 }
 
+// vaultExists checks whether a KongVault with the given prefix is managed by the controller.
+func (validator KongHTTPValidator) vaultExists(prefix string) bool {
+	return lo.ContainsBy(validator.Storer.ListKongVaults(), func(vault *kongv1alpha1.KongVault) bool {
+		return vault.Spec.Prefix == prefix
+	})
+}
+
 func (validator KongHTTPValidator) ensureConsumerDoesNotExistInGateway(ctx context.Context, username string) (string, error) {
 	if consumerSvc, hasClient := validator.AdminAPIServicesProvider.GetConsumersService(); hasClient {
 		// verify that the consumer is not already present in the data-plane
@@ -589,6 +752,176 @@ func (validator KongHTTPValidator) validateVaultAgainstGatewaySchema(ctx context
 	return "", nil
 }
 
+// upstreamPolicyUsers holds Services, KongServiceFacades and HTTPRoutes that are checked for KongUpstreamPolicy
+// conflicts.
+type upstreamPolicyUsers struct {
+	services       map[k8stypes.NamespacedName]corev1.Service
+	serviceFacades map[k8stypes.NamespacedName]incubatorv1alpha1.KongServiceFacade
+	httpRoutes     map[k8stypes.NamespacedName]gatewayapi.HTTPRoute
+}
+
+// listUpstreamPolicyUsers returns Services, KongServiceFacades and HTTPRoutes from the validator's store.
+func (validator KongHTTPValidator) listUpstreamPolicyUsers() (upstreamPolicyUsers, error) {
+	users := upstreamPolicyUsers{
+		services:       make(map[k8stypes.NamespacedName]corev1.Service),
+		serviceFacades: make(map[k8stypes.NamespacedName]incubatorv1alpha1.KongServiceFacade),
+		httpRoutes:     make(map[k8stypes.NamespacedName]gatewayapi.HTTPRoute),
+	}
+	cacheStores := validator.Storer.CacheStores()
+	for _, o := range cacheStores.Service.List() {
+		if service, ok := o.(*corev1.Service); ok {
+			users.services[client.ObjectKeyFromObject(service)] = *service
+		}
+	}
+	for _, o := range cacheStores.KongServiceFacade.List() {
+		if serviceFacade, ok := o.(*incubatorv1alpha1.KongServiceFacade); ok {
+			users.serviceFacades[client.ObjectKeyFromObject(serviceFacade)] = *serviceFacade
+		}
+	}
+	httpRoutes, err := validator.Storer.ListHTTPRoutes()
+	if err != nil {
+		return users, fmt.Errorf("failed to list HTTPRoutes: %w", err)
+	}
+	for _, httpRoute := range httpRoutes {
+		users.httpRoutes[client.ObjectKeyFromObject(httpRoute)] = *httpRoute
+	}
+	return users, nil
+}
+
+// set replaces the object of the kind of obj stored under the key with value. The object is removed when value
+// is nil.
+func (u upstreamPolicyUsers) set(obj client.Object, key k8stypes.NamespacedName, value client.Object) {
+	switch obj.(type) {
+	case *corev1.Service:
+		delete(u.services, key)
+		if service, ok := value.(*corev1.Service); ok && service != nil {
+			u.services[key] = *service
+		}
+	case *incubatorv1alpha1.KongServiceFacade:
+		delete(u.serviceFacades, key)
+		if serviceFacade, ok := value.(*incubatorv1alpha1.KongServiceFacade); ok && serviceFacade != nil {
+			u.serviceFacades[key] = *serviceFacade
+		}
+	case *gatewayapi.HTTPRoute:
+		delete(u.httpRoutes, key)
+		if httpRoute, ok := value.(*gatewayapi.HTTPRoute); ok && httpRoute != nil {
+			u.httpRoutes[key] = *httpRoute
+		}
+	}
+}
+
+// referencedPolicies returns KongUpstreamPolicies referenced by the given Services or KongServiceFacades or by
+// backends of the given HTTPRoutes.
+func (u upstreamPolicyUsers) referencedPolicies(objs ...client.Object) []k8stypes.NamespacedName {
+	var policies []k8stypes.NamespacedName
+	addPolicyOf := func(obj client.Object) {
+		if policy, ok := annotations.ExtractUpstreamPolicy(obj.GetAnnotations()); ok {
+			policies = append(policies, k8stypes.NamespacedName{Namespace: obj.GetNamespace(), Name: policy})
+		}
+	}
+	for _, obj := range objs {
+		switch o := obj.(type) {
+		case *corev1.Service:
+			if o != nil {
+				addPolicyOf(o)
+			}
+		case *incubatorv1alpha1.KongServiceFacade:
+			if o != nil {
+				addPolicyOf(o)
+			}
+		case *gatewayapi.HTTPRoute:
+			if o == nil {
+				continue
+			}
+			for _, rule := range o.Spec.Rules {
+				for _, br := range rule.BackendRefs {
+					key := k8stypes.NamespacedName{Namespace: o.Namespace, Name: string(br.Name)}
+					if br.Namespace != nil {
+						key.Namespace = string(*br.Namespace)
+					}
+					if service, ok := u.services[key]; ok && (br.Kind == nil || *br.Kind == "Service") {
+						addPolicyOf(&service)
+					}
+					if serviceFacade, ok := u.serviceFacades[key]; ok && br.Kind != nil &&
+						*br.Kind == incubatorv1alpha1.KongServiceFacadeKind {
+						addPolicyOf(&serviceFacade)
+					}
+				}
+			}
+		}
+	}
+	return lo.Uniq(policies)
+}
+
+// conflicts returns sorted keys of Services and KongServiceFacades referencing any of the given KongUpstreamPolicies
+// that are used in an HTTPRoute rule together with backends not using the same KongUpstreamPolicy.
+func (u upstreamPolicyUsers) conflicts(policies ...k8stypes.NamespacedName) []string {
+	if len(policies) == 0 {
+		return nil
+	}
+	httpRoutes := lo.Values(u.httpRoutes)
+	var conflicts []string
+	for _, policy := range policies {
+		services := lo.Filter(lo.Values(u.services), func(service corev1.Service, _ int) bool {
+			name, ok := annotations.ExtractUpstreamPolicy(service.Annotations)
+			return ok && service.Namespace == policy.Namespace && name == policy.Name
+		})
+		serviceFacades := lo.Filter(lo.Values(u.serviceFacades), func(serviceFacade incubatorv1alpha1.KongServiceFacade, _ int) bool {
+			name, ok := annotations.ExtractUpstreamPolicy(serviceFacade.Annotations)
+			return ok && serviceFacade.Namespace == policy.Namespace && name == policy.Name
+		})
+		if len(services) == 0 && len(serviceFacades) == 0 {
+			continue
+		}
+		conflicts = append(conflicts, configuration.GetServicesConflictedOnUpstreamPolicy(services, serviceFacades, httpRoutes)...)
+	}
+	conflicts = lo.Uniq(conflicts)
+	sort.Strings(conflicts)
+	return conflicts
+}
+
+func (validator KongHTTPValidator) validateUpstreamAgainstGatewaySchema(ctx context.Context, upstream kong.Upstream) (string, error) {
+	schemaService, hasClient := validator.AdminAPIServicesProvider.GetSchemasService()
+	if !hasClient {
+		return "", nil
+	}
+	isValid, msg, err := schemaService.Validate(ctx, kong.EntityTypeUpstreams, &upstream)
+	if err != nil {
+		return ErrTextUpstreamPolicyUnableToValidate, err
+	}
+	if !isValid {
+		return fmt.Sprintf(ErrTextUpstreamPolicyViolatesSchema, msg), nil
+	}
+	return "", nil
+}
+
+// validateUpstreamHashing ensures that the hashing fallback of the upstream doesn't use the same input as the primary
+// hashing as Kong gateway rejects such upstreams.
+func validateUpstreamHashing(upstream *kong.Upstream) error {
+	hashOn, hashFallback := lo.FromPtr(upstream.HashOn), lo.FromPtr(upstream.HashFallback)
+	if hashOn == "" || hashOn == "none" || hashOn != hashFallback {
+		return nil
+	}
+
+	// Header, query argument and URI capture inputs are the same only when they refer to the same name.
+	// Header names are case-insensitive.
+	switch hashOn {
+	case kongstate.KongHashOnTypeHeader:
+		if !strings.EqualFold(lo.FromPtr(upstream.HashOnHeader), lo.FromPtr(upstream.HashFallbackHeader)) {
+			return nil
+		}
+	case kongstate.KongHashOnTypeQueryArg:
+		if lo.FromPtr(upstream.HashOnQueryArg) != lo.FromPtr(upstream.HashFallbackQueryArg) {
+			return nil
+		}
+	case kongstate.KongHashOnTypeURICapture:
+		if lo.FromPtr(upstream.HashOnURICapture) != lo.FromPtr(upstream.HashFallbackURICapture) {
+			return nil
+		}
+	}
+	return fmt.Errorf("spec.hashOn and spec.hashOnFallback must not use the same %s input", hashOn)
+}
+
 type managerClientSecretGetter struct {
 	managerClient client.Client
 }
@@ -601,6 +934,26 @@ func (m *managerClientSecretGetter) GetSecret(namespace, name string) (*corev1.S
 	}, secret)
 }
 
+// configFromUnretrievableErrText returns the error text for a plugin whose configFrom could not be loaded.
+func configFromUnretrievableErrText(fromConfigMap bool) string {
+	if fromConfigMap {
+		return ErrTextPluginConfigMapConfigUnretrievable
+	}
+	return ErrTextPluginSecretConfigUnretrievable
+}
+
+type managerClientConfigMapGetter struct {
+	managerClient client.Client
+}
+
+func (m *managerClientConfigMapGetter) GetConfigMap(namespace, name string) (*corev1.ConfigMap, error) {
+	configMap := &corev1.ConfigMap{}
+	return configMap, m.managerClient.Get(context.Background(), client.ObjectKey{
+		Namespace: namespace,
+		Name:      name,
+	}, configMap)
+}
+
 type managerClientConsumerGetter struct {
 	managerClient client.Client
 }
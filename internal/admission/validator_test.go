@@ -26,6 +26,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	managerscheme "github.com/kong/kubernetes-ingress-controller/v3/internal/manager/scheme"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
@@ -132,6 +133,18 @@ func TestKongHTTPValidator_ValidatePlugin(t *testing.T) {
 				},
 			},
 		},
+		ConfigMaps: []*corev1.ConfigMap{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "",
+					Name:      "conf-configmap",
+				},
+				Data: map[string]string{
+					"valid-conf":   `{"foo":"bar"}`,
+					"invalid-conf": `{"foo":"baz}`,
+				},
+			},
+		},
 	})
 	type args struct {
 		plugin          kongv1.KongPlugin
@@ -232,6 +245,66 @@ func TestKongHTTPValidator_ValidatePlugin(t *testing.T) {
 			wantMessage: ErrTextPluginConfigInvalid,
 			wantErr:     false,
 		},
+		{
+			name:      "plugin has invalid configPatches from ConfigMap",
+			PluginSvc: &fakePluginSvc{},
+			args: args{
+				plugin: kongv1.KongPlugin{
+					PluginName: "key-auth",
+					Config: apiextensionsv1.JSON{
+						Raw: []byte(`{"k1":"v1"}`),
+					},
+					ConfigPatches: []kongv1.ConfigPatch{
+						{
+							Path: "/foo",
+							ValueFrom: kongv1.ConfigSource{
+								ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+									ConfigMap: "conf-configmap",
+									Key:       "invalid-conf",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantOK:      false,
+			wantMessage: ErrTextPluginConfigInvalid,
+			wantErr:     false,
+		},
+		{
+			name:      "plugin ConfigFrom references ConfigMap",
+			PluginSvc: &fakePluginSvc{valid: true},
+			args: args{
+				plugin: kongv1.KongPlugin{
+					PluginName: "key-auth",
+					ConfigFrom: &kongv1.ConfigSource{
+						ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+							Key:       "valid-conf",
+							ConfigMap: "conf-configmap",
+						},
+					},
+				},
+			},
+			wantOK: true,
+		},
+		{
+			name:      "plugin ConfigFrom references non-existent ConfigMap",
+			PluginSvc: &fakePluginSvc{},
+			args: args{
+				plugin: kongv1.KongPlugin{
+					PluginName: "key-auth",
+					ConfigFrom: &kongv1.ConfigSource{
+						ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+							Key:       "valid-conf",
+							ConfigMap: "missing-configmap",
+						},
+					},
+				},
+			},
+			wantOK:      false,
+			wantMessage: ErrTextPluginConfigMapConfigUnretrievable,
+			wantErr:     false,
+		},
 		{
 			name:      "plugin ConfigFrom references non-existent Secret",
 			PluginSvc: &fakePluginSvc{},
@@ -292,7 +365,8 @@ func TestKongHTTPValidator_ValidatePlugin(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			validator := KongHTTPValidator{
-				SecretGetter: store,
+				SecretGetter:    store,
+				ConfigMapGetter: store,
 				AdminAPIServicesProvider: fakeServicesProvider{
 					pluginSvc: tt.PluginSvc,
 				},
@@ -331,6 +405,18 @@ func TestKongHTTPValidator_ValidateClusterPlugin(t *testing.T) {
 				},
 			},
 		},
+		ConfigMaps: []*corev1.ConfigMap{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "conf-configmap",
+				},
+				Data: map[string]string{
+					"valid-conf":   `{"foo":"bar"}`,
+					"invalid-conf": `{"foo":"baz}`,
+				},
+			},
+		},
 	})
 	type args struct {
 		plugin          kongv1.KongClusterPlugin
@@ -433,6 +519,47 @@ func TestKongHTTPValidator_ValidateClusterPlugin(t *testing.T) {
 			wantMessage: ErrTextPluginConfigInvalid,
 			wantErr:     false,
 		},
+		{
+			name:      "plugin has valid configPatches from ConfigMap",
+			PluginSvc: &fakePluginSvc{valid: true},
+			args: args{
+				plugin: kongv1.KongClusterPlugin{
+					PluginName: "key-auth",
+					ConfigPatches: []kongv1.NamespacedConfigPatch{
+						{
+							Path: "/foo",
+							ValueFrom: kongv1.NamespacedConfigSource{
+								ConfigMapValue: &kongv1.NamespacedConfigMapValueFromSource{
+									Namespace: "default",
+									ConfigMap: "conf-configmap",
+									Key:       "valid-conf",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantOK: true,
+		},
+		{
+			name:      "plugin ConfigFrom references non-existent ConfigMap",
+			PluginSvc: &fakePluginSvc{},
+			args: args{
+				plugin: kongv1.KongClusterPlugin{
+					PluginName: "key-auth",
+					ConfigFrom: &kongv1.NamespacedConfigSource{
+						ConfigMapValue: &kongv1.NamespacedConfigMapValueFromSource{
+							Key:       "valid-conf",
+							ConfigMap: "missing-configmap",
+							Namespace: "default",
+						},
+					},
+				},
+			},
+			wantOK:      false,
+			wantMessage: ErrTextPluginConfigMapConfigUnretrievable,
+			wantErr:     false,
+		},
 		{
 			name:      "plugin ConfigFrom references non-existent Secret",
 			PluginSvc: &fakePluginSvc{},
@@ -505,7 +632,8 @@ func TestKongHTTPValidator_ValidateClusterPlugin(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			validator := KongHTTPValidator{
-				SecretGetter: store,
+				SecretGetter:    store,
+				ConfigMapGetter: store,
 				AdminAPIServicesProvider: fakeServicesProvider{
 					pluginSvc: tt.PluginSvc,
 				},
@@ -700,8 +828,6 @@ func (f fakeInfoSvc) Get(context.Context) (*kong.Info, error) {
 }
 
 func TestKongHTTPValidator_ValidateConsumerGroup(t *testing.T) {
-	store, err := store.NewFakeStore(store.FakeObjects{})
-	require.NoError(t, err)
 	type args struct {
 		cg kongv1beta1.KongConsumerGroup
 	}
@@ -709,7 +835,9 @@ func TestKongHTTPValidator_ValidateConsumerGroup(t *testing.T) {
 		name                 string
 		ConsumerGroupSvc     kong.AbstractConsumerGroupService
 		InfoSvc              kong.AbstractInfoService
+		PluginSvc            kong.AbstractPluginService
 		ManagerClientObjects []client.Object
+		StoreConsumerGroups  []*kongv1beta1.KongConsumerGroup
 		args                 args
 		wantOK               bool
 		wantMessage          string
@@ -797,6 +925,82 @@ func TestKongHTTPValidator_ValidateConsumerGroup(t *testing.T) {
 			wantMessage: "",
 			wantErr:     false,
 		},
+		{
+			name:             "Enterprise version and KongConsumerGroup with valid plugins in spec passes",
+			ConsumerGroupSvc: &fakeConsumerGroupSvc{err: nil},
+			InfoSvc:          &fakeInfoSvc{version: "3.4.1.0"},
+			PluginSvc:        &fakePluginSvc{valid: true},
+			args: args{
+				cg: kongv1beta1.KongConsumerGroup{
+					Spec: kongv1beta1.KongConsumerGroupSpec{
+						Name: "gold",
+						Plugins: []kongv1beta1.KongConsumerGroupPlugin{
+							{
+								Name:   "rate-limiting-advanced",
+								Config: apiextensionsv1.JSON{Raw: []byte(`{"limit":[100],"window_size":[60]}`)},
+							},
+						},
+					},
+				},
+			},
+			wantOK: true,
+		},
+		{
+			name:             "Enterprise version and KongConsumerGroup with plugin in spec violating schema fails",
+			ConsumerGroupSvc: &fakeConsumerGroupSvc{err: nil},
+			InfoSvc:          &fakeInfoSvc{version: "3.4.1.0"},
+			PluginSvc:        &fakePluginSvc{valid: false, msg: "limit: required field missing"},
+			args: args{
+				cg: kongv1beta1.KongConsumerGroup{
+					Spec: kongv1beta1.KongConsumerGroupSpec{
+						Plugins: []kongv1beta1.KongConsumerGroupPlugin{
+							{
+								Name:   "rate-limiting-advanced",
+								Config: apiextensionsv1.JSON{Raw: []byte(`{"window_size":[60]}`)},
+							},
+						},
+					},
+				},
+			},
+			wantOK:      false,
+			wantMessage: `plugin "rate-limiting-advanced": ` + fmt.Sprintf(ErrTextPluginConfigViolatesSchema, "limit: required field missing"),
+		},
+		{
+			name:             "Enterprise version and KongConsumerGroup with plugin in spec with invalid config fails",
+			ConsumerGroupSvc: &fakeConsumerGroupSvc{err: nil},
+			InfoSvc:          &fakeInfoSvc{version: "3.4.1.0"},
+			args: args{
+				cg: kongv1beta1.KongConsumerGroup{
+					Spec: kongv1beta1.KongConsumerGroupSpec{
+						Plugins: []kongv1beta1.KongConsumerGroupPlugin{
+							{
+								Name:   "rate-limiting-advanced",
+								Config: apiextensionsv1.JSON{Raw: []byte(`[1,2,3]`)},
+							},
+						},
+					},
+				},
+			},
+			wantOK:      false,
+			wantMessage: `could not parse plugin configuration: plugin "rate-limiting-advanced": json: cannot unmarshal array into Go value of type kong.Configuration`,
+		},
+		{
+			name:             "OSS version with plugins in spec fails on the edition check",
+			ConsumerGroupSvc: &fakeConsumerGroupSvc{err: nil},
+			InfoSvc:          &fakeInfoSvc{version: "3.4.1"},
+			PluginSvc:        &fakePluginSvc{valid: true},
+			args: args{
+				cg: kongv1beta1.KongConsumerGroup{
+					Spec: kongv1beta1.KongConsumerGroupSpec{
+						Plugins: []kongv1beta1.KongConsumerGroupPlugin{
+							{Name: "rate-limiting-advanced"},
+						},
+					},
+				},
+			},
+			wantOK:      false,
+			wantMessage: ErrTextConsumerGroupUnsupported,
+		},
 		{
 			name:             "OSS version",
 			ConsumerGroupSvc: &fakeConsumerGroupSvc{err: nil},
@@ -863,18 +1067,109 @@ func TestKongHTTPValidator_ValidateConsumerGroup(t *testing.T) {
 			wantMessage: fmt.Sprintf("%s: %s", ErrTextConsumerGroupUnexpected, `HTTP status 418 (message: "I'm a teapot")`),
 			wantErr:     false,
 		},
+		{
+			name:             "KongConsumerGroup with spec.name used as the name of another KongConsumerGroup fails",
+			ConsumerGroupSvc: &fakeConsumerGroupSvc{err: nil},
+			InfoSvc:          &fakeInfoSvc{version: "3.4.1.0"},
+			StoreConsumerGroups: []*kongv1beta1.KongConsumerGroup{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "gold",
+						Namespace: "default",
+						Annotations: map[string]string{
+							annotations.IngressClassKey: annotations.DefaultIngressClass,
+						},
+					},
+				},
+			},
+			args: args{
+				cg: kongv1beta1.KongConsumerGroup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "gold-tier",
+						Namespace: "default",
+					},
+					Spec: kongv1beta1.KongConsumerGroupSpec{
+						Name: "gold",
+					},
+				},
+			},
+			wantOK:      false,
+			wantMessage: fmt.Sprintf(ErrTextConsumerGroupNameDuplicate, "gold", "default", "gold"),
+			wantErr:     false,
+		},
+		{
+			name:             "KongConsumerGroup with the name of a KongConsumerGroup in another namespace fails",
+			ConsumerGroupSvc: &fakeConsumerGroupSvc{err: nil},
+			InfoSvc:          &fakeInfoSvc{version: "3.4.1.0"},
+			StoreConsumerGroups: []*kongv1beta1.KongConsumerGroup{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "gold",
+						Namespace: "default",
+						Annotations: map[string]string{
+							annotations.IngressClassKey: annotations.DefaultIngressClass,
+						},
+					},
+				},
+			},
+			args: args{
+				cg: kongv1beta1.KongConsumerGroup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "gold",
+						Namespace: "other",
+					},
+				},
+			},
+			wantOK:      false,
+			wantMessage: fmt.Sprintf(ErrTextConsumerGroupNameDuplicate, "gold", "default", "gold"),
+			wantErr:     false,
+		},
+		{
+			name:             "update of KongConsumerGroup keeping its name passes",
+			ConsumerGroupSvc: &fakeConsumerGroupSvc{err: nil},
+			InfoSvc:          &fakeInfoSvc{version: "3.4.1.0"},
+			StoreConsumerGroups: []*kongv1beta1.KongConsumerGroup{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "gold",
+						Namespace: "default",
+						Annotations: map[string]string{
+							annotations.IngressClassKey: annotations.DefaultIngressClass,
+						},
+					},
+				},
+			},
+			args: args{
+				cg: kongv1beta1.KongConsumerGroup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "gold",
+						Namespace: "default",
+					},
+					Spec: kongv1beta1.KongConsumerGroupSpec{
+						Tags: []string{"tier:gold"},
+					},
+				},
+			},
+			wantOK:      true,
+			wantMessage: "",
+			wantErr:     false,
+		},
 	}
 
 	scheme := runtime.NewScheme()
 	require.NoError(t, kongv1.AddToScheme(scheme))
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			storer, err := store.NewFakeStore(store.FakeObjects{KongConsumerGroups: tt.StoreConsumerGroups})
+			require.NoError(t, err)
 			validator := KongHTTPValidator{
 				ManagerClient: fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.ManagerClientObjects...).Build(),
-				SecretGetter:  store,
+				SecretGetter:  storer,
+				Storer:        storer,
 				AdminAPIServicesProvider: fakeServicesProvider{
 					infoSvc:          tt.InfoSvc,
 					consumerGroupSvc: tt.ConsumerGroupSvc,
+					pluginSvc:        tt.PluginSvc,
 				},
 				ingressClassMatcher: fakeClassMatcher,
 				Logger:              zapr.NewLogger(zap.NewNop()),
@@ -990,8 +1285,69 @@ func TestKongHTTPValidator_ValidateCredential(t *testing.T) {
 			wantOK:      false,
 			wantMessage: fmt.Sprintf("%s: %s", ErrTextConsumerCredentialValidationFailed, "missing required field(s): key"),
 		},
+		{
+			name: "key-auth credential referencing configured vault with a consumer gets accepted",
+			consumers: []kongv1.KongConsumer{
+				{
+					Username:    "username",
+					Credentials: []string{"username-key-auth-1"},
+				},
+			},
+			secret: corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "username-key-auth-1",
+					Labels: map[string]string{
+						"konghq.com/credential": "key-auth",
+					},
+				},
+				Data: map[string][]byte{
+					"key": []byte("{vault://env/my-key}"),
+				},
+			},
+			wantOK: true,
+		},
+		{
+			name: "key-auth credential referencing not configured vault with a consumer gets rejected",
+			consumers: []kongv1.KongConsumer{
+				{
+					Username:    "username",
+					Credentials: []string{"username-key-auth-1"},
+				},
+			},
+			secret: corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "username-key-auth-1",
+					Labels: map[string]string{
+						"konghq.com/credential": "key-auth",
+					},
+				},
+				Data: map[string][]byte{
+					"key": []byte("{vault://aws/my-key}"),
+				},
+			},
+			wantOK: false,
+			wantMessage: fmt.Sprintf("%s: %s", ErrTextConsumerCredentialValidationFailed,
+				`field key references vault with prefix "aws" that is not configured by any KongVault`),
+		},
 	}
 
+	storer := lo.Must(store.NewFakeStore(store.FakeObjects{
+		KongVaults: []*kongv1alpha1.KongVault{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "env-vault",
+					Annotations: map[string]string{
+						annotations.IngressClassKey: annotations.DefaultIngressClass,
+					},
+				},
+				Spec: kongv1alpha1.KongVaultSpec{
+					Backend: "env",
+					Prefix:  "env",
+				},
+			},
+		},
+	}))
+
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
@@ -1001,6 +1357,7 @@ func TestKongHTTPValidator_ValidateCredential(t *testing.T) {
 			b := fake.NewClientBuilder().WithScheme(scheme)
 
 			validator := KongHTTPValidator{
+				Storer:        storer,
 				ManagerClient: b.Build(),
 				ConsumerGetter: fakeConsumerGetter{
 					consumers: tc.consumers,
@@ -1422,6 +1779,268 @@ func (s fakeVaultSvc) Validate(_ context.Context, _ *kong.Vault) (bool, string,
 	return true, "", nil
 }
 
+func TestValidator_ValidateUpstreamPolicy(t *testing.T) {
+	const policyName = "policy"
+	serviceUsingPolicy := func(name string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: corev1.NamespaceDefault,
+				Name:      name,
+				Annotations: map[string]string{
+					kongv1beta1.KongUpstreamPolicyAnnotationKey: policyName,
+				},
+			},
+		}
+	}
+	httpRoute := func(backendRefs ...gatewayapi.HTTPBackendRef) *gatewayapi.HTTPRoute {
+		return &gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: corev1.NamespaceDefault, Name: "httproute"},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Rules: []gatewayapi.HTTPRouteRule{{BackendRefs: backendRefs}},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		spec            kongv1beta1.KongUpstreamPolicySpec
+		oldPolicy       *kongv1beta1.KongUpstreamPolicy
+		services        []*corev1.Service
+		httpRoutes      []*gatewayapi.HTTPRoute
+		validateSvcFail bool
+		expectedOK      bool
+		expectedMessage string
+	}{
+		{
+			name: "valid policy",
+			spec: kongv1beta1.KongUpstreamPolicySpec{
+				Algorithm: lo.ToPtr("consistent-hashing"),
+				HashOn:    &kongv1beta1.KongUpstreamHash{Header: lo.ToPtr("x-user")},
+				HashOnFallback: &kongv1beta1.KongUpstreamHash{
+					Input: lo.ToPtr(kongv1beta1.HashInput("ip")),
+				},
+			},
+			services:   []*corev1.Service{serviceUsingPolicy("svc-1"), serviceUsingPolicy("svc-2")},
+			httpRoutes: []*gatewayapi.HTTPRoute{httpRoute(builder.NewHTTPBackendRef("svc-1").Build(), builder.NewHTTPBackendRef("svc-2").Build())},
+			expectedOK: true,
+		},
+		{
+			name: "hashOn and hashOnFallback using the same input",
+			spec: kongv1beta1.KongUpstreamPolicySpec{
+				Algorithm: lo.ToPtr("consistent-hashing"),
+				HashOn:    &kongv1beta1.KongUpstreamHash{Input: lo.ToPtr(kongv1beta1.HashInput("ip"))},
+				HashOnFallback: &kongv1beta1.KongUpstreamHash{
+					Input: lo.ToPtr(kongv1beta1.HashInput("ip")),
+				},
+			},
+			expectedOK:      false,
+			expectedMessage: "KongUpstreamPolicy spec is invalid: spec.hashOn and spec.hashOnFallback must not use the same ip input",
+		},
+		{
+			name: "hashOn and hashOnFallback using the same header",
+			spec: kongv1beta1.KongUpstreamPolicySpec{
+				Algorithm:      lo.ToPtr("consistent-hashing"),
+				HashOn:         &kongv1beta1.KongUpstreamHash{Header: lo.ToPtr("x-user")},
+				HashOnFallback: &kongv1beta1.KongUpstreamHash{Header: lo.ToPtr("X-User")},
+			},
+			expectedOK:      false,
+			expectedMessage: "KongUpstreamPolicy spec is invalid: spec.hashOn and spec.hashOnFallback must not use the same header input",
+		},
+		{
+			name: "hashOn and hashOnFallback using different query args",
+			spec: kongv1beta1.KongUpstreamPolicySpec{
+				Algorithm:      lo.ToPtr("consistent-hashing"),
+				HashOn:         &kongv1beta1.KongUpstreamHash{QueryArg: lo.ToPtr("user")},
+				HashOnFallback: &kongv1beta1.KongUpstreamHash{QueryArg: lo.ToPtr("session")},
+			},
+			expectedOK: true,
+		},
+		{
+			name:     "policy used by Service in HTTPRoute rule together with Service not using it",
+			spec:     kongv1beta1.KongUpstreamPolicySpec{Algorithm: lo.ToPtr("least-connections")},
+			services: []*corev1.Service{serviceUsingPolicy("svc-1")},
+			httpRoutes: []*gatewayapi.HTTPRoute{
+				httpRoute(builder.NewHTTPBackendRef("svc-1").Build(), builder.NewHTTPBackendRef("svc-no-policy").Build()),
+			},
+			expectedOK: false,
+			expectedMessage: "KongUpstreamPolicy cannot be applied to Services used in HTTPRoute rules together with " +
+				"backends not using the same KongUpstreamPolicy: default/svc-1",
+		},
+		{
+			name:      "update of policy with pre-existing conflict",
+			spec:      kongv1beta1.KongUpstreamPolicySpec{Algorithm: lo.ToPtr("least-connections")},
+			oldPolicy: &kongv1beta1.KongUpstreamPolicy{},
+			services:  []*corev1.Service{serviceUsingPolicy("svc-1")},
+			httpRoutes: []*gatewayapi.HTTPRoute{
+				httpRoute(builder.NewHTTPBackendRef("svc-1").Build(), builder.NewHTTPBackendRef("svc-no-policy").Build()),
+			},
+			expectedOK: true,
+		},
+		{
+			name:            "policy rejected by Kong gateway",
+			spec:            kongv1beta1.KongUpstreamPolicySpec{Algorithm: lo.ToPtr("round-robin")},
+			validateSvcFail: true,
+			expectedOK:      false,
+			expectedMessage: "KongUpstreamPolicy failed schema validation: something is wrong in the entity",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storer := lo.Must(store.NewFakeStore(store.FakeObjects{
+				Services:   tc.services,
+				HTTPRoutes: tc.httpRoutes,
+			}))
+			validator := KongHTTPValidator{
+				AdminAPIServicesProvider: fakeServicesProvider{
+					schemaSvc: fakeSchemaSvc{shouldFail: tc.validateSvcFail},
+				},
+				Storer: storer,
+				Logger: logr.Discard(),
+			}
+			ok, msg, err := validator.ValidateUpstreamPolicy(context.Background(), kongv1beta1.KongUpstreamPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: corev1.NamespaceDefault, Name: policyName},
+				Spec:       tc.spec,
+			}, tc.oldPolicy)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expectedMessage, msg)
+		})
+	}
+}
+
+func TestValidator_ValidateUpstreamPolicyConflicts(t *testing.T) {
+	const policyName = "policy"
+	service := func(name string, withPolicy bool) *corev1.Service {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: corev1.NamespaceDefault, Name: name},
+		}
+		if withPolicy {
+			svc.Annotations = map[string]string{kongv1beta1.KongUpstreamPolicyAnnotationKey: policyName}
+		}
+		return svc
+	}
+	serviceFacade := func(name string, withPolicy bool) *incubatorv1alpha1.KongServiceFacade {
+		facade := &incubatorv1alpha1.KongServiceFacade{
+			ObjectMeta: metav1.ObjectMeta{Namespace: corev1.NamespaceDefault, Name: name},
+		}
+		if withPolicy {
+			facade.Annotations = map[string]string{kongv1beta1.KongUpstreamPolicyAnnotationKey: policyName}
+		}
+		return facade
+	}
+	httpRoute := func(name string, backendRefs ...gatewayapi.HTTPBackendRef) *gatewayapi.HTTPRoute {
+		return &gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: corev1.NamespaceDefault, Name: name},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Rules: []gatewayapi.HTTPRouteRule{{BackendRefs: backendRefs}},
+			},
+		}
+	}
+	facadeBackendRef := func(name string) gatewayapi.HTTPBackendRef {
+		return builder.NewHTTPBackendRef(name).
+			WithGroup(incubatorv1alpha1.GroupVersion.Group).
+			WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+			Build()
+	}
+
+	testCases := []struct {
+		name            string
+		services        []*corev1.Service
+		serviceFacades  []*incubatorv1alpha1.KongServiceFacade
+		httpRoutes      []*gatewayapi.HTTPRoute
+		obj             client.Object
+		oldObj          client.Object
+		expectedOK      bool
+		expectedMessage string
+	}{
+		{
+			name:     "HTTPRoute created with backends using the same policy",
+			services: []*corev1.Service{service("svc-1", true), service("svc-2", true)},
+			obj: httpRoute("httproute",
+				builder.NewHTTPBackendRef("svc-1").Build(), builder.NewHTTPBackendRef("svc-2").Build(),
+			),
+			expectedOK: true,
+		},
+		{
+			name:     "HTTPRoute created with backends not using the same policy",
+			services: []*corev1.Service{service("svc-1", true), service("svc-2", false)},
+			obj: httpRoute("httproute",
+				builder.NewHTTPBackendRef("svc-1").Build(), builder.NewHTTPBackendRef("svc-2").Build(),
+			),
+			expectedOK: false,
+			expectedMessage: "KongUpstreamPolicy cannot be applied to Services used in HTTPRoute rules together with " +
+				"backends not using the same KongUpstreamPolicy: default/svc-1",
+		},
+		{
+			name:     "HTTPRoute with pre-existing conflict updated",
+			services: []*corev1.Service{service("svc-1", true), service("svc-2", false)},
+			httpRoutes: []*gatewayapi.HTTPRoute{
+				httpRoute("httproute", builder.NewHTTPBackendRef("svc-1").Build(), builder.NewHTTPBackendRef("svc-2").Build()),
+			},
+			obj: httpRoute("httproute",
+				builder.NewHTTPBackendRef("svc-1").Build(), builder.NewHTTPBackendRef("svc-2").Build(),
+			),
+			oldObj: httpRoute("httproute",
+				builder.NewHTTPBackendRef("svc-1").Build(), builder.NewHTTPBackendRef("svc-2").Build(),
+			),
+			expectedOK: true,
+		},
+		{
+			name:     "Service annotated with policy used in HTTPRoute rule together with Service not using it",
+			services: []*corev1.Service{service("svc-1", false), service("svc-2", false)},
+			httpRoutes: []*gatewayapi.HTTPRoute{
+				httpRoute("httproute", builder.NewHTTPBackendRef("svc-1").Build(), builder.NewHTTPBackendRef("svc-2").Build()),
+			},
+			obj:        service("svc-1", true),
+			oldObj:     service("svc-1", false),
+			expectedOK: false,
+			expectedMessage: "KongUpstreamPolicy cannot be applied to Services used in HTTPRoute rules together with " +
+				"backends not using the same KongUpstreamPolicy: default/svc-1",
+		},
+		{
+			name:     "Service not used in HTTPRoute annotated with policy",
+			services: []*corev1.Service{service("svc-1", false), service("svc-2", false)},
+			httpRoutes: []*gatewayapi.HTTPRoute{
+				httpRoute("httproute", builder.NewHTTPBackendRef("svc-2").Build()),
+			},
+			obj:        service("svc-1", true),
+			oldObj:     service("svc-1", false),
+			expectedOK: true,
+		},
+		{
+			name:           "KongServiceFacade created with policy used in HTTPRoute rule together with Service not using it",
+			services:       []*corev1.Service{service("svc-1", false)},
+			serviceFacades: []*incubatorv1alpha1.KongServiceFacade{},
+			httpRoutes: []*gatewayapi.HTTPRoute{
+				httpRoute("httproute", facadeBackendRef("facade-1"), builder.NewHTTPBackendRef("svc-1").Build()),
+			},
+			obj:        serviceFacade("facade-1", true),
+			expectedOK: false,
+			expectedMessage: "KongUpstreamPolicy cannot be applied to Services used in HTTPRoute rules together with " +
+				"backends not using the same KongUpstreamPolicy: KongServiceFacade/default/facade-1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storer := lo.Must(store.NewFakeStore(store.FakeObjects{
+				Services:           tc.services,
+				KongServiceFacades: tc.serviceFacades,
+				HTTPRoutes:         tc.httpRoutes,
+			}))
+			validator := KongHTTPValidator{
+				Storer: storer,
+				Logger: logr.Discard(),
+			}
+			ok, msg, err := validator.ValidateUpstreamPolicyConflicts(context.Background(), tc.obj, tc.oldObj)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expectedMessage, msg)
+		})
+	}
+}
+
 func TestValidator_ValidateCustomEntity(t *testing.T) {
 	testCases := []struct {
 		name            string
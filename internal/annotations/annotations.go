@@ -80,6 +80,19 @@ const (
 	// published to.
 	GatewayPublishServiceKey = "/publish-service"
 
+	// WorkspaceKey is an annotation suffix used on a Namespace to indicate the Kong workspace its objects are
+	// configured in.
+	WorkspaceKey = "/workspace"
+
+	// ProgrammedKey, ProgrammedReasonKey, ProgrammedGenerationKey and ProgrammedConfigHashKey are annotation
+	// suffixes reflecting the Programmed condition (its status, reason and observed generation) and the hash of
+	// the configuration the object was programmed with. They're set by the controller on objects whose status
+	// has no conditions (e.g. Ingress).
+	ProgrammedKey           = "/programmed"
+	ProgrammedReasonKey     = "/programmed-reason"
+	ProgrammedGenerationKey = "/programmed-generation"
+	ProgrammedConfigHashKey = "/programmed-config-hash"
+
 	// DefaultIngressClass defines the default class used
 	// by Kong's ingress controller.
 	DefaultIngressClass = "kong"
@@ -426,3 +439,8 @@ func ExtractUpstreamPolicy(anns map[string]string) (string, bool) {
 	s, ok := anns[kongv1beta1.KongUpstreamPolicyAnnotationKey]
 	return s, ok
 }
+
+// ExtractWorkspace extracts the workspace annotation value.
+func ExtractWorkspace(anns map[string]string) string {
+	return anns[AnnotationPrefix+WorkspaceKey]
+}
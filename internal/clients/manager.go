@@ -3,11 +3,14 @@ package clients
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/samber/lo"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/adminapi"
 	dpconf "github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/config"
@@ -46,7 +49,7 @@ type Ticker interface {
 type AdminAPIClientsManager struct {
 	// discoveredAdminAPIsNotifyChan is used for notifications that contain Admin API
 	// endpoints list that should be used for configuring the dataplane.
-	discoveredAdminAPIsNotifyChan    chan []adminapi.DiscoveredAdminAPI
+	discoveredAdminAPIsNotifyChan    chan discoveredAdminAPIsNotification
 	gatewayClientsChangesSubscribers []chan struct{}
 
 	dbMode dpconf.DBMode
@@ -63,12 +66,19 @@ type AdminAPIClientsManager struct {
 	// configured.
 	pendingGatewayClients map[string]adminapi.DiscoveredAdminAPI
 
+	// discoveredAdminAPIs holds the most recent Admin APIs discovered by each of the sources. It's accessed only
+	// by the reconciliation loop.
+	discoveredAdminAPIs map[k8stypes.NamespacedName][]adminapi.DiscoveredAdminAPI
+
 	// readinessChecker is used to check readiness of the clients.
 	readinessChecker ReadinessChecker
 
 	// readinessReconciliationTicker is used to run readiness reconciliation loop.
 	readinessReconciliationTicker Ticker
 
+	// podsGateways maps pods of Kong Gateway data-planes to Gateways whose publish Services they back.
+	podsGateways map[k8stypes.NamespacedName][]k8stypes.NamespacedName
+
 	// konnectClient represents a special-case of the data-plane which is Konnect cloud.
 	// This client is used to synchronise configuration with Konnect's Control Plane Admin API.
 	konnectClient *adminapi.KonnectClient
@@ -79,6 +89,14 @@ type AdminAPIClientsManager struct {
 	logger logr.Logger
 }
 
+// discoveredAdminAPIsNotification is a notification about Admin APIs discovered by a single source.
+type discoveredAdminAPIsNotification struct {
+	// source is empty for Admin APIs discovered from the Admin API Service (see Notify) and holds the Gateway
+	// for Admin APIs of a managed Gateway's data plane (see NotifyManagedGateway).
+	source    k8stypes.NamespacedName
+	adminAPIs []adminapi.DiscoveredAdminAPI
+}
+
 type AdminAPIClientsManagerOption func(*AdminAPIClientsManager)
 
 // WithReadinessReconciliationTicker allows to set a custom ticker for readiness reconciliation loop.
@@ -109,11 +127,18 @@ func NewAdminAPIClientsManager(
 		return c.BaseRootURL(), c
 	})
 	c := &AdminAPIClientsManager{
-		readyGatewayClients:           readyClients,
-		pendingGatewayClients:         make(map[string]adminapi.DiscoveredAdminAPI),
+		readyGatewayClients:   readyClients,
+		pendingGatewayClients: make(map[string]adminapi.DiscoveredAdminAPI),
+		discoveredAdminAPIs: map[k8stypes.NamespacedName][]adminapi.DiscoveredAdminAPI{
+			// Initial clients are assumed to be discovered from the Admin API Service until it notifies otherwise.
+			{}: lo.Map(initialClients, func(c *adminapi.Client, _ int) adminapi.DiscoveredAdminAPI {
+				podRef, _ := c.PodReference()
+				return adminapi.DiscoveredAdminAPI{Address: c.BaseRootURL(), PodRef: podRef}
+			}),
+		},
 		readinessChecker:              readinessChecker,
 		readinessReconciliationTicker: clock.NewTicker(),
-		discoveredAdminAPIsNotifyChan: make(chan []adminapi.DiscoveredAdminAPI),
+		discoveredAdminAPIsNotifyChan: make(chan discoveredAdminAPIsNotification),
 		ctx:                           ctx,
 		runningChan:                   make(chan struct{}),
 		logger:                        logger,
@@ -144,8 +169,20 @@ func (c *AdminAPIClientsManager) Run() {
 }
 
 // Notify receives a list of addresses that KongClient should use from now on as
-// a list of Kong Admin API endpoints.
+// a list of Kong Admin API endpoints discovered from the Admin API Service. Admin APIs of managed Gateways'
+// data planes (see NotifyManagedGateway) are used in addition to them.
 func (c *AdminAPIClientsManager) Notify(discoveredAPIs []adminapi.DiscoveredAdminAPI) {
+	c.notify(discoveredAdminAPIsNotification{adminAPIs: discoveredAPIs})
+}
+
+// NotifyManagedGateway receives a list of Admin APIs of the data plane provisioned for a managed Gateway that
+// KongClient should use from now on in addition to the ones discovered from the Admin API Service. An empty list
+// should be passed once the Gateway is gone.
+func (c *AdminAPIClientsManager) NotifyManagedGateway(gateway k8stypes.NamespacedName, discoveredAPIs []adminapi.DiscoveredAdminAPI) {
+	c.notify(discoveredAdminAPIsNotification{source: gateway, adminAPIs: discoveredAPIs})
+}
+
+func (c *AdminAPIClientsManager) notify(notification discoveredAdminAPIsNotification) {
 	// Ensure here that we're not done.
 	select {
 	case <-c.ctx.Done():
@@ -156,7 +193,7 @@ func (c *AdminAPIClientsManager) Notify(discoveredAPIs []adminapi.DiscoveredAdmi
 	// And here also listen on c.ctx.Done() to allow the notification to be interrupted.
 	select {
 	case <-c.ctx.Done():
-	case c.discoveredAdminAPIsNotifyChan <- discoveredAPIs:
+	case c.discoveredAdminAPIsNotifyChan <- notification:
 	}
 }
 
@@ -205,6 +242,39 @@ func (c *AdminAPIClientsManager) GatewayClientsToConfigure() []*adminapi.Client
 	return readyGatewayClients[:1]
 }
 
+// AssociateGatewaysPods associates Kong Gateway data-planes with Gateways based on pods backing the Gateways'
+// publish Services. It's used to determine which Gateways' configuration should be sent to which data-plane.
+func (c *AdminAPIClientsManager) AssociateGatewaysPods(gatewaysPods map[k8stypes.NamespacedName][]k8stypes.NamespacedName) {
+	podsGateways := make(map[k8stypes.NamespacedName][]k8stypes.NamespacedName)
+	for gateway, pods := range gatewaysPods {
+		for _, pod := range pods {
+			podsGateways[pod] = append(podsGateways[pod], gateway)
+		}
+	}
+	for _, gateways := range podsGateways {
+		slices.SortFunc(gateways, func(a, b k8stypes.NamespacedName) int {
+			return strings.Compare(a.String(), b.String())
+		})
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.podsGateways = podsGateways
+}
+
+// GatewaysForClient returns Gateways associated with the data-plane of the given client with AssociateGatewaysPods.
+// It returns nil if the client has no pod reference or its pod backs no Gateway.
+func (c *AdminAPIClientsManager) GatewaysForClient(client *adminapi.Client) []k8stypes.NamespacedName {
+	podRef, ok := client.PodReference()
+	if !ok {
+		return nil
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.podsGateways[podRef]
+}
+
 func (c *AdminAPIClientsManager) GatewayClientsCount() int {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
@@ -249,14 +319,31 @@ func (c *AdminAPIClientsManager) gatewayClientsReconciliationLoop() {
 			c.logger.V(util.InfoLevel).Info("Closing AdminAPIClientsManager", "reason", c.ctx.Err())
 			c.closeGatewayClientsSubscribers()
 			return
-		case discoveredAdminAPIs := <-c.discoveredAdminAPIsNotifyChan:
-			c.onDiscoveredAdminAPIsNotification(discoveredAdminAPIs)
+		case notification := <-c.discoveredAdminAPIsNotifyChan:
+			if c.storeDiscoveredAdminAPIs(notification) {
+				c.onDiscoveredAdminAPIsNotification(lo.Flatten(lo.Values(c.discoveredAdminAPIs)))
+			}
 		case <-c.readinessReconciliationTicker.Channel():
 			c.onReadinessReconciliationTick()
 		}
 	}
 }
 
+// storeDiscoveredAdminAPIs stores the Admin APIs discovered by the notification's source. Sources of managed
+// Gateways are forgotten once they have no Admin APIs. It returns false if a source that isn't known notified
+// about no Admin APIs, so there's nothing to adjust.
+func (c *AdminAPIClientsManager) storeDiscoveredAdminAPIs(notification discoveredAdminAPIsNotification) bool {
+	if notification.source == (k8stypes.NamespacedName{}) || len(notification.adminAPIs) > 0 {
+		c.discoveredAdminAPIs[notification.source] = notification.adminAPIs
+		return true
+	}
+	if _, ok := c.discoveredAdminAPIs[notification.source]; !ok {
+		return false
+	}
+	delete(c.discoveredAdminAPIs, notification.source)
+	return true
+}
+
 // onDiscoveredAdminAPIsNotification is called when a new notification about Admin API addresses change is received.
 // It will adjust lists of gateway clients and notify subscribers about the change if readyGatewayClients list has
 // changed.
@@ -157,6 +157,49 @@ func TestAdminAPIClientsManager_OnNotifyClientsAreUpdatedAccordingly(t *testing.
 	require.NotPanics(t, func() { manager.Notify([]adminapi.DiscoveredAdminAPI{}) }, "notifying about new clients after manager has been shut down shouldn't panic")
 }
 
+func TestAdminAPIClientsManager_NotifyManagedGateway(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	readinessChecker := &mockReadinessChecker{}
+	initialClient, err := adminapi.NewTestClient(testURL1)
+	require.NoError(t, err)
+	manager, err := clients.NewAdminAPIClientsManager(
+		ctx,
+		zapr.NewLogger(zap.NewNop()),
+		[]*adminapi.Client{initialClient},
+		readinessChecker,
+	)
+	require.NoError(t, err)
+	manager.Run()
+	<-manager.Running()
+
+	requireClientsMatchEventually := func(addresses []string, msg string) {
+		require.Eventually(t, func() bool {
+			clientAddresses := lo.Map(manager.GatewayClients(), func(cl *adminapi.Client, _ int) string {
+				return cl.BaseRootURL()
+			})
+			slices.Sort(clientAddresses)
+			return slices.Equal(addresses, clientAddresses)
+		}, time.Second, time.Millisecond, msg)
+	}
+
+	gateway := k8stypes.NamespacedName{Namespace: "ns", Name: "gateway"}
+	readinessChecker.LetChecksReturn(clients.ReadinessCheckResult{ClientsTurnedReady: intoTurnedReady(testURL2)})
+	manager.NotifyManagedGateway(gateway, []adminapi.DiscoveredAdminAPI{testDiscoveredAdminAPI(testURL2)})
+	requireClientsMatchEventually([]string{testURL1, testURL2},
+		"managed gateway's admin API should be added to the initial client")
+
+	readinessChecker.LetChecksReturn(clients.ReadinessCheckResult{})
+	manager.Notify([]adminapi.DiscoveredAdminAPI{})
+	requireClientsMatchEventually([]string{testURL2},
+		"managed gateway's admin API should be kept when the admin API service has no endpoints")
+
+	manager.NotifyManagedGateway(gateway, nil)
+	requireClientsMatchEventually([]string{},
+		"managed gateway's admin API should be removed once the gateway is gone")
+}
+
 func TestNewAdminAPIClientsManager_NoInitialClientsDisallowed(t *testing.T) {
 	_, err := clients.NewAdminAPIClientsManager(
 		context.Background(),
@@ -239,6 +282,48 @@ func TestAdminAPIClientsManager_Clients_DBMode(t *testing.T) {
 	require.Equal(t, m.GatewayClientsCount(), 2, "Expecting 2 initial clients")
 }
 
+func TestAdminAPIClientsManager_GatewaysForClient(t *testing.T) {
+	newClientForPod := func(url string, pod k8stypes.NamespacedName) *adminapi.Client {
+		client, err := adminapi.NewTestClient(url)
+		require.NoError(t, err)
+		client.AttachPodReference(pod)
+		return client
+	}
+	var (
+		podA     = k8stypes.NamespacedName{Namespace: "kong", Name: "pod-a"}
+		podB     = k8stypes.NamespacedName{Namespace: "kong", Name: "pod-b"}
+		gatewayA = k8stypes.NamespacedName{Namespace: "default", Name: "gateway-a"}
+		gatewayB = k8stypes.NamespacedName{Namespace: "default", Name: "gateway-b"}
+
+		clientA          = newClientForPod("localhost:8080", podA)
+		clientB          = newClientForPod("localhost:8081", podB)
+		clientWithoutPod = lo.Must(adminapi.NewTestClient("localhost:8082"))
+	)
+
+	m, err := clients.NewAdminAPIClientsManager(
+		context.Background(),
+		zapr.NewLogger(zap.NewNop()),
+		[]*adminapi.Client{clientA, clientB, clientWithoutPod},
+		&mockReadinessChecker{},
+	)
+	require.NoError(t, err)
+	require.Empty(t, m.GatewaysForClient(clientA), "no gateways are expected before association")
+
+	m.AssociateGatewaysPods(map[k8stypes.NamespacedName][]k8stypes.NamespacedName{
+		gatewayB: {podA, podB},
+		gatewayA: {podA},
+	})
+	require.Equal(t, []k8stypes.NamespacedName{gatewayA, gatewayB}, m.GatewaysForClient(clientA))
+	require.Equal(t, []k8stypes.NamespacedName{gatewayB}, m.GatewaysForClient(clientB))
+	require.Empty(t, m.GatewaysForClient(clientWithoutPod))
+
+	m.AssociateGatewaysPods(map[k8stypes.NamespacedName][]k8stypes.NamespacedName{
+		gatewayA: {podB},
+	})
+	require.Empty(t, m.GatewaysForClient(clientA), "previous association should be replaced")
+	require.Equal(t, []k8stypes.NamespacedName{gatewayA}, m.GatewaysForClient(clientB))
+}
+
 func TestAdminAPIClientsManager_SubscribeToGatewayClientsChanges(t *testing.T) {
 	t.Parallel()
 
@@ -16,16 +16,18 @@ func StartDiagnosticsServer(
 	c *manager.Config,
 	logger logr.Logger,
 ) (diagnostics.Server, error) {
-	if !c.EnableProfiling && !c.EnableConfigDumps {
+	if !c.EnableProfiling && !c.EnableConfigDumps && !c.EnableSyncEvents {
 		logger.Info("Diagnostics server disabled")
 		return diagnostics.Server{}, nil
 	}
 	logger.Info("Starting diagnostics server")
 
 	s := diagnostics.NewServer(logger, diagnostics.ServerConfig{
-		ProfilingEnabled:    c.EnableProfiling,
-		ConfigDumpsEnabled:  c.EnableConfigDumps,
-		DumpSensitiveConfig: c.DumpSensitiveConfig,
+		ProfilingEnabled:       c.EnableProfiling,
+		ConfigDumpsEnabled:     c.EnableConfigDumps,
+		DumpSensitiveConfig:    c.DumpSensitiveConfig,
+		ConfigDumpsHistorySize: c.ConfigDumpsHistorySize,
+		SyncEventsEnabled:      c.EnableSyncEvents,
 	})
 	go func() {
 		if err := s.Listen(ctx, port); err != nil {
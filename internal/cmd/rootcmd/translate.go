@@ -0,0 +1,291 @@
+package rootcmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/kong/go-kong/kong"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	cliflag "k8s.io/component-base/cli/flag"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	dpconf "github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/config"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/deckgen"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/featuregates"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+)
+
+const (
+	translateOutputYAML = "yaml"
+	translateOutputJSON = "json"
+
+	// translateStdinFilename is the filename that makes the translate command read manifests from stdin.
+	translateStdinFilename = "-"
+)
+
+// TranslateConfig holds the configuration of the translate command.
+type TranslateConfig struct {
+	Filenames                 []string
+	Output                    string
+	IngressClassName          string
+	KongWorkspace             string
+	RouterFlavor              string
+	EnterpriseEdition         bool
+	FeatureGates              map[string]bool
+	FailOnTranslationFailures bool
+}
+
+// GetTranslateCmd returns the command translating Kubernetes manifests into Kong declarative configuration
+// offline, without connecting to a Kubernetes cluster or Kong Gateway.
+func GetTranslateCmd() *cobra.Command {
+	var cfg TranslateConfig
+	cmd := &cobra.Command{
+		Use:   "translate",
+		Short: "Translate Kubernetes manifests into Kong declarative configuration",
+		Long: "Translate Kubernetes manifests into Kong declarative configuration (in decK format) offline, " +
+			"using the same translation logic as the controller. Translation failures are printed to stderr.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Translate(cmd.Context(), cfg, cmd.InOrStdin(), cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+		SilenceUsage: true,
+	}
+
+	flagSet := cmd.Flags()
+	flagSet.StringSliceVarP(&cfg.Filenames, "filename", "f", nil,
+		`Files or directories containing Kubernetes manifests to translate. Use "-" to read from stdin.`)
+	flagSet.StringVarP(&cfg.Output, "output", "o", translateOutputYAML,
+		fmt.Sprintf("Output format of the generated configuration. One of: %s, %s.", translateOutputYAML, translateOutputJSON))
+	flagSet.StringVar(&cfg.IngressClassName, "ingress-class", annotations.DefaultIngressClass,
+		`Name of the ingress class whose objects should be translated.`)
+	flagSet.StringVar(&cfg.KongWorkspace, "kong-workspace", "",
+		"Kong Enterprise workspace the configuration is generated for.")
+	flagSet.StringVar(&cfg.RouterFlavor, "router-flavor", string(dpconf.RouterFlavorTraditionalCompatible),
+		"Kong Gateway router flavor to generate the routes for.")
+	flagSet.BoolVar(&cfg.EnterpriseEdition, "enterprise", false,
+		"Translate objects that are only available in Kong Enterprise.")
+	flagSet.Var(cliflag.NewMapStringBool(&cfg.FeatureGates), "feature-gates",
+		"A set of comma separated key=value pairs that describe feature gates for alpha/beta/experimental features. "+
+			fmt.Sprintf("See the Feature Gates documentation for information and available options: %s", featuregates.DocsURL))
+	flagSet.BoolVar(&cfg.FailOnTranslationFailures, "fail-on-translation-failures", false,
+		"Exit with a non-zero code when any of the objects failed to be translated.")
+	_ = cmd.MarkFlagRequired("filename")
+
+	return cmd
+}
+
+// Translate reads Kubernetes manifests according to cfg, translates them into Kong declarative configuration
+// and writes it to out. Translation failures are written to errOut.
+func Translate(ctx context.Context, cfg TranslateConfig, in io.Reader, out, errOut io.Writer) error {
+	if cfg.Output != translateOutputYAML && cfg.Output != translateOutputJSON {
+		return fmt.Errorf("unsupported output format %q, must be one of: %s, %s", cfg.Output, translateOutputYAML, translateOutputJSON)
+	}
+
+	logger := logr.Discard()
+	featureGates, err := featuregates.New(logger, cfg.FeatureGates)
+	if err != nil {
+		return err
+	}
+
+	documents, err := readTranslateDocuments(cfg.Filenames, in)
+	if err != nil {
+		return err
+	}
+	objects, err := filterSupportedDocuments(documents, errOut)
+	if err != nil {
+		return err
+	}
+	cacheStores, err := store.NewCacheStoresFromObjYAML(objects...)
+	if err != nil {
+		return fmt.Errorf("failed to load objects: %w", err)
+	}
+
+	routerFlavor := dpconf.RouterFlavor(cfg.RouterFlavor)
+	featureFlags := translator.NewFeatureFlags(featureGates, routerFlavor, false, cfg.EnterpriseEdition)
+	t, err := translator.NewTranslator(
+		logger,
+		store.New(cacheStores, cfg.IngressClassName, logger),
+		cfg.KongWorkspace,
+		featureFlags,
+		offlineSchemaServiceProvider{},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create translator: %w", err)
+	}
+	result := t.BuildKongConfig()
+
+	content := deckgen.ToDeckContent(ctx, logger, result.KongState, deckgen.GenerateDeckContentParams{
+		ExpressionRoutes: featureFlags.ExpressionRoutes,
+		PluginSchemas:    offlinePluginSchemaStore{},
+	})
+	var b []byte
+	switch cfg.Output {
+	case translateOutputJSON:
+		b, err = json.MarshalIndent(content, "", "  ")
+		b = append(b, '\n')
+	default:
+		b, err = yaml.Marshal(content)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to marshal configuration: %w", err)
+	}
+	if _, err := out.Write(b); err != nil {
+		return err
+	}
+
+	printTranslationFailures(errOut, result.TranslationFailures)
+	if cfg.FailOnTranslationFailures && len(result.TranslationFailures) > 0 {
+		return fmt.Errorf("%d translation failure(s) occurred", len(result.TranslationFailures))
+	}
+	return nil
+}
+
+// readTranslateDocuments reads YAML documents from the given files, directories (non-recursively) or stdin.
+func readTranslateDocuments(filenames []string, in io.Reader) ([][]byte, error) {
+	var documents [][]byte
+	for _, filename := range filenames {
+		if filename == translateStdinFilename {
+			docs, err := splitYAMLDocuments(in)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read stdin: %w", err)
+			}
+			documents = append(documents, docs...)
+			continue
+		}
+
+		paths := []string{filename}
+		if info, err := os.Stat(filename); err != nil {
+			return nil, err
+		} else if info.IsDir() {
+			entries, err := os.ReadDir(filename)
+			if err != nil {
+				return nil, err
+			}
+			paths = paths[:0]
+			for _, entry := range entries {
+				switch filepath.Ext(entry.Name()) {
+				case ".yaml", ".yml", ".json":
+					if !entry.IsDir() {
+						paths = append(paths, filepath.Join(filename, entry.Name()))
+					}
+				}
+			}
+		}
+
+		for _, path := range paths {
+			docs, err := readYAMLFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s: %w", path, err)
+			}
+			documents = append(documents, docs...)
+		}
+	}
+	return documents, nil
+}
+
+func readYAMLFile(path string) ([][]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return splitYAMLDocuments(f)
+}
+
+// splitYAMLDocuments splits a multi-document YAML stream into separate non-empty documents.
+func splitYAMLDocuments(r io.Reader) ([][]byte, error) {
+	var documents [][]byte
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return documents, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(strings.TrimSpace(string(doc))) == 0 {
+			continue
+		}
+		documents = append(documents, doc)
+	}
+}
+
+// filterSupportedDocuments returns only documents with objects that the translator can consume, reporting
+// the skipped ones to errOut, so that manifests can contain e.g. Deployments.
+func filterSupportedDocuments(documents [][]byte, errOut io.Writer) ([][]byte, error) {
+	supported := make([][]byte, 0, len(documents))
+	for _, doc := range documents {
+		var obj struct {
+			APIVersion string `json:"apiVersion"`
+			Kind       string `json:"kind"`
+			Metadata   struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"metadata"`
+		}
+		if err := yaml.Unmarshal(doc, &obj); err != nil {
+			return nil, fmt.Errorf("failed to parse object: %w", err)
+		}
+		// Comment-only documents don't contain any object.
+		if obj.APIVersion == "" && obj.Kind == "" {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(obj.APIVersion)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse apiVersion of %s %s: %w", obj.Kind, obj.Metadata.Name, err)
+		}
+		if !store.IsObjectKindSupported(gv.WithKind(obj.Kind)) {
+			fmt.Fprintf(errOut, "Skipping unsupported object %s %s\n", obj.Kind, namespacedName(obj.Metadata.Namespace, obj.Metadata.Name))
+			continue
+		}
+		supported = append(supported, doc)
+	}
+	return supported, nil
+}
+
+func printTranslationFailures(errOut io.Writer, translationFailures []failures.ResourceFailure) {
+	for _, failure := range translationFailures {
+		objects := make([]string, 0, len(failure.CausingObjects()))
+		for _, obj := range failure.CausingObjects() {
+			objects = append(objects, fmt.Sprintf("%s %s",
+				obj.GetObjectKind().GroupVersionKind().Kind, namespacedName(obj.GetNamespace(), obj.GetName())))
+		}
+		fmt.Fprintf(errOut, "Translation failure (%s): %s\n", strings.Join(objects, ", "), failure.Message())
+	}
+}
+
+func namespacedName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
+
+// offlineSchemaServiceProvider provides a schema service that is always unavailable as there's no Kong Gateway
+// to fetch schemas from when translating offline.
+type offlineSchemaServiceProvider struct{}
+
+func (offlineSchemaServiceProvider) GetSchemaService() kong.AbstractSchemaService {
+	return translator.UnavailableSchemaService{}
+}
+
+// offlinePluginSchemaStore is a plugin schema store that is always unavailable as there's no Kong Gateway
+// to fetch schemas from when translating offline. Plugins' defaults are not filled in because of that.
+type offlinePluginSchemaStore struct{}
+
+func (offlinePluginSchemaStore) Schema(_ context.Context, pluginName string) (map[string]interface{}, error) {
+	return nil, fmt.Errorf("schema of plugin %s is not available offline", pluginName)
+}
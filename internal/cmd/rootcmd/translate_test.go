@@ -0,0 +1,131 @@
+package rootcmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kong/go-database-reconciler/pkg/file"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+const translateTestManifests = `
+# Objects of kinds unsupported by the translator should be skipped.
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: backend
+  namespace: default
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: backend
+  namespace: default
+spec:
+  ports:
+  - port: 80
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: ingress
+  namespace: default
+spec:
+  ingressClassName: kong
+  rules:
+  - http:
+      paths:
+      - path: /
+        pathType: Prefix
+        backend:
+          service:
+            name: backend
+            port:
+              number: 80
+---
+apiVersion: configuration.konghq.com/v1
+kind: KongConsumer
+metadata:
+  name: consumer
+  namespace: default
+  annotations:
+    kubernetes.io/ingress.class: kong
+`
+
+func TestTranslate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("manifests from stdin are translated to YAML", func(t *testing.T) {
+		var out, errOut bytes.Buffer
+		err := Translate(ctx, TranslateConfig{
+			Filenames:        []string{translateStdinFilename},
+			Output:           translateOutputYAML,
+			IngressClassName: "kong",
+			RouterFlavor:     "traditional_compatible",
+		}, strings.NewReader(translateTestManifests), &out, &errOut)
+		require.NoError(t, err)
+
+		var content file.Content
+		require.NoError(t, yaml.Unmarshal(out.Bytes(), &content))
+		require.Len(t, content.Services, 1)
+		assert.Equal(t, "default.backend.80", *content.Services[0].Name)
+		require.Len(t, content.Services[0].Routes, 1)
+		require.Len(t, content.Upstreams, 1)
+
+		assert.Contains(t, errOut.String(), "Skipping unsupported object Deployment default/backend")
+		assert.Contains(t, errOut.String(), "Translation failure (KongConsumer default/consumer): no username or custom_id specified")
+	})
+
+	t.Run("manifests from a directory are translated to JSON", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "manifests.yaml"), []byte(translateTestManifests), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a manifest"), 0o600))
+
+		var out, errOut bytes.Buffer
+		err := Translate(ctx, TranslateConfig{
+			Filenames:        []string{dir},
+			Output:           translateOutputJSON,
+			IngressClassName: "kong",
+			RouterFlavor:     "expressions",
+		}, nil, &out, &errOut)
+		require.NoError(t, err)
+
+		var content file.Content
+		require.NoError(t, json.Unmarshal(out.Bytes(), &content))
+		require.Len(t, content.Services, 1)
+		require.Len(t, content.Services[0].Routes, 1)
+		assert.NotNil(t, content.Services[0].Routes[0].Expression, "expression routes should be generated")
+	})
+
+	t.Run("translation failures result in an error when requested", func(t *testing.T) {
+		var out, errOut bytes.Buffer
+		err := Translate(ctx, TranslateConfig{
+			Filenames:                 []string{translateStdinFilename},
+			Output:                    translateOutputYAML,
+			IngressClassName:          "kong",
+			FailOnTranslationFailures: true,
+		}, strings.NewReader(translateTestManifests), &out, &errOut)
+		require.EqualError(t, err, "1 translation failure(s) occurred")
+		require.NotEmpty(t, out.String(), "configuration should be printed regardless of failures")
+	})
+
+	t.Run("unsupported output format", func(t *testing.T) {
+		err := Translate(ctx, TranslateConfig{Output: "toml"}, nil, &bytes.Buffer{}, &bytes.Buffer{})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid feature gate", func(t *testing.T) {
+		err := Translate(ctx, TranslateConfig{
+			Output:       translateOutputYAML,
+			FeatureGates: map[string]bool{"NotExistingFeature": true},
+		}, nil, &bytes.Buffer{}, &bytes.Buffer{})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,61 @@
+package configuration
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ctrlref "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/reference"
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+)
+
+func TestCoreV1ConfigMapReconciler_shouldReconcileConfigMap(t *testing.T) {
+	referencedConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "referenced",
+		},
+	}
+	plugin := &kongv1.KongPlugin{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "plugin",
+		},
+	}
+
+	r := &CoreV1ConfigMapReconciler{
+		ReferenceIndexers: ctrlref.NewCacheIndexers(logr.Discard()),
+	}
+	require.NoError(t, r.ReferenceIndexers.SetObjectReference(plugin, referencedConfigMap))
+
+	tests := []struct {
+		name      string
+		configMap *corev1.ConfigMap
+		want      bool
+	}{
+		{
+			name:      "ConfigMap referenced by a KongPlugin",
+			configMap: referencedConfigMap,
+			want:      true,
+		},
+		{
+			name: "ConfigMap not referenced by any object",
+			configMap: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "not-referenced",
+				},
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, r.shouldReconcileConfigMap(tt.configMap))
+		})
+	}
+}
@@ -244,7 +244,7 @@ func (r *KongUpstreamPolicyReconciler) getUpstreamPolicyForObject(ctx context.Co
 }
 
 // getUpstreamPoliciesForHTTPRouteServices enqueues a new reconcile request for the KongUpstreamPolicies referenced by
-// the Services of an HTTPRoute.
+// the Services and KongServiceFacades of an HTTPRoute.
 func (r *KongUpstreamPolicyReconciler) getUpstreamPoliciesForHTTPRouteServices(ctx context.Context, obj client.Object) []reconcile.Request {
 	httpRoute, ok := obj.(*gatewayapi.HTTPRoute)
 	if !ok {
@@ -253,7 +253,13 @@ func (r *KongUpstreamPolicyReconciler) getUpstreamPoliciesForHTTPRouteServices(c
 	var requests []reconcile.Request
 	for _, rule := range httpRoute.Spec.Rules {
 		for _, br := range rule.BackendRefs {
-			if !isSupportedHTTPRouteBackendRef(br.BackendRef) {
+			var backend client.Object
+			switch {
+			case isSupportedHTTPRouteBackendRef(br.BackendRef):
+				backend = &corev1.Service{}
+			case r.KongServiceFacadeEnabled && isServiceFacadeBackendRef(br.BackendRef):
+				backend = &incubatorv1alpha1.KongServiceFacade{}
+			default:
 				continue
 			}
 
@@ -261,23 +267,19 @@ func (r *KongUpstreamPolicyReconciler) getUpstreamPoliciesForHTTPRouteServices(c
 			if br.BackendRef.Namespace != nil {
 				namespace = string(*br.BackendRef.Namespace)
 			}
-			service := &corev1.Service{}
 			if err := r.Client.Get(ctx, k8stypes.NamespacedName{
 				Namespace: namespace,
 				Name:      string(br.BackendRef.Name),
-			}, service); err != nil {
+			}, backend); err != nil {
 				if !apierrors.IsNotFound(err) {
-					r.Log.Error(err, "Failed to retrieve Service in watch predicates",
-						"Service", fmt.Sprintf("%s/%s", namespace, string(br.BackendRef.Name)),
+					r.Log.Error(err, "Failed to retrieve HTTPRoute backend in watch predicates",
+						"backend", fmt.Sprintf("%s/%s", namespace, string(br.BackendRef.Name)),
 					)
 				}
 				continue
 			}
 
-			if service.Annotations == nil {
-				continue
-			}
-			upstreamPolicy, ok := service.Annotations[kongv1beta1.KongUpstreamPolicyAnnotationKey]
+			upstreamPolicy, ok := backend.GetAnnotations()[kongv1beta1.KongUpstreamPolicyAnnotationKey]
 			if !ok {
 				continue
 			}
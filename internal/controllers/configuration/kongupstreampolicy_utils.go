@@ -69,7 +69,7 @@ func (r *KongUpstreamPolicyReconciler) enforceKongUpstreamPolicyStatus(
 	}
 
 	// Build the status for each ancestor.
-	ancestorsStatus, err := r.buildAncestorsStatus(ctx, services, serviceFacades)
+	ancestorsStatus, err := r.buildAncestorsStatus(ctx, oldPolicy.Generation, services, serviceFacades)
 	if err != nil {
 		return false, err
 	}
@@ -129,30 +129,37 @@ func (r *KongUpstreamPolicyReconciler) maybeGetServiceFacadesReferencingUpstream
 	return serviceFacades.Items, nil
 }
 
-// buildAncestorsStatus creates a list of services with their conditions associated.
+// buildAncestorsStatus creates a list of services with their conditions associated. Conditions are given
+// the generation of the KongUpstreamPolicy they were observed for.
 func (r *KongUpstreamPolicyReconciler) buildAncestorsStatus(
 	ctx context.Context,
+	policyGeneration int64,
 	services []corev1.Service,
 	serviceFacades []incubatorv1alpha1.KongServiceFacade,
 ) ([]ancestorStatus, error) {
-	// Check if any Services have conflicts. We do not verify conflicts for KongServiceFacades as there's
-	// no scenario in which they would have one.
-	conflictedServices, err := r.getConflictedServices(ctx, services)
+	// Check if any Services or KongServiceFacades have conflicts.
+	httpRoutes, err := r.getHTTPRoutesReferencingServices(ctx, services, serviceFacades)
 	if err != nil {
 		return nil, err
 	}
+	conflictedServices := make(servicesSet)
+	for _, key := range GetServicesConflictedOnUpstreamPolicy(services, serviceFacades, httpRoutes) {
+		conflictedServices[serviceKey(key)] = struct{}{}
+	}
 
 	// Prepare conditions.
 	acceptedCondition := metav1.Condition{
 		Type:               string(gatewayapi.PolicyConditionAccepted),
 		Status:             metav1.ConditionTrue,
 		Reason:             string(gatewayapi.PolicyReasonAccepted),
+		ObservedGeneration: policyGeneration,
 		LastTransitionTime: metav1.Now(),
 	}
 	programmedCondition := metav1.Condition{
 		Type:               string(gatewayapi.GatewayConditionProgrammed),
 		Status:             metav1.ConditionTrue,
 		Reason:             string(gatewayapi.GatewayReasonProgrammed),
+		ObservedGeneration: policyGeneration,
 		LastTransitionTime: metav1.Now(),
 	}
 
@@ -189,7 +196,17 @@ func (r *KongUpstreamPolicyReconciler) buildAncestorsStatus(
 	}
 	for _, serviceFacade := range serviceFacades {
 		serviceFacade := serviceFacade
+		acceptedCondition := acceptedCondition
 		programmedCondition := programmedCondition
+
+		if _, isConflicted := conflictedServices[buildServiceFacadeReference(serviceFacade.Namespace, serviceFacade.Name)]; isConflicted {
+			// If the KongServiceFacade is conflicted, we change both conditions to False.
+			acceptedCondition.Status = metav1.ConditionFalse
+			acceptedCondition.Reason = string(gatewayapi.PolicyReasonConflicted)
+			programmedCondition.Status = metav1.ConditionFalse
+			programmedCondition.Reason = string(gatewayapi.GatewayReasonPending)
+		}
+
 		if !r.DataplaneClient.KubernetesObjectIsConfigured(&serviceFacade) {
 			// If the KongServiceFacade is not configured, we change it to False.
 			programmedCondition.Status = metav1.ConditionFalse
@@ -210,23 +227,30 @@ func (r *KongUpstreamPolicyReconciler) buildAncestorsStatus(
 	return ancestorsStatus, nil
 }
 
-// getConflictedServices returns a set of services that have conflicts.
-func (r *KongUpstreamPolicyReconciler) getConflictedServices(ctx context.Context, services []corev1.Service) (servicesSet, error) {
-	// return directly when HTTPRoute is not enabled, as it only check conflicted services in HTTPRoute backends only.
+// getHTTPRoutesReferencingServices returns HTTPRoutes that use any of the Services or KongServiceFacades as backends.
+func (r *KongUpstreamPolicyReconciler) getHTTPRoutesReferencingServices(
+	ctx context.Context,
+	services []corev1.Service,
+	serviceFacades []incubatorv1alpha1.KongServiceFacade,
+) ([]gatewayapi.HTTPRoute, error) {
+	// return directly when HTTPRoute is not enabled, as conflicts are checked in HTTPRoute backends only.
 	if !r.HTTPRouteEnabled {
-		return make(servicesSet), nil
+		return nil, nil
 	}
-	// Prepare a mapping for efficient lookups if a Service uses this KongUpstreamPolicy.
-	upstreamPolicyServices := make(servicesSet)
+
+	serviceKeys := make([]serviceKey, 0, len(services)+len(serviceFacades))
 	for _, service := range services {
-		upstreamPolicyServices[buildServiceReference(service.Namespace, service.Name)] = struct{}{}
+		serviceKeys = append(serviceKeys, buildServiceReference(service.Namespace, service.Name))
+	}
+	for _, serviceFacade := range serviceFacades {
+		serviceKeys = append(serviceKeys, buildServiceFacadeReference(serviceFacade.Namespace, serviceFacade.Name))
 	}
 
-	conflictedServices := make(servicesSet)
-	for serviceKey := range upstreamPolicyServices {
-		// We fetch all the HTTPRoutes that reference this service.
-		httpRoutes := &gatewayapi.HTTPRouteList{}
-		err := r.List(ctx, httpRoutes,
+	// We fetch all the HTTPRoutes that reference any of the services.
+	httpRoutes := make(map[k8stypes.NamespacedName]gatewayapi.HTTPRoute)
+	for _, serviceKey := range serviceKeys {
+		httpRouteList := &gatewayapi.HTTPRouteList{}
+		err := r.List(ctx, httpRouteList,
 			client.MatchingFields{
 				routeBackendRefServiceNameIndexKey: string(serviceKey),
 			},
@@ -234,14 +258,42 @@ func (r *KongUpstreamPolicyReconciler) getConflictedServices(ctx context.Context
 		if err != nil {
 			return nil, err
 		}
-		hasConflict := lo.ContainsBy(httpRoutes.Items, func(httpRoute gatewayapi.HTTPRoute) bool {
+		for _, httpRoute := range httpRouteList.Items {
+			httpRoutes[client.ObjectKeyFromObject(&httpRoute)] = httpRoute
+		}
+	}
+
+	return lo.Values(httpRoutes), nil
+}
+
+// GetServicesConflictedOnUpstreamPolicy returns keys of the given Services and KongServiceFacades (all referencing
+// the same KongUpstreamPolicy) that are used in a rule of any of the given HTTPRoutes together with a backendRef that
+// doesn't use the same KongUpstreamPolicy. Services are identified by "namespace/name" and KongServiceFacades by
+// "KongServiceFacade/namespace/name" keys.
+func GetServicesConflictedOnUpstreamPolicy(
+	services []corev1.Service,
+	serviceFacades []incubatorv1alpha1.KongServiceFacade,
+	httpRoutes []gatewayapi.HTTPRoute,
+) []string {
+	upstreamPolicyServices := make(servicesSet)
+	for _, service := range services {
+		upstreamPolicyServices[buildServiceReference(service.Namespace, service.Name)] = struct{}{}
+	}
+	for _, serviceFacade := range serviceFacades {
+		upstreamPolicyServices[buildServiceFacadeReference(serviceFacade.Namespace, serviceFacade.Name)] = struct{}{}
+	}
+
+	var conflictedServices []string
+	for serviceKey := range upstreamPolicyServices {
+		hasConflict := lo.ContainsBy(httpRoutes, func(httpRoute gatewayapi.HTTPRoute) bool {
 			return httpRouteHasUpstreamPolicyConflictedBackendRefsWithService(httpRoute, upstreamPolicyServices, serviceKey)
 		})
 		if hasConflict {
-			conflictedServices[serviceKey] = struct{}{}
+			conflictedServices = append(conflictedServices, string(serviceKey))
 		}
 	}
-	return conflictedServices, nil
+	sort.Strings(conflictedServices)
+	return conflictedServices
 }
 
 // httpRouteHasUpstreamPolicyConflictedBackendRefsWithService checks if there's any HTTPRoute's rule that uses multiple backendRefs
@@ -377,7 +429,8 @@ func isPolicyStatusUpdated(oldStatus, newStatus gatewayapi.PolicyStatus) bool {
 			if newCondition.Type != oldCondition.Type ||
 				newCondition.Status != oldCondition.Status ||
 				newCondition.Reason != oldCondition.Reason ||
-				newCondition.Message != oldCondition.Message {
+				newCondition.Message != oldCondition.Message ||
+				newCondition.ObservedGeneration != oldCondition.ObservedGeneration {
 				return false
 			}
 		}
@@ -387,20 +440,36 @@ func isPolicyStatusUpdated(oldStatus, newStatus gatewayapi.PolicyStatus) bool {
 }
 
 func backendRefToServiceRef(routeNamespace string, br gatewayapi.BackendRef) serviceKey {
-	if !isSupportedHTTPRouteBackendRef(br) {
-		return ""
-	}
 	namespace := routeNamespace
 	if br.Namespace != nil {
 		namespace = string(*br.Namespace)
 	}
-	return buildServiceReference(namespace, string(br.Name))
+	switch {
+	case isSupportedHTTPRouteBackendRef(br):
+		return buildServiceReference(namespace, string(br.Name))
+	case isServiceFacadeBackendRef(br):
+		return buildServiceFacadeReference(namespace, string(br.Name))
+	default:
+		return ""
+	}
 }
 
 func buildServiceReference(namespace, name string) serviceKey {
 	return serviceKey(fmt.Sprintf("%s/%s", namespace, name))
 }
 
+// buildServiceFacadeReference returns a key of a KongServiceFacade. It's prefixed with the kind not to clash
+// with keys of Services.
+func buildServiceFacadeReference(namespace, name string) serviceKey {
+	return serviceKey(fmt.Sprintf("%s/%s/%s", incubatorv1alpha1.KongServiceFacadeKind, namespace, name))
+}
+
+// isServiceFacadeBackendRef returns true if the backendRef references a KongServiceFacade.
+func isServiceFacadeBackendRef(br gatewayapi.BackendRef) bool {
+	return br.Group != nil && string(*br.Group) == incubatorv1alpha1.GroupVersion.Group &&
+		br.Kind != nil && string(*br.Kind) == incubatorv1alpha1.KongServiceFacadeKind
+}
+
 func isSupportedHTTPRouteBackendRef(br gatewayapi.BackendRef) bool {
 	groupIsCoreOrNilOrEmpty := br.Group == nil || *br.Group == "core" || *br.Group == ""
 	kindIsServiceOrNil := br.Kind == nil || *br.Kind == "Service"
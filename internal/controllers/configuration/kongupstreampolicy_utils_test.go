@@ -678,6 +678,83 @@ func TestEnforceKongUpstreamPolicyStatus(t *testing.T) {
 			},
 			updated: true,
 		},
+		{
+			name: "service referencing the policy with status observed for an older generation. Status update.",
+			kongUpstreamPolicy: kongv1beta1.KongUpstreamPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       policyName,
+					Namespace:  testNamespace,
+					Generation: 2,
+				},
+				Status: gatewayapi.PolicyStatus{
+					Ancestors: []gatewayapi.PolicyAncestorStatus{
+						{
+							AncestorRef: gatewayapi.ParentReference{
+								Group:     lo.ToPtr(gatewayapi.Group("core")),
+								Kind:      lo.ToPtr(gatewayapi.Kind("Service")),
+								Namespace: lo.ToPtr(gatewayapi.Namespace(testNamespace)),
+								Name:      gatewayapi.ObjectName("svc-1"),
+							},
+							ControllerName: gatewaycontroller.GetControllerName(),
+							Conditions: []metav1.Condition{
+								{
+									Type:               string(gatewayapi.PolicyConditionAccepted),
+									Status:             metav1.ConditionTrue,
+									Reason:             string(gatewayapi.PolicyReasonAccepted),
+									ObservedGeneration: 1,
+								},
+								{
+									Type:               string(gatewayapi.GatewayConditionProgrammed),
+									Status:             metav1.ConditionTrue,
+									Reason:             string(gatewayapi.GatewayReasonProgrammed),
+									ObservedGeneration: 1,
+								},
+							},
+						},
+					},
+				},
+			},
+			inputObjects: []client.Object{
+				&corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc-1",
+						Namespace: testNamespace,
+						Annotations: map[string]string{
+							kongv1beta1.KongUpstreamPolicyAnnotationKey: policyName,
+						},
+					},
+				},
+			},
+			objectsConfiguredInDataPlane: true,
+			expectedKongUpstreamPolicyStatus: gatewayapi.PolicyStatus{
+				Ancestors: []gatewayapi.PolicyAncestorStatus{
+					{
+						AncestorRef: gatewayapi.ParentReference{
+							Group:     lo.ToPtr(gatewayapi.Group("core")),
+							Kind:      lo.ToPtr(gatewayapi.Kind("Service")),
+							Namespace: lo.ToPtr(gatewayapi.Namespace(testNamespace)),
+							Name:      gatewayapi.ObjectName("svc-1"),
+						},
+						ControllerName: gatewaycontroller.GetControllerName(),
+						Conditions: []metav1.Condition{
+							{
+								Type:               string(gatewayapi.PolicyConditionAccepted),
+								Status:             metav1.ConditionTrue,
+								Reason:             string(gatewayapi.PolicyReasonAccepted),
+								ObservedGeneration: 2,
+							},
+							{
+								Type:               string(gatewayapi.GatewayConditionProgrammed),
+								Status:             metav1.ConditionTrue,
+								Reason:             string(gatewayapi.GatewayReasonProgrammed),
+								ObservedGeneration: 2,
+							},
+						},
+					},
+				},
+			},
+			updated: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -867,6 +944,70 @@ func TestHttpRouteHasUpstreamPolicyConflictedBackendRefsWithService(t *testing.T
 	}
 }
 
+func TestGetServicesConflictedOnUpstreamPolicy(t *testing.T) {
+	services := []corev1.Service{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "svc-1"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "svc-2"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "svc-3"}},
+	}
+	httpRoutes := []gatewayapi.HTTPRoute{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "httpRoute-1", Namespace: "default"},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Rules: []gatewayapi.HTTPRouteRule{
+					{
+						BackendRefs: []gatewayapi.HTTPBackendRef{
+							builder.NewHTTPBackendRef("svc-1").Build(),
+							builder.NewHTTPBackendRef("svc-2").Build(),
+						},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "httpRoute-2", Namespace: "default"},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Rules: []gatewayapi.HTTPRouteRule{
+					{
+						BackendRefs: []gatewayapi.HTTPBackendRef{
+							builder.NewHTTPBackendRef("svc-3").Build(),
+							builder.NewHTTPBackendRef("svc-not-using-policy").Build(),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	serviceFacades := []incubatorv1alpha1.KongServiceFacade{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "facade"}},
+	}
+	httpRouteWithFacade := gatewayapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "httpRoute-3", Namespace: "default"},
+		Spec: gatewayapi.HTTPRouteSpec{
+			Rules: []gatewayapi.HTTPRouteRule{
+				{
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("facade").
+							WithGroup(incubatorv1alpha1.GroupVersion.Group).
+							WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+							Build(),
+						builder.NewHTTPBackendRef("svc-not-using-policy").Build(),
+					},
+				},
+			},
+		},
+	}
+
+	require.Empty(t, GetServicesConflictedOnUpstreamPolicy(services, nil, httpRoutes[:1]))
+	require.Equal(t, []string{"default/svc-3"}, GetServicesConflictedOnUpstreamPolicy(services, nil, httpRoutes))
+	require.Equal(t, []string{"default/svc-1"}, GetServicesConflictedOnUpstreamPolicy(services[:1], nil, httpRoutes))
+	require.Equal(t,
+		[]string{"KongServiceFacade/default/facade"},
+		GetServicesConflictedOnUpstreamPolicy(nil, serviceFacades, []gatewayapi.HTTPRoute{httpRouteWithFacade}),
+	)
+}
+
 func TestBuildPolicyStatus(t *testing.T) {
 	acceptedCondition := metav1.Condition{
 		Type:   string(gatewayapi.PolicyConditionAccepted),
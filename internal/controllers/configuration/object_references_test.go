@@ -229,6 +229,118 @@ func TestListKongClusterPluginReferredSecrets(t *testing.T) {
 	}
 }
 
+func TestListKongPluginReferredConfigMaps(t *testing.T) {
+	testCases := []struct {
+		name             string
+		plugin           *kongv1.KongPlugin
+		refConfigMapName []k8stypes.NamespacedName
+	}{
+		{
+			name: "kong_plugin_refer_secrets_only",
+			plugin: &kongv1.KongPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      "plugin1",
+				},
+				ConfigFrom: &kongv1.ConfigSource{
+					SecretValue: kongv1.SecretValueFromSource{
+						Secret: "secret1",
+						Key:    "k",
+					},
+				},
+			},
+		},
+		{
+			name: "kong_plugin_refer_configmaps",
+			plugin: &kongv1.KongPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      "plugin1",
+				},
+				ConfigPatches: []kongv1.ConfigPatch{
+					{
+						Path: "/a",
+						ValueFrom: kongv1.ConfigSource{
+							ConfigMapValue: &kongv1.ConfigMapValueFromSource{ConfigMap: "cm1", Key: "a"},
+						},
+					},
+					{
+						Path: "/b",
+						ValueFrom: kongv1.ConfigSource{
+							ConfigMapValue: &kongv1.ConfigMapValueFromSource{ConfigMap: "cm1", Key: "b"},
+						},
+					},
+					{
+						Path: "/c",
+						ValueFrom: kongv1.ConfigSource{
+							SecretValue: kongv1.SecretValueFromSource{Secret: "secret1", Key: "c"},
+						},
+					},
+					{
+						Path: "/d",
+						ValueFrom: kongv1.ConfigSource{
+							ConfigMapValue: &kongv1.ConfigMapValueFromSource{ConfigMap: "cm2", Key: "d"},
+						},
+					},
+				},
+			},
+			refConfigMapName: []k8stypes.NamespacedName{
+				{Namespace: "ns", Name: "cm1"},
+				{Namespace: "ns", Name: "cm2"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configMapNames := listKongPluginReferredConfigMaps(tc.plugin)
+			require.ElementsMatch(t, tc.refConfigMapName, configMapNames)
+		})
+	}
+}
+
+func TestListKongClusterPluginReferredConfigMaps(t *testing.T) {
+	testCases := []struct {
+		name             string
+		plugin           *kongv1.KongClusterPlugin
+		refConfigMapName []k8stypes.NamespacedName
+	}{
+		{
+			name: "kong_cluster_plugin_refer_no_configmaps",
+			plugin: &kongv1.KongClusterPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "plugin1",
+				},
+			},
+		},
+		{
+			name: "kong_cluster_plugin_refer_configmaps",
+			plugin: &kongv1.KongClusterPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "plugin1",
+				},
+				ConfigFrom: &kongv1.NamespacedConfigSource{
+					ConfigMapValue: &kongv1.NamespacedConfigMapValueFromSource{
+						Namespace: "ns",
+						ConfigMap: "cm1",
+						Key:       "k",
+					},
+				},
+			},
+			refConfigMapName: []k8stypes.NamespacedName{
+				{Namespace: "ns", Name: "cm1"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configMapNames := listKongClusterPluginReferredConfigMaps(tc.plugin)
+			require.ElementsMatch(t, tc.refConfigMapName, configMapNames)
+		})
+	}
+}
+
 func TestListKongConsumerReferredSecrets(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -64,6 +64,7 @@ type CoreV1ServiceReconciler struct {
 	Scheme            *runtime.Scheme
 	DataplaneClient   controllers.DataPlane
 	CacheSyncTimeout  time.Duration
+	StatusQueue       *status.Queue
 	ReferenceIndexers ctrlref.CacheIndexers
 }
 
@@ -80,6 +81,19 @@ func (r *CoreV1ServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			},
 			CacheSyncTimeout: r.CacheSyncTimeout,
 		})
+	// if configured, start the status updater controller
+	if r.StatusQueue != nil {
+		blder.WatchesRawSource(
+			source.Channel(
+				r.StatusQueue.Subscribe(schema.GroupVersionKind{
+					Group:   "",
+					Version: "v1",
+					Kind:    "Service",
+				}),
+				&handler.EnqueueRequestForObject{},
+			),
+		)
+	}
 	return blder.For(&corev1.Service{}).
 		Complete(r)
 }
@@ -141,6 +155,26 @@ func (r *CoreV1ServiceReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	if err := r.DataplaneClient.UpdateObject(obj); err != nil {
 		return ctrl.Result{}, err
 	}
+	// if status updates are enabled report the status for the object
+	if r.DataplaneClient.AreKubernetesObjectReportsEnabled() {
+		log.V(util.DebugLevel).Info("Updating programmed condition status", "namespace", req.Namespace, "name", req.Name)
+		configurationStatus := r.DataplaneClient.KubernetesObjectConfigurationStatus(obj)
+		programmedConditionNeeded := ctrlutils.IsProgrammedConditionNeeded(configurationStatus, obj.Status.Conditions)
+		conditions, updateNeeded := ctrlutils.EnsureProgrammedCondition(
+			configurationStatus,
+			obj.Generation,
+			obj.Status.Conditions,
+			ctrlutils.WithConfigurationHash(r.DataplaneClient.KubernetesObjectsConfigurationHash()),
+		)
+		if !programmedConditionNeeded {
+			conditions, updateNeeded = obj.Status.Conditions, false
+		}
+		obj.Status.Conditions = conditions
+		if updateNeeded {
+			return ctrl.Result{}, r.Status().Update(ctx, obj)
+		}
+		log.V(util.DebugLevel).Info("Status update not needed", "namespace", req.Namespace, "name", req.Name)
+	}
 	// update reference relationship from the Service to other objects.
 	if err := updateReferredObjects(ctx, r.Client, r.ReferenceIndexers, r.DataplaneClient, obj); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -236,6 +270,87 @@ func (r *DiscoveryV1EndpointSliceReconciler) Reconcile(ctx context.Context, req
 	return ctrl.Result{}, nil
 }
 
+// -----------------------------------------------------------------------------
+// CoreV1 Namespace - Reconciler
+// -----------------------------------------------------------------------------
+
+// CoreV1NamespaceReconciler reconciles Namespace resources
+type CoreV1NamespaceReconciler struct {
+	client.Client
+
+	Log              logr.Logger
+	Scheme           *runtime.Scheme
+	DataplaneClient  controllers.DataPlane
+	CacheSyncTimeout time.Duration
+}
+
+var _ controllers.Reconciler = &CoreV1NamespaceReconciler{}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CoreV1NamespaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	blder := ctrl.NewControllerManagedBy(mgr).
+		// set the controller name
+		Named("CoreV1Namespace").
+		WithOptions(controller.Options{
+			LogConstructor: func(_ *reconcile.Request) logr.Logger {
+				return r.Log
+			},
+			CacheSyncTimeout: r.CacheSyncTimeout,
+		})
+	return blder.For(&corev1.Namespace{}).
+		Complete(r)
+}
+
+// SetLogger sets the logger.
+func (r *CoreV1NamespaceReconciler) SetLogger(l logr.Logger) {
+	r.Log = l
+}
+
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// Reconcile processes the watched objects
+func (r *CoreV1NamespaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("CoreV1Namespace", req.NamespacedName)
+
+	// get the relevant object
+	obj := new(corev1.Namespace)
+
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			obj.Namespace = req.Namespace
+			obj.Name = req.Name
+
+			return ctrl.Result{}, r.DataplaneClient.DeleteObject(obj)
+		}
+		return ctrl.Result{}, err
+	}
+	log.V(util.DebugLevel).Info("Reconciling resource", "namespace", req.Namespace, "name", req.Name)
+
+	// clean the object up if it's being deleted
+	if !obj.DeletionTimestamp.IsZero() && time.Now().After(obj.DeletionTimestamp.Time) {
+		log.V(util.DebugLevel).Info("Resource is being deleted, its configuration will be removed", "type", "Namespace", "namespace", req.Namespace, "name", req.Name)
+
+		objectExistsInCache, err := r.DataplaneClient.ObjectExists(obj)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if objectExistsInCache {
+			if err := r.DataplaneClient.DeleteObject(obj); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{Requeue: true}, nil // wait until the object is no longer present in the cache
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// update the kong Admin API with the changes
+	if err := r.DataplaneClient.UpdateObject(obj); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
 // -----------------------------------------------------------------------------
 // NetV1 Ingress - Reconciler
 // -----------------------------------------------------------------------------
@@ -323,7 +438,7 @@ func (r *NetV1IngressReconciler) SetLogger(l logr.Logger) {
 	r.Log = l
 }
 
-//+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;update
 //+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses/status,verbs=get;update;patch
 
 // Reconcile processes the watched objects
@@ -407,6 +522,16 @@ func (r *NetV1IngressReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	}
 	// if status updates are enabled report the status for the object
 	if r.DataplaneClient.AreKubernetesObjectReportsEnabled() {
+		log.V(util.DebugLevel).Info("Updating programmed annotations", "namespace", req.Namespace, "name", req.Name)
+		if annotations, updateNeeded := ctrlutils.EnsureProgrammedAnnotations(
+			r.DataplaneClient.KubernetesObjectConfigurationStatus(obj),
+			obj.Generation,
+			r.DataplaneClient.KubernetesObjectsConfigurationHash(),
+			obj.GetAnnotations(),
+		); updateNeeded {
+			obj.SetAnnotations(annotations)
+			return ctrl.Result{}, r.Update(ctx, obj)
+		}
 		log.V(util.DebugLevel).Info("Determining whether data-plane configuration has succeeded", "namespace", req.Namespace, "name", req.Name)
 
 		if !r.DataplaneClient.KubernetesObjectIsConfigured(obj) {
@@ -609,6 +734,7 @@ type KongV1KongPluginReconciler struct {
 	Scheme            *runtime.Scheme
 	DataplaneClient   controllers.DataPlane
 	CacheSyncTimeout  time.Duration
+	StatusQueue       *status.Queue
 	ReferenceIndexers ctrlref.CacheIndexers
 }
 
@@ -625,6 +751,19 @@ func (r *KongV1KongPluginReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			},
 			CacheSyncTimeout: r.CacheSyncTimeout,
 		})
+	// if configured, start the status updater controller
+	if r.StatusQueue != nil {
+		blder.WatchesRawSource(
+			source.Channel(
+				r.StatusQueue.Subscribe(schema.GroupVersionKind{
+					Group:   "configuration.konghq.com",
+					Version: "v1",
+					Kind:    "KongPlugin",
+				}),
+				&handler.EnqueueRequestForObject{},
+			),
+		)
+	}
 	return blder.For(&kongv1.KongPlugin{}).
 		Complete(r)
 }
@@ -686,6 +825,23 @@ func (r *KongV1KongPluginReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	if err := r.DataplaneClient.UpdateObject(obj); err != nil {
 		return ctrl.Result{}, err
 	}
+	// if status updates are enabled report the status for the object
+	if r.DataplaneClient.AreKubernetesObjectReportsEnabled() {
+		log.V(util.DebugLevel).Info("Updating programmed condition status", "namespace", req.Namespace, "name", req.Name)
+		configurationStatus := r.DataplaneClient.KubernetesObjectConfigurationStatus(obj)
+		conditions, updateNeeded := ctrlutils.EnsureProgrammedCondition(
+			configurationStatus,
+			obj.Generation,
+			obj.Status.Conditions,
+			ctrlutils.WithConfigurationHash(r.DataplaneClient.KubernetesObjectsConfigurationHash()),
+			ctrlutils.WithUnknownMessage("Found no references to this resource in Ingress or similar resources."),
+		)
+		obj.Status.Conditions = conditions
+		if updateNeeded {
+			return ctrl.Result{}, r.Status().Update(ctx, obj)
+		}
+		log.V(util.DebugLevel).Info("Status update not needed", "namespace", req.Namespace, "name", req.Name)
+	}
 	// update reference relationship from the KongPlugin to other objects.
 	if err := updateReferredObjects(ctx, r.Client, r.ReferenceIndexers, r.DataplaneClient, obj); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -712,6 +868,7 @@ type KongV1KongClusterPluginReconciler struct {
 	Scheme           *runtime.Scheme
 	DataplaneClient  controllers.DataPlane
 	CacheSyncTimeout time.Duration
+	StatusQueue      *status.Queue
 
 	IngressClassName           string
 	DisableIngressClassLookups bool
@@ -731,6 +888,19 @@ func (r *KongV1KongClusterPluginReconciler) SetupWithManager(mgr ctrl.Manager) e
 			},
 			CacheSyncTimeout: r.CacheSyncTimeout,
 		})
+	// if configured, start the status updater controller
+	if r.StatusQueue != nil {
+		blder.WatchesRawSource(
+			source.Channel(
+				r.StatusQueue.Subscribe(schema.GroupVersionKind{
+					Group:   "configuration.konghq.com",
+					Version: "v1",
+					Kind:    "KongClusterPlugin",
+				}),
+				&handler.EnqueueRequestForObject{},
+			),
+		)
+	}
 	if !r.DisableIngressClassLookups {
 		blder.Watches(&netv1.IngressClass{},
 			handler.EnqueueRequestsFromMapFunc(r.listClassless),
@@ -843,6 +1013,23 @@ func (r *KongV1KongClusterPluginReconciler) Reconcile(ctx context.Context, req c
 	if err := r.DataplaneClient.UpdateObject(obj); err != nil {
 		return ctrl.Result{}, err
 	}
+	// if status updates are enabled report the status for the object
+	if r.DataplaneClient.AreKubernetesObjectReportsEnabled() {
+		log.V(util.DebugLevel).Info("Updating programmed condition status", "namespace", req.Namespace, "name", req.Name)
+		configurationStatus := r.DataplaneClient.KubernetesObjectConfigurationStatus(obj)
+		conditions, updateNeeded := ctrlutils.EnsureProgrammedCondition(
+			configurationStatus,
+			obj.Generation,
+			obj.Status.Conditions,
+			ctrlutils.WithConfigurationHash(r.DataplaneClient.KubernetesObjectsConfigurationHash()),
+			ctrlutils.WithUnknownMessage("Found no references to this resource in Ingress or similar resources."),
+		)
+		obj.Status.Conditions = conditions
+		if updateNeeded {
+			return ctrl.Result{}, r.Status().Update(ctx, obj)
+		}
+		log.V(util.DebugLevel).Info("Status update not needed", "namespace", req.Namespace, "name", req.Name)
+	}
 	// update reference relationship from the KongClusterPlugin to other objects.
 	if err := updateReferredObjects(ctx, r.Client, r.ReferenceIndexers, r.DataplaneClient, obj); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -1022,6 +1209,7 @@ func (r *KongV1KongConsumerReconciler) Reconcile(ctx context.Context, req ctrl.R
 			configurationStatus,
 			obj.Generation,
 			obj.Status.Conditions,
+			ctrlutils.WithConfigurationHash(r.DataplaneClient.KubernetesObjectsConfigurationHash()),
 		)
 		obj.Status.Conditions = conditions
 		if updateNeeded {
@@ -1208,6 +1396,7 @@ func (r *KongV1Beta1KongConsumerGroupReconciler) Reconcile(ctx context.Context,
 			configurationStatus,
 			obj.Generation,
 			obj.Status.Conditions,
+			ctrlutils.WithConfigurationHash(r.DataplaneClient.KubernetesObjectsConfigurationHash()),
 		)
 		obj.Status.Conditions = conditions
 		if updateNeeded {
@@ -1400,10 +1589,24 @@ func (r *KongV1Beta1TCPIngressReconciler) Reconcile(ctx context.Context, req ctr
 	}
 	// if status updates are enabled report the status for the object
 	if r.DataplaneClient.AreKubernetesObjectReportsEnabled() {
+		log.V(util.DebugLevel).Info("Updating programmed condition status", "namespace", req.Namespace, "name", req.Name)
+		configurationStatus := r.DataplaneClient.KubernetesObjectConfigurationStatus(obj)
+		conditions, updateNeeded := ctrlutils.EnsureProgrammedCondition(
+			configurationStatus,
+			obj.Generation,
+			obj.Status.Conditions,
+			ctrlutils.WithConfigurationHash(r.DataplaneClient.KubernetesObjectsConfigurationHash()),
+		)
+		obj.Status.Conditions = conditions
 		log.V(util.DebugLevel).Info("Determining whether data-plane configuration has succeeded", "namespace", req.Namespace, "name", req.Name)
 
 		if !r.DataplaneClient.KubernetesObjectIsConfigured(obj) {
 			log.V(util.DebugLevel).Info("Resource not yet configured in the data-plane", "namespace", req.Namespace, "name", req.Name)
+			if updateNeeded {
+				if err := r.Status().Update(ctx, obj); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
 			return ctrl.Result{Requeue: true}, nil // requeue until the object has been properly configured
 		}
 
@@ -1414,10 +1617,11 @@ func (r *KongV1Beta1TCPIngressReconciler) Reconcile(ctx context.Context, req ctr
 		}
 
 		log.V(util.DebugLevel).Info("Found addresses for data-plane updating object status", "namespace", req.Namespace, "name", req.Name)
-		updateNeeded, err := ctrlutils.UpdateLoadBalancerIngress(obj, addrs)
+		addressesUpdateNeeded, err := ctrlutils.UpdateLoadBalancerIngress(obj, addrs)
 		if err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to update load balancer address: %w", err)
 		}
+		updateNeeded = updateNeeded || addressesUpdateNeeded
 		if updateNeeded {
 			return ctrl.Result{}, r.Status().Update(ctx, obj)
 		}
@@ -1577,10 +1781,24 @@ func (r *KongV1Beta1UDPIngressReconciler) Reconcile(ctx context.Context, req ctr
 	}
 	// if status updates are enabled report the status for the object
 	if r.DataplaneClient.AreKubernetesObjectReportsEnabled() {
+		log.V(util.DebugLevel).Info("Updating programmed condition status", "namespace", req.Namespace, "name", req.Name)
+		configurationStatus := r.DataplaneClient.KubernetesObjectConfigurationStatus(obj)
+		conditions, updateNeeded := ctrlutils.EnsureProgrammedCondition(
+			configurationStatus,
+			obj.Generation,
+			obj.Status.Conditions,
+			ctrlutils.WithConfigurationHash(r.DataplaneClient.KubernetesObjectsConfigurationHash()),
+		)
+		obj.Status.Conditions = conditions
 		log.V(util.DebugLevel).Info("Determining whether data-plane configuration has succeeded", "namespace", req.Namespace, "name", req.Name)
 
 		if !r.DataplaneClient.KubernetesObjectIsConfigured(obj) {
 			log.V(util.DebugLevel).Info("Resource not yet configured in the data-plane", "namespace", req.Namespace, "name", req.Name)
+			if updateNeeded {
+				if err := r.Status().Update(ctx, obj); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
 			return ctrl.Result{Requeue: true}, nil // requeue until the object has been properly configured
 		}
 
@@ -1591,10 +1809,11 @@ func (r *KongV1Beta1UDPIngressReconciler) Reconcile(ctx context.Context, req ctr
 		}
 
 		log.V(util.DebugLevel).Info("Found addresses for data-plane updating object status", "namespace", req.Namespace, "name", req.Name)
-		updateNeeded, err := ctrlutils.UpdateLoadBalancerIngress(obj, addrs)
+		addressesUpdateNeeded, err := ctrlutils.UpdateLoadBalancerIngress(obj, addrs)
 		if err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to update load balancer address: %w", err)
 		}
+		updateNeeded = updateNeeded || addressesUpdateNeeded
 		if updateNeeded {
 			return ctrl.Result{}, r.Status().Update(ctx, obj)
 		}
@@ -1839,6 +2058,7 @@ func (r *IncubatorV1Alpha1KongServiceFacadeReconciler) Reconcile(ctx context.Con
 			configurationStatus,
 			obj.Generation,
 			obj.Status.Conditions,
+			ctrlutils.WithConfigurationHash(r.DataplaneClient.KubernetesObjectsConfigurationHash()),
 			ctrlutils.WithUnknownMessage("Found no references to this resource in Ingress or similar resources."),
 		)
 		obj.Status.Conditions = conditions
@@ -1851,6 +2071,173 @@ func (r *IncubatorV1Alpha1KongServiceFacadeReconciler) Reconcile(ctx context.Con
 	return ctrl.Result{}, nil
 }
 
+// -----------------------------------------------------------------------------
+// IncubatorV1Alpha1 KongUpstreamTarget - Reconciler
+// -----------------------------------------------------------------------------
+
+// IncubatorV1Alpha1KongUpstreamTargetReconciler reconciles KongUpstreamTarget resources
+type IncubatorV1Alpha1KongUpstreamTargetReconciler struct {
+	client.Client
+
+	Log              logr.Logger
+	Scheme           *runtime.Scheme
+	DataplaneClient  controllers.DataPlane
+	CacheSyncTimeout time.Duration
+	StatusQueue      *status.Queue
+
+	IngressClassName           string
+	DisableIngressClassLookups bool
+}
+
+var _ controllers.Reconciler = &IncubatorV1Alpha1KongUpstreamTargetReconciler{}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *IncubatorV1Alpha1KongUpstreamTargetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	blder := ctrl.NewControllerManagedBy(mgr).
+		// set the controller name
+		Named("IncubatorV1Alpha1KongUpstreamTarget").
+		WithOptions(controller.Options{
+			LogConstructor: func(_ *reconcile.Request) logr.Logger {
+				return r.Log
+			},
+			CacheSyncTimeout: r.CacheSyncTimeout,
+		})
+	// if configured, start the status updater controller
+	if r.StatusQueue != nil {
+		blder.WatchesRawSource(
+			source.Channel(
+				r.StatusQueue.Subscribe(schema.GroupVersionKind{
+					Group:   "incubator.ingress-controller.konghq.com",
+					Version: "v1alpha1",
+					Kind:    "KongUpstreamTarget",
+				}),
+				&handler.EnqueueRequestForObject{},
+			),
+		)
+	}
+	if !r.DisableIngressClassLookups {
+		blder.Watches(&netv1.IngressClass{},
+			handler.EnqueueRequestsFromMapFunc(r.listClassless),
+			builder.WithPredicates(predicate.NewPredicateFuncs(ctrlutils.IsDefaultIngressClass)),
+		)
+	}
+	preds := ctrlutils.GeneratePredicateFuncsForIngressClassFilter(r.IngressClassName)
+	return blder.Watches(&incubatorv1alpha1.KongUpstreamTarget{},
+		&handler.EnqueueRequestForObject{},
+		builder.WithPredicates(preds),
+	).
+		Complete(r)
+}
+
+// listClassless finds and reconciles all objects without ingress class information
+func (r *IncubatorV1Alpha1KongUpstreamTargetReconciler) listClassless(ctx context.Context, obj client.Object) []reconcile.Request {
+	resourceList := &incubatorv1alpha1.KongUpstreamTargetList{}
+	if err := r.Client.List(ctx, resourceList); err != nil {
+		r.Log.Error(err, "Failed to list classless kongupstreamtargets")
+		return nil
+	}
+	var recs []reconcile.Request
+	for i, resource := range resourceList.Items {
+		if ctrlutils.IsIngressClassEmpty(&resourceList.Items[i]) {
+			recs = append(recs, reconcile.Request{
+				NamespacedName: k8stypes.NamespacedName{
+					Namespace: resource.Namespace,
+					Name:      resource.Name,
+				},
+			})
+		}
+	}
+	return recs
+}
+
+// SetLogger sets the logger.
+func (r *IncubatorV1Alpha1KongUpstreamTargetReconciler) SetLogger(l logr.Logger) {
+	r.Log = l
+}
+
+//+kubebuilder:rbac:groups=incubator.ingress-controller.konghq.com,resources=kongupstreamtargets,verbs=get;list;watch
+//+kubebuilder:rbac:groups=incubator.ingress-controller.konghq.com,resources=kongupstreamtargets/status,verbs=get;update;patch
+
+// Reconcile processes the watched objects
+func (r *IncubatorV1Alpha1KongUpstreamTargetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("IncubatorV1Alpha1KongUpstreamTarget", req.NamespacedName)
+
+	// get the relevant object
+	obj := new(incubatorv1alpha1.KongUpstreamTarget)
+
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			obj.Namespace = req.Namespace
+			obj.Name = req.Name
+
+			return ctrl.Result{}, r.DataplaneClient.DeleteObject(obj)
+		}
+		return ctrl.Result{}, err
+	}
+	log.V(util.DebugLevel).Info("Reconciling resource", "namespace", req.Namespace, "name", req.Name)
+
+	// clean the object up if it's being deleted
+	if !obj.DeletionTimestamp.IsZero() && time.Now().After(obj.DeletionTimestamp.Time) {
+		log.V(util.DebugLevel).Info("Resource is being deleted, its configuration will be removed", "type", "KongUpstreamTarget", "namespace", req.Namespace, "name", req.Name)
+
+		objectExistsInCache, err := r.DataplaneClient.ObjectExists(obj)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if objectExistsInCache {
+			if err := r.DataplaneClient.DeleteObject(obj); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{Requeue: true}, nil // wait until the object is no longer present in the cache
+		}
+		return ctrl.Result{}, nil
+	}
+
+	class := new(netv1.IngressClass)
+	if !r.DisableIngressClassLookups {
+		if err := r.Get(ctx, k8stypes.NamespacedName{Name: r.IngressClassName}, class); err != nil {
+			// we log this without taking action to support legacy configurations that only set ingressClassName or
+			// used the class annotation and did not create a corresponding IngressClass. We only need this to determine
+			// if the IngressClass is default or to configure default settings, and can assume no/no additional defaults
+			// if none exists.
+			log.V(util.DebugLevel).Info("Could not retrieve IngressClass", "ingressclass", r.IngressClassName)
+		}
+	}
+	// if the object is not configured with our ingress.class, then we need to ensure it's removed from the cache
+	if !ctrlutils.MatchesIngressClass(obj, r.IngressClassName, ctrlutils.IsDefaultIngressClass(class)) {
+		log.V(util.DebugLevel).Info("Object missing ingress class, ensuring it's removed from configuration",
+			"namespace", req.Namespace, "name", req.Name, "class", r.IngressClassName)
+		return ctrl.Result{}, r.DataplaneClient.DeleteObject(obj)
+	} else {
+		log.V(util.DebugLevel).Info("Object has matching ingress class", "namespace", req.Namespace, "name", req.Name,
+			"class", r.IngressClassName)
+	}
+
+	// update the kong Admin API with the changes
+	if err := r.DataplaneClient.UpdateObject(obj); err != nil {
+		return ctrl.Result{}, err
+	}
+	// if status updates are enabled report the status for the object
+	if r.DataplaneClient.AreKubernetesObjectReportsEnabled() {
+		log.V(util.DebugLevel).Info("Updating programmed condition status", "namespace", req.Namespace, "name", req.Name)
+		configurationStatus := r.DataplaneClient.KubernetesObjectConfigurationStatus(obj)
+		conditions, updateNeeded := ctrlutils.EnsureProgrammedCondition(
+			configurationStatus,
+			obj.Generation,
+			obj.Status.Conditions,
+			ctrlutils.WithConfigurationHash(r.DataplaneClient.KubernetesObjectsConfigurationHash()),
+			ctrlutils.WithUnknownMessage("Found no references to this resource in Gateway API routes."),
+		)
+		obj.Status.Conditions = conditions
+		if updateNeeded {
+			return ctrl.Result{}, r.Status().Update(ctx, obj)
+		}
+		log.V(util.DebugLevel).Info("Status update not needed", "namespace", req.Namespace, "name", req.Name)
+	}
+
+	return ctrl.Result{}, nil
+}
+
 // -----------------------------------------------------------------------------
 // KongV1Alpha1 KongVault - Reconciler
 // -----------------------------------------------------------------------------
@@ -2005,6 +2392,7 @@ func (r *KongV1Alpha1KongVaultReconciler) Reconcile(ctx context.Context, req ctr
 			configurationStatus,
 			obj.Generation,
 			obj.Status.Conditions,
+			ctrlutils.WithConfigurationHash(r.DataplaneClient.KubernetesObjectsConfigurationHash()),
 		)
 		obj.Status.Conditions = conditions
 		if updateNeeded {
@@ -2170,6 +2558,7 @@ func (r *KongV1Alpha1KongCustomEntityReconciler) Reconcile(ctx context.Context,
 			configurationStatus,
 			obj.Generation,
 			obj.Status.Conditions,
+			ctrlutils.WithConfigurationHash(r.DataplaneClient.KubernetesObjectsConfigurationHash()),
 		)
 		obj.Status.Conditions = conditions
 		if updateNeeded {
@@ -22,6 +22,7 @@ type DataPlaneStatusClient interface {
 	AreKubernetesObjectReportsEnabled() bool
 	KubernetesObjectConfigurationStatus(obj client.Object) k8sobj.ConfigurationStatus
 	KubernetesObjectIsConfigured(obj client.Object) bool
+	KubernetesObjectsConfigurationHash() string
 }
 
 // DataPlaneClient is a common client interface that is used by reconcilers to interact
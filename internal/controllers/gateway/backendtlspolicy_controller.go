@@ -0,0 +1,433 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers"
+	ctrlref "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/reference"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+)
+
+// -----------------------------------------------------------------------------
+// BackendTLSPolicy Controller - Reconciler
+// -----------------------------------------------------------------------------
+
+// BackendTLSPolicyReconciler reconciles BackendTLSPolicy resources.
+type BackendTLSPolicyReconciler struct {
+	client.Client
+
+	Log               logr.Logger
+	Scheme            *runtime.Scheme
+	DataplaneClient   controllers.DataPlane
+	ReferenceIndexers ctrlref.CacheIndexers
+	CacheSyncTimeout  time.Duration
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BackendTLSPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := r.setupIndices(mgr); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("backendtlspolicy-controller").
+		WithOptions(controller.Options{
+			LogConstructor: func(_ *reconcile.Request) logr.Logger {
+				return r.Log
+			},
+			CacheSyncTimeout: r.CacheSyncTimeout,
+		}).
+		Watches(&corev1.Service{},
+			handler.EnqueueRequestsFromMapFunc(r.listBackendTLSPoliciesForObject(backendTLSPolicyTargetRefIndexKey)),
+		).
+		Watches(&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.listBackendTLSPoliciesForObject(backendTLSPolicyCACertificateRefIndexKey)),
+		).
+		Watches(&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.listBackendTLSPoliciesForObject(backendTLSPolicyCACertificateRefIndexKey)),
+		).
+		For(&gatewayapi.BackendTLSPolicy{}).
+		Complete(r)
+}
+
+func (r *BackendTLSPolicyReconciler) setupIndices(mgr ctrl.Manager) error {
+	if err := mgr.GetCache().IndexField(
+		context.Background(),
+		&gatewayapi.BackendTLSPolicy{},
+		backendTLSPolicyTargetRefIndexKey,
+		indexBackendTLSPolicyOnTargetRefs,
+	); err != nil {
+		return fmt.Errorf("failed to index BackendTLSPolicies on targetRefs: %w", err)
+	}
+	if err := mgr.GetCache().IndexField(
+		context.Background(),
+		&gatewayapi.BackendTLSPolicy{},
+		backendTLSPolicyCACertificateRefIndexKey,
+		indexBackendTLSPolicyOnCACertificateRefs,
+	); err != nil {
+		return fmt.Errorf("failed to index BackendTLSPolicies on caCertificateRefs: %w", err)
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// BackendTLSPolicy Controller - Indexers
+// -----------------------------------------------------------------------------
+
+const (
+	backendTLSPolicyTargetRefIndexKey        = "backendTLSPolicyTargetRef"
+	backendTLSPolicyCACertificateRefIndexKey = "backendTLSPolicyCACertificateRef"
+)
+
+// indexBackendTLSPolicyOnTargetRefs indexes the BackendTLSPolicies on the kind and name of the targeted objects.
+func indexBackendTLSPolicyOnTargetRefs(o client.Object) []string {
+	policy, ok := o.(*gatewayapi.BackendTLSPolicy)
+	if !ok {
+		return []string{}
+	}
+	return lo.Map(policy.Spec.TargetRefs, func(ref gatewayapi.LocalPolicyTargetReferenceWithSectionName, _ int) string {
+		return backendTLSPolicyIndexValue(string(ref.Kind), string(ref.Name))
+	})
+}
+
+// indexBackendTLSPolicyOnCACertificateRefs indexes the BackendTLSPolicies on the kind and name of the referenced
+// CA certificates' ConfigMaps and Secrets.
+func indexBackendTLSPolicyOnCACertificateRefs(o client.Object) []string {
+	policy, ok := o.(*gatewayapi.BackendTLSPolicy)
+	if !ok {
+		return []string{}
+	}
+	return lo.Map(policy.Spec.Validation.CACertificateRefs, func(ref gatewayapi.LocalObjectReference, _ int) string {
+		return backendTLSPolicyIndexValue(string(ref.Kind), string(ref.Name))
+	})
+}
+
+func backendTLSPolicyIndexValue(kind, name string) string {
+	return kind + "/" + name
+}
+
+// -----------------------------------------------------------------------------
+// BackendTLSPolicy Controller - Watch Predicates
+// -----------------------------------------------------------------------------
+
+// listBackendTLSPoliciesForObject returns a map function enqueuing reconcile requests for all BackendTLSPolicies
+// referencing an object (Service, ConfigMap or Secret) using the given index.
+func (r *BackendTLSPolicyReconciler) listBackendTLSPoliciesForObject(indexKey string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		var kind string
+		switch obj.(type) {
+		case *corev1.Service:
+			kind = "Service"
+		case *corev1.ConfigMap:
+			kind = "ConfigMap"
+		case *corev1.Secret:
+			kind = "Secret"
+		default:
+			return nil
+		}
+
+		policies := &gatewayapi.BackendTLSPolicyList{}
+		if err := r.List(ctx, policies,
+			client.InNamespace(obj.GetNamespace()),
+			client.MatchingFields{indexKey: backendTLSPolicyIndexValue(kind, obj.GetName())},
+		); err != nil {
+			r.Log.Error(err, "Failed to list BackendTLSPolicies in watch", "kind", kind)
+			return nil
+		}
+		return lo.Map(policies.Items, func(p gatewayapi.BackendTLSPolicy, _ int) reconcile.Request {
+			return reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&p)}
+		})
+	}
+}
+
+// -----------------------------------------------------------------------------
+// BackendTLSPolicy Controller - Reconciliation
+// -----------------------------------------------------------------------------
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=backendtlspolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=backendtlspolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+
+// Reconcile processes the watched objects.
+func (r *BackendTLSPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("GatewayV1Alpha3BackendTLSPolicy", req.NamespacedName)
+
+	policy := new(gatewayapi.BackendTLSPolicy)
+	if err := r.Get(ctx, req.NamespacedName, policy); err != nil {
+		if apierrors.IsNotFound(err) {
+			policy.Namespace = req.Namespace
+			policy.Name = req.Name
+			return ctrl.Result{}, r.deletePolicy(policy)
+		}
+		return ctrl.Result{}, err
+	}
+	debug(log, policy, "Processing BackendTLSPolicy")
+
+	if !policy.DeletionTimestamp.IsZero() {
+		debug(log, policy, "BackendTLSPolicy is being deleted, re-configuring data-plane")
+		return ctrl.Result{}, r.deletePolicy(policy)
+	}
+
+	// enforce the desired BackendTLSPolicy status
+	updated, err := r.enforceBackendTLSPolicyStatus(ctx, policy)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if updated {
+		// status update will re-trigger reconciliation
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.updateReferencedCACertificates(ctx, policy); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.DataplaneClient.UpdateObject(policy); err != nil {
+		debug(log, policy, "Failed to update object in data-plane, requeueing")
+		return ctrl.Result{}, err
+	}
+	info(log, policy, "BackendTLSPolicy has been configured on the data-plane")
+	return ctrl.Result{}, nil
+}
+
+// SetLogger sets the logger.
+func (r *BackendTLSPolicyReconciler) SetLogger(l logr.Logger) {
+	r.Log = l
+}
+
+// deletePolicy removes the BackendTLSPolicy from the data-plane along with the ConfigMaps and Secrets
+// no longer referenced by any other object.
+func (r *BackendTLSPolicyReconciler) deletePolicy(policy *gatewayapi.BackendTLSPolicy) error {
+	if err := ctrlref.DeleteReferencesByReferrer(r.ReferenceIndexers, r.DataplaneClient, policy); err != nil {
+		return err
+	}
+	return r.DataplaneClient.DeleteObject(policy)
+}
+
+// updateReferencedCACertificates pushes ConfigMaps and Secrets referenced by the BackendTLSPolicy to the data-plane
+// and records the references so that objects no longer referenced are removed from the data-plane.
+func (r *BackendTLSPolicyReconciler) updateReferencedCACertificates(ctx context.Context, policy *gatewayapi.BackendTLSPolicy) error {
+	referredSecrets := make(map[k8stypes.NamespacedName]struct{})
+	referredConfigMaps := make(map[k8stypes.NamespacedName]struct{})
+	for _, ref := range policy.Spec.Validation.CACertificateRefs {
+		if ref.Group != "" {
+			continue
+		}
+		nn := k8stypes.NamespacedName{Namespace: policy.Namespace, Name: string(ref.Name)}
+		switch ref.Kind {
+		case "Secret":
+			referredSecrets[nn] = struct{}{}
+		case "ConfigMap":
+			referredConfigMaps[nn] = struct{}{}
+		}
+	}
+
+	for nn := range referredConfigMaps {
+		configMap := &corev1.ConfigMap{}
+		if err := r.Get(ctx, nn, configMap); err != nil {
+			if apierrors.IsNotFound(err) {
+				// The ConfigMap will be pushed once it's created as its creation triggers the policy reconciliation.
+				continue
+			}
+			return err
+		}
+		configMap.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"}
+		if err := r.ReferenceIndexers.SetObjectReference(policy, configMap); err != nil {
+			return err
+		}
+		if err := r.DataplaneClient.UpdateObject(configMap); err != nil {
+			return err
+		}
+	}
+	if err := r.removeOutdatedReferencesToConfigMaps(policy, referredConfigMaps); err != nil {
+		return err
+	}
+
+	if err := ctrlref.UpdateReferencesToSecret(
+		ctx, r.Client, r.ReferenceIndexers, r.DataplaneClient, policy, referredSecrets,
+	); err != nil && !apierrors.IsNotFound(err) {
+		// The Secret will be pushed once it's created as its creation triggers the policy reconciliation.
+		return err
+	}
+	return nil
+}
+
+// removeOutdatedReferencesToConfigMaps removes reference records to ConfigMaps no longer referenced by the policy
+// and removes them from the data-plane if they're not referenced by any other object.
+func (r *BackendTLSPolicyReconciler) removeOutdatedReferencesToConfigMaps(
+	policy *gatewayapi.BackendTLSPolicy,
+	referredConfigMaps map[k8stypes.NamespacedName]struct{},
+) error {
+	referents, err := r.ReferenceIndexers.ListReferredObjects(policy)
+	if err != nil {
+		return err
+	}
+	for _, referent := range referents {
+		if _, ok := referent.(*corev1.ConfigMap); !ok {
+			continue
+		}
+		if _, ok := referredConfigMaps[client.ObjectKeyFromObject(referent)]; ok {
+			continue
+		}
+		if err := r.ReferenceIndexers.DeleteObjectReference(policy, referent); err != nil {
+			return err
+		}
+		if err := r.ReferenceIndexers.DeleteObjectIfNotReferred(referent, r.DataplaneClient); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// BackendTLSPolicy Controller - Status
+// -----------------------------------------------------------------------------
+
+// enforceBackendTLSPolicyStatus builds the desired status of the BackendTLSPolicy with every targeted Service as
+// an ancestor and patches the policy if the status differs from the current one. It returns true if the status
+// was updated.
+func (r *BackendTLSPolicyReconciler) enforceBackendTLSPolicyStatus(ctx context.Context, oldPolicy *gatewayapi.BackendTLSPolicy) (bool, error) {
+	acceptedCondition, err := r.buildAcceptedCondition(ctx, oldPolicy)
+	if err != nil {
+		return false, err
+	}
+
+	newStatus := gatewayapi.PolicyStatus{}
+	for _, targetRef := range oldPolicy.Spec.TargetRefs {
+		condition := acceptedCondition
+		if targetRef.Kind != "Service" || (targetRef.Group != "" && targetRef.Group != "core") {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = string(gatewayapi.PolicyReasonInvalid)
+			condition.Message = fmt.Sprintf("unsupported target %s/%s", targetRef.Group, targetRef.Kind)
+		} else if err := r.Get(ctx, k8stypes.NamespacedName{Namespace: oldPolicy.Namespace, Name: string(targetRef.Name)}, &corev1.Service{}); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return false, err
+			}
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = string(gatewayapi.PolicyReasonTargetNotFound)
+			condition.Message = fmt.Sprintf("Service %s not found", targetRef.Name)
+		}
+
+		ancestorRef := gatewayapi.ParentReference{
+			Group:       lo.ToPtr(gatewayapi.Group("core")),
+			Kind:        lo.ToPtr(gatewayapi.Kind("Service")),
+			Namespace:   lo.ToPtr(gatewayapi.Namespace(oldPolicy.Namespace)),
+			Name:        targetRef.Name,
+			SectionName: targetRef.SectionName,
+		}
+		if lo.ContainsBy(newStatus.Ancestors, func(a gatewayapi.PolicyAncestorStatus) bool {
+			return reflect.DeepEqual(a.AncestorRef, ancestorRef)
+		}) {
+			continue
+		}
+		newStatus.Ancestors = append(newStatus.Ancestors, gatewayapi.PolicyAncestorStatus{
+			AncestorRef:    ancestorRef,
+			ControllerName: GetControllerName(),
+			Conditions:     []metav1.Condition{condition},
+		})
+	}
+
+	if isBackendTLSPolicyStatusEqual(oldPolicy.Status, newStatus) {
+		return false, nil
+	}
+	newPolicy := oldPolicy.DeepCopy()
+	newPolicy.Status = newStatus
+	return true, r.Client.Status().Patch(ctx, newPolicy, client.MergeFrom(oldPolicy))
+}
+
+// buildAcceptedCondition returns the Accepted condition of the BackendTLSPolicy reflecting the validity of its
+// CA certificates configuration.
+func (r *BackendTLSPolicyReconciler) buildAcceptedCondition(ctx context.Context, policy *gatewayapi.BackendTLSPolicy) (metav1.Condition, error) {
+	condition := metav1.Condition{
+		Type:               string(gatewayapi.PolicyConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: policy.Generation,
+		LastTransitionTime: metav1.Now(),
+		Reason:             string(gatewayapi.PolicyReasonAccepted),
+	}
+	invalid := func(msg string) (metav1.Condition, error) {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(gatewayapi.PolicyReasonInvalid)
+		condition.Message = msg
+		return condition, nil
+	}
+
+	validation := policy.Spec.Validation
+	if validation.WellKnownCACertificates != nil {
+		if *validation.WellKnownCACertificates != gatewayapi.WellKnownCACertificatesSystem {
+			return invalid(fmt.Sprintf("unsupported wellKnownCACertificates %q", *validation.WellKnownCACertificates))
+		}
+		return condition, nil
+	}
+	for _, ref := range validation.CACertificateRefs {
+		nn := k8stypes.NamespacedName{Namespace: policy.Namespace, Name: string(ref.Name)}
+		var (
+			hasKey bool
+			err    error
+		)
+		switch {
+		case ref.Group == "" && ref.Kind == "ConfigMap":
+			configMap := &corev1.ConfigMap{}
+			err = r.Get(ctx, nn, configMap)
+			_, hasKey = configMap.Data[translator.BackendTLSPolicyCACertificateKey]
+		case ref.Group == "" && ref.Kind == "Secret":
+			secret := &corev1.Secret{}
+			err = r.Get(ctx, nn, secret)
+			_, hasKey = secret.Data[translator.BackendTLSPolicyCACertificateKey]
+		default:
+			return invalid(fmt.Sprintf("unsupported caCertificateRef kind %s/%s", ref.Group, ref.Kind))
+		}
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return invalid(fmt.Sprintf("%s %s not found", ref.Kind, ref.Name))
+			}
+			return metav1.Condition{}, err
+		}
+		if !hasKey {
+			return invalid(fmt.Sprintf("%s %s is missing the %q key", ref.Kind, ref.Name, translator.BackendTLSPolicyCACertificateKey))
+		}
+	}
+	return condition, nil
+}
+
+// isBackendTLSPolicyStatusEqual compares two policy statuses ignoring conditions' last transition times.
+func isBackendTLSPolicyStatusEqual(oldStatus, newStatus gatewayapi.PolicyStatus) bool {
+	if len(oldStatus.Ancestors) != len(newStatus.Ancestors) {
+		return false
+	}
+	for i, oldAncestor := range oldStatus.Ancestors {
+		newAncestor := newStatus.Ancestors[i]
+		if newAncestor.ControllerName != oldAncestor.ControllerName ||
+			!reflect.DeepEqual(newAncestor.AncestorRef, oldAncestor.AncestorRef) ||
+			len(oldAncestor.Conditions) != len(newAncestor.Conditions) {
+			return false
+		}
+		for j, oldCondition := range oldAncestor.Conditions {
+			newCondition := newAncestor.Conditions[j]
+			if newCondition.Type != oldCondition.Type ||
+				newCondition.Status != oldCondition.Status ||
+				newCondition.Reason != oldCondition.Reason ||
+				newCondition.Message != oldCondition.Message ||
+				newCondition.ObservedGeneration != oldCondition.ObservedGeneration {
+				return false
+			}
+		}
+	}
+	return true
+}
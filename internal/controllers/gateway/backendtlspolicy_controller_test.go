@@ -0,0 +1,126 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/scheme"
+)
+
+func TestEnforceBackendTLSPolicyStatus(t *testing.T) {
+	const testNamespace = "test"
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: testNamespace},
+	}
+	caConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: testNamespace},
+		Data:       map[string]string{"ca.crt": "cert"},
+	}
+	newPolicy := func(validation gatewayapi.BackendTLSPolicyValidation) gatewayapi.BackendTLSPolicy {
+		return gatewayapi.BackendTLSPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: testNamespace, Generation: 1},
+			Spec: gatewayapi.BackendTLSPolicySpec{
+				TargetRefs: []gatewayapi.LocalPolicyTargetReferenceWithSectionName{
+					{LocalPolicyTargetReference: gatewayapi.LocalPolicyTargetReference{Kind: "Service", Name: "svc"}},
+				},
+				Validation: validation,
+			},
+		}
+	}
+	expectedStatus := func(status metav1.ConditionStatus, reason gatewayapi.PolicyConditionReason, msg string) gatewayapi.PolicyStatus {
+		return gatewayapi.PolicyStatus{
+			Ancestors: []gatewayapi.PolicyAncestorStatus{
+				{
+					AncestorRef: gatewayapi.ParentReference{
+						Group:     lo.ToPtr(gatewayapi.Group("core")),
+						Kind:      lo.ToPtr(gatewayapi.Kind("Service")),
+						Namespace: lo.ToPtr(gatewayapi.Namespace(testNamespace)),
+						Name:      "svc",
+					},
+					ControllerName: GetControllerName(),
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(gatewayapi.PolicyConditionAccepted),
+							Status:             status,
+							Reason:             string(reason),
+							Message:            msg,
+							ObservedGeneration: 1,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		policy         gatewayapi.BackendTLSPolicy
+		inputObjects   []client.Object
+		expectedStatus gatewayapi.PolicyStatus
+	}{
+		{
+			name: "accepted",
+			policy: newPolicy(gatewayapi.BackendTLSPolicyValidation{
+				CACertificateRefs: []gatewayapi.LocalObjectReference{{Kind: "ConfigMap", Name: "ca"}},
+				Hostname:          "example.com",
+			}),
+			inputObjects:   []client.Object{service, caConfigMap},
+			expectedStatus: expectedStatus(metav1.ConditionTrue, gatewayapi.PolicyReasonAccepted, ""),
+		},
+		{
+			name: "target not found",
+			policy: newPolicy(gatewayapi.BackendTLSPolicyValidation{
+				WellKnownCACertificates: lo.ToPtr(gatewayapi.WellKnownCACertificatesSystem),
+				Hostname:                "example.com",
+			}),
+			expectedStatus: expectedStatus(metav1.ConditionFalse, gatewayapi.PolicyReasonTargetNotFound, "Service svc not found"),
+		},
+		{
+			name: "CA certificate ConfigMap not found",
+			policy: newPolicy(gatewayapi.BackendTLSPolicyValidation{
+				CACertificateRefs: []gatewayapi.LocalObjectReference{{Kind: "ConfigMap", Name: "missing"}},
+				Hostname:          "example.com",
+			}),
+			inputObjects:   []client.Object{service},
+			expectedStatus: expectedStatus(metav1.ConditionFalse, gatewayapi.PolicyReasonInvalid, "ConfigMap missing not found"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			inputObjects := append([]client.Object{&tc.policy}, tc.inputObjects...)
+			fakeClient := fakectrlruntimeclient.
+				NewClientBuilder().
+				WithScheme(lo.Must(scheme.Get())).
+				WithObjects(inputObjects...).
+				WithStatusSubresource(&tc.policy).
+				Build()
+			reconciler := BackendTLSPolicyReconciler{Client: fakeClient}
+
+			updated, err := reconciler.enforceBackendTLSPolicyStatus(context.Background(), &tc.policy)
+			require.NoError(t, err)
+			assert.True(t, updated)
+
+			newPolicy := &gatewayapi.BackendTLSPolicy{}
+			require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKeyFromObject(&tc.policy), newPolicy))
+			ignoreLastTransitionTime := cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")
+			assert.Empty(t, cmp.Diff(tc.expectedStatus, newPolicy.Status, ignoreLastTransitionTime))
+
+			updated, err = reconciler.enforceBackendTLSPolicyStatus(context.Background(), newPolicy)
+			require.NoError(t, err)
+			assert.False(t, updated, "status should not be updated when it's already up to date")
+		})
+	}
+}
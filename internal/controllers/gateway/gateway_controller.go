@@ -13,7 +13,9 @@ import (
 	"github.com/kong/go-kong/kong"
 	"github.com/samber/lo"
 	"github.com/samber/mo"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -34,6 +36,7 @@ import (
 	ctrlutils "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/utils"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 // -----------------------------------------------------------------------------
@@ -69,6 +72,19 @@ type GatewayReconciler struct { //nolint:revive
 	// If GatewayNN is set,
 	// only resources managed by the specified Gateway are reconciled.
 	GatewayNN controllers.OptionalNamespacedName
+
+	// ManagedGatewaysEnabled enables provisioning data planes for Gateways whose GatewayClass
+	// refers to a KongGatewayConfiguration.
+	ManagedGatewaysEnabled bool
+
+	// ManagedAdminAPIsDiscoverer discovers Admin APIs of data planes provisioned for managed Gateways.
+	ManagedAdminAPIsDiscoverer ManagedAdminAPIsDiscoverer
+	// ManagedAdminAPIsNotifier is notified about Admin APIs of data planes provisioned for managed Gateways,
+	// so that they get configured.
+	ManagedAdminAPIsNotifier ManagedAdminAPIsNotifier
+
+	// GatewayClassSupportedFeatures are the Gateway API features published in the status of managed GatewayClasses.
+	GatewayClassSupportedFeatures []gatewayapi.SupportedFeature
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -131,16 +147,40 @@ func (r *GatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		)
 	}
 
+	// watch objects provisioned for managed Gateways and the configuration they're provisioned from
+	if r.ManagedGatewaysEnabled {
+		blder.Owns(&appsv1.Deployment{}).Owns(&corev1.Service{}).Owns(&corev1.Secret{}).
+			// EndpointSlices inherit labels of the provisioned Services, they're watched to discover
+			// Admin APIs of the data planes.
+			Watches(&discoveryv1.EndpointSlice{},
+				handler.EnqueueRequestsFromMapFunc(r.listManagedGatewaysForEndpointSlice),
+				builder.WithPredicates(predicate.NewPredicateFuncs(isManagedDataPlaneObject)),
+			)
+
+		if ctrlutils.CRDExists(mgr.GetRESTMapper(), schema.GroupVersionResource{
+			Group:    incubatorv1alpha1.GroupVersion.Group,
+			Version:  incubatorv1alpha1.GroupVersion.Version,
+			Resource: "konggatewayconfigurations",
+		}) {
+			blder.Watches(&incubatorv1alpha1.KongGatewayConfiguration{},
+				handler.EnqueueRequestsFromMapFunc(r.listGatewaysForKongGatewayConfiguration),
+			)
+		} else {
+			r.Log.Info("KongGatewayConfiguration CRD is not installed, managed Gateways will not be provisioned")
+		}
+	}
+
 	if err := blder.Complete(r); err != nil {
 		return err
 	}
 
 	// start the required gatewayclass controller as well
 	gwcCTRL := &GatewayClassReconciler{
-		Client:           r.Client,
-		Log:              r.Log.WithName(strings.ToUpper(gatewayapi.V1GroupVersion) + "GatewayClass"),
-		Scheme:           r.Scheme,
-		CacheSyncTimeout: r.CacheSyncTimeout,
+		Client:            r.Client,
+		Log:               r.Log.WithName(strings.ToUpper(gatewayapi.V1GroupVersion) + "GatewayClass"),
+		Scheme:            r.Scheme,
+		CacheSyncTimeout:  r.CacheSyncTimeout,
+		SupportedFeatures: r.GatewayClassSupportedFeatures,
 	}
 
 	return gwcCTRL.SetupWithManager(mgr)
@@ -396,6 +436,7 @@ func (r *GatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 			if err != nil {
 				return ctrl.Result{}, err
 			}
+			r.forgetManagedGatewayAdminAPIs(req.NamespacedName)
 			debug(log, gateway, "Reconciliation triggered but gateway does not exist, deleting it in dataplane")
 			return ctrl.Result{}, r.DataplaneClient.DeleteObject(gateway)
 		}
@@ -439,12 +480,13 @@ func (r *GatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, nil
 	}
 
-	// if there's any deletion timestamp on the object, we can simply ignore it. At this point
-	// with unmanaged mode being the only option supported there are no finalizers and
-	// the object should be cleaned up by GC promptly.
+	// if there's any deletion timestamp on the object, we can simply ignore it. There are no
+	// finalizers and the object (along with the data plane provisioned for it in managed mode)
+	// should be cleaned up by GC promptly.
 	debug(log, gateway, "Checking deletion timestamp")
 	if gateway.DeletionTimestamp != nil {
 		debug(log, gateway, "Gateway is being deleted, ignoring")
+		r.forgetManagedGatewayAdminAPIs(req.NamespacedName)
 		return ctrl.Result{Requeue: false}, nil
 	}
 
@@ -456,11 +498,16 @@ func (r *GatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return reconcile.Result{}, nil
 	}
 
-	if isGatewayClassUnmanaged(gwc.Annotations) {
-		// The Gateway has to be reconciled by KIC only if it is unmanaged.
+	// The Gateway has to be reconciled by KIC only if it is unmanaged or its data plane is provisioned by KIC.
+	switch {
+	case isGatewayClassUnmanaged(gwc.Annotations):
 		if result, err := r.reconcileUnmanagedGateway(ctx, log, gateway); err != nil {
 			return result, err
 		}
+	case r.ManagedGatewaysEnabled && isGatewayClassManaged(gwc):
+		if result, err := r.reconcileManagedGateway(ctx, log, gateway, gwc); err != nil {
+			return result, err
+		}
 	}
 
 	// If the Gateway has been accepted (by KIC or the managing controller), the dataplane update must be performed.
@@ -478,6 +525,16 @@ func (r *GatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 				return ctrl.Result{Requeue: true}, nil
 			}
 		}
+
+		referredConfigMapNames := listConfigMapNamesReferredByGateway(gateway)
+		if err := ctrlref.UpdateReferencesToConfigMap(
+			ctx, r.Client, r.ReferenceIndexers, r.DataplaneClient,
+			gateway, referredConfigMapNames); err != nil {
+			if apierrors.IsNotFound(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, err
+		}
 	}
 	return ctrl.Result{}, nil
 }
@@ -872,8 +929,9 @@ func (r *GatewayReconciler) updateAddressesAndListenersStatus(
 		setGatewayCondition(gateway, programmedCondition)
 		return true, r.Status().Update(ctx, pruneGatewayStatusConds(gateway))
 	}
-	if !reflect.DeepEqual(gateway.Status.Listeners, listenerStatuses) {
+	if !reflect.DeepEqual(gateway.Status.Listeners, listenerStatuses) || !reflect.DeepEqual(gateway.Status.Addresses, addresses) {
 		gateway.Status.Listeners = listenerStatuses
+		gateway.Status.Addresses = addresses
 		return true, r.Status().Update(ctx, gateway)
 	}
 	return false, nil
@@ -0,0 +1,720 @@
+package gateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/adminapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
+)
+
+// -----------------------------------------------------------------------------
+// Gateway Controller - Managed Mode - Vars & Consts
+// -----------------------------------------------------------------------------
+
+const (
+	// ManagedGatewayLabel is the label set on the Deployment, Pods, Services and Secret provisioned for a managed Gateway.
+	// Its value is the UID of the Gateway.
+	ManagedGatewayLabel = "konghq.com/managed-gateway"
+
+	// managedDataPlaneContainerName is the name of the Kong Gateway container in the provisioned Deployment.
+	managedDataPlaneContainerName = "proxy"
+
+	// managedDataPlaneFirstProxyPort is the container port assigned to the first Gateway listener port. Subsequent
+	// listener ports get consecutive container ports, so that privileged ports are never bound in the container.
+	managedDataPlaneFirstProxyPort = 8000
+
+	// managedDataPlaneAdminPort is the container port of the Kong Admin API.
+	managedDataPlaneAdminPort = 8444
+
+	// ManagedDataPlaneAdminPortName is the name of the Kong Admin API port. Admin APIs are discovered
+	// from the provisioned admin Service's EndpointSlices by this name.
+	ManagedDataPlaneAdminPortName = "admin-tls"
+
+	// managedDataPlaneAdminTLSVolumeName is the name of the volume with the Admin API TLS Secret.
+	managedDataPlaneAdminTLSVolumeName = "admin-tls"
+
+	// managedDataPlaneAdminTLSMountPath is the path the Admin API TLS Secret is mounted at in the Kong Gateway container.
+	managedDataPlaneAdminTLSMountPath = "/etc/kong/admin-tls"
+
+	// managedDataPlaneAdminTLSChecksumAnnotation is set on the data plane's pods to roll them out whenever
+	// the Admin API TLS Secret's certificates change.
+	managedDataPlaneAdminTLSChecksumAnnotation = "konghq.com/admin-tls-checksum"
+
+	// managedDataPlaneDefaultImage is the Kong Gateway image used when the KongGatewayConfiguration doesn't set one.
+	// It matches the default of the KongGatewayConfiguration's spec.dataPlane.image field.
+	managedDataPlaneDefaultImage = "kong:3.7"
+
+	// managedDataPlaneStatusPort is the container port of the Kong status API used for readiness probes.
+	managedDataPlaneStatusPort = 8100
+
+	// managedDataPlaneReadinessPath is the Kong status API path used for readiness probes. Unlike /status,
+	// it reports ready only after a DB-less Kong Gateway has loaded its configuration, so that pods don't
+	// get traffic before the controller has pushed the configuration to them.
+	managedDataPlaneReadinessPath = "/status/ready"
+
+	// managedDataPlaneMaxNameLength is the maximum length of the provisioned resources' names. It's limited
+	// by the Service name which has to be a valid DNS-1035 label.
+	managedDataPlaneMaxNameLength = 63
+)
+
+// errGatewayClassParametersRefNamespaceMissing is returned when a GatewayClass refers to a KongGatewayConfiguration
+// without specifying its namespace.
+var errGatewayClassParametersRefNamespaceMissing = errors.New("namespace is required in parametersRef")
+
+// -----------------------------------------------------------------------------
+// Gateway Controller - Managed Mode - Reconciliation
+// -----------------------------------------------------------------------------
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways/finalizers,verbs=update
+// +kubebuilder:rbac:groups=incubator.ingress-controller.konghq.com,resources=konggatewayconfigurations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch
+
+// reconcileManagedGateway reconciles a Gateway whose GatewayClass refers to a KongGatewayConfiguration.
+// In this mode the controller provisions a Kong Gateway Deployment and a Service exposing it for the Gateway,
+// and derives the Gateway's addresses and listeners from the provisioned Service. The Kong Gateways' Admin APIs
+// are exposed with a headless Service, secured with certificates from a Secret provisioned for the Gateway,
+// and registered to be configured by the controller. All the objects are owned by the Gateway, so they're
+// garbage collected when the Gateway gets deleted.
+func (r *GatewayReconciler) reconcileManagedGateway(
+	ctx context.Context,
+	log logr.Logger,
+	gateway *gatewayapi.Gateway,
+	gwc *gatewayapi.GatewayClass,
+) (ctrl.Result, error) {
+	debug(log, gateway, "Retrieving KongGatewayConfiguration referenced by gatewayclass", "gatewayclass", gwc.Name)
+	gwConfig, err := r.getKongGatewayConfiguration(ctx, gwc)
+	if err != nil {
+		if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) && !errors.Is(err, errGatewayClassParametersRefNamespaceMissing) {
+			return ctrl.Result{}, err
+		}
+		info(log, gateway, "Gatewayclass parameters could not be resolved, marking gateway as not accepted", "error", err.Error())
+		return ctrl.Result{}, r.setGatewayNotAccepted(ctx, gateway, gatewayapi.GatewayReasonInvalidParameters,
+			fmt.Sprintf("parameters of gatewayclass %s could not be resolved: %s", gwc.Name, err))
+	}
+
+	svcName := k8stypes.NamespacedName{Namespace: gateway.Namespace, Name: managedDataPlaneName(gateway)}
+	if publishServices := annotations.ExtractGatewayPublishService(gateway.Annotations); len(publishServices) != 1 ||
+		publishServices[0] != svcName.String() {
+		debug(log, gateway, fmt.Sprintf("Setting publish service annotation to the provisioned service %s", svcName))
+		if gateway.Annotations == nil {
+			gateway.Annotations = map[string]string{}
+		}
+		annotations.UpdateGatewayPublishService(gateway.Annotations, []string{svcName.String()})
+		return ctrl.Result{}, r.Update(ctx, gateway)
+	}
+
+	if !isGatewayAccepted(gateway) {
+		info(log, gateway, "Marking gateway as accepted")
+		acceptedCondition := metav1.Condition{
+			Type:               string(gatewayapi.GatewayConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: gateway.Generation,
+			LastTransitionTime: metav1.Now(),
+			Reason:             string(gatewayapi.GatewayReasonAccepted),
+			Message:            "this managed gateway has been picked up by the controller and its data plane will be provisioned",
+		}
+		setGatewayCondition(gateway, acceptedCondition)
+		programmedCondition := metav1.Condition{
+			Type:               string(gatewayapi.GatewayConditionProgrammed),
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: gateway.Generation,
+			LastTransitionTime: metav1.Now(),
+			Reason:             string(gatewayapi.GatewayReasonPending),
+		}
+		setGatewayCondition(gateway, programmedCondition)
+		return ctrl.Result{}, r.Status().Update(ctx, pruneGatewayStatusConds(gateway))
+	}
+
+	ports := managedDataPlanePorts(gateway)
+	adminName := k8stypes.NamespacedName{Namespace: gateway.Namespace, Name: managedDataPlaneAdminName(gateway)}
+
+	debug(log, gateway, "Ensuring data plane admin API TLS secret for gateway")
+	adminTLS := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: adminName.Namespace, Name: adminName.Name}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, adminTLS, func() error {
+		if err := configureManagedDataPlaneAdminTLSSecret(adminTLS, gateway); err != nil {
+			return err
+		}
+		return controllerutil.SetControllerReference(gateway, adminTLS, r.Scheme)
+	}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to ensure data plane admin API TLS secret %s: %w", adminName, err)
+	}
+
+	debug(log, gateway, "Ensuring data plane deployment for gateway")
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: svcName.Namespace, Name: svcName.Name}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, deployment, func() error {
+		configureManagedDataPlaneDeployment(deployment, gateway, gwConfig, ports, adminTLS)
+		return controllerutil.SetControllerReference(gateway, deployment, r.Scheme)
+	}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to ensure data plane deployment %s: %w", svcName, err)
+	}
+
+	debug(log, gateway, "Ensuring data plane service for gateway")
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: svcName.Namespace, Name: svcName.Name}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, svc, func() error {
+		configureManagedDataPlaneService(svc, gateway, gwConfig, ports)
+		return controllerutil.SetControllerReference(gateway, svc, r.Scheme)
+	}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to ensure data plane service %s: %w", svcName, err)
+	}
+
+	debug(log, gateway, "Ensuring data plane admin API service for gateway")
+	adminSvc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: adminName.Namespace, Name: adminName.Name}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, adminSvc, func() error {
+		configureManagedDataPlaneAdminService(adminSvc, gateway)
+		return controllerutil.SetControllerReference(gateway, adminSvc, r.Scheme)
+	}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to ensure data plane admin API service %s: %w", adminName, err)
+	}
+
+	debug(log, gateway, "Registering data plane admin APIs for gateway")
+	if err := r.notifyManagedGatewayAdminAPIs(ctx, gateway, adminName, adminTLS); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// The Gateway can't be considered programmed until there's at least one data plane replica able to serve
+	// traffic. The reconciliation will be triggered again once the Deployment's status changes.
+	if deployment.Status.ReadyReplicas < 1 {
+		debug(log, gateway, "Data plane deployment is not ready yet")
+		if util.CheckCondition(
+			gateway.Status.Conditions,
+			util.ConditionType(gatewayapi.GatewayConditionProgrammed),
+			util.ConditionReason(gatewayapi.GatewayReasonPending),
+			metav1.ConditionFalse,
+			gateway.Generation,
+		) {
+			return ctrl.Result{}, nil
+		}
+		setGatewayCondition(gateway, metav1.Condition{
+			Type:               string(gatewayapi.GatewayConditionProgrammed),
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: gateway.Generation,
+			LastTransitionTime: metav1.Now(),
+			Reason:             string(gatewayapi.GatewayReasonPending),
+			Message:            "waiting for the data plane deployment to become ready",
+		})
+		return ctrl.Result{}, r.Status().Update(ctx, pruneGatewayStatusConds(gateway))
+	}
+
+	debug(log, gateway, "Determining addresses from the provisioned service")
+	addresses, _, err := r.determineL4ListenersFromService(log, svc)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	referenceGrantList := &gatewayapi.ReferenceGrantList{}
+	if r.enableReferenceGrant {
+		if err := r.Client.List(ctx, referenceGrantList); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	listenerStatuses, err := getListenerStatus(ctx, gateway, managedDataPlaneListeners(ports), referenceGrantList.Items, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	debug(log, gateway, "Updating the gateway status if necessary")
+	isChanged, err := r.updateAddressesAndListenersStatus(ctx, gateway, listenerStatuses, addresses)
+	if err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	if isChanged {
+		debug(log, gateway, "Gateway status updated")
+		return ctrl.Result{}, nil
+	}
+
+	info(log, gateway, "Gateway provisioning complete")
+	return ctrl.Result{}, nil
+}
+
+// getKongGatewayConfiguration retrieves the KongGatewayConfiguration referenced by the GatewayClass' parametersRef.
+func (r *GatewayReconciler) getKongGatewayConfiguration(
+	ctx context.Context,
+	gwc *gatewayapi.GatewayClass,
+) (*incubatorv1alpha1.KongGatewayConfiguration, error) {
+	ref := gwc.Spec.ParametersRef
+	if ref.Namespace == nil || *ref.Namespace == "" {
+		return nil, errGatewayClassParametersRefNamespaceMissing
+	}
+	gwConfig := &incubatorv1alpha1.KongGatewayConfiguration{}
+	nn := k8stypes.NamespacedName{Namespace: string(*ref.Namespace), Name: ref.Name}
+	if err := r.Get(ctx, nn, gwConfig); err != nil {
+		return nil, fmt.Errorf("failed to get %s %s: %w", incubatorv1alpha1.KongGatewayConfigurationKind, nn, err)
+	}
+	return gwConfig, nil
+}
+
+// setGatewayNotAccepted sets the Gateway's Accepted condition to false with the given reason and message,
+// unless it's already set.
+func (r *GatewayReconciler) setGatewayNotAccepted(
+	ctx context.Context,
+	gateway *gatewayapi.Gateway,
+	reason gatewayapi.GatewayConditionReason,
+	message string,
+) error {
+	if util.CheckCondition(
+		gateway.Status.Conditions,
+		util.ConditionType(gatewayapi.GatewayConditionAccepted),
+		util.ConditionReason(reason),
+		metav1.ConditionFalse,
+		gateway.Generation,
+	) {
+		return nil
+	}
+	setGatewayCondition(gateway, metav1.Condition{
+		Type:               string(gatewayapi.GatewayConditionAccepted),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: gateway.Generation,
+		LastTransitionTime: metav1.Now(),
+		Reason:             string(reason),
+		Message:            message,
+	})
+	return r.Status().Update(ctx, pruneGatewayStatusConds(gateway))
+}
+
+// listGatewaysForKongGatewayConfiguration is a watch predicate which finds all the gateway objects whose
+// GatewayClasses refer to the KongGatewayConfiguration and enqueues them for reconciliation.
+func (r *GatewayReconciler) listGatewaysForKongGatewayConfiguration(ctx context.Context, obj client.Object) []reconcile.Request {
+	gatewayClasses := &gatewayapi.GatewayClassList{}
+	if err := r.Client.List(ctx, gatewayClasses); err != nil {
+		r.Log.Error(err, "Failed to list gatewayclasses in watch", "konggatewayconfiguration", client.ObjectKeyFromObject(obj))
+		return nil
+	}
+	gateways := &gatewayapi.GatewayList{}
+	if err := r.Client.List(ctx, gateways); err != nil {
+		r.Log.Error(err, "Failed to list gateways in watch", "konggatewayconfiguration", client.ObjectKeyFromObject(obj))
+		return nil
+	}
+
+	var recs []reconcile.Request
+	for i := range gatewayClasses.Items {
+		gwc := &gatewayClasses.Items[i]
+		if !isGatewayClassControlled(gwc) || !isGatewayClassManaged(gwc) {
+			continue
+		}
+		ref := gwc.Spec.ParametersRef
+		if ref.Name != obj.GetName() || ref.Namespace == nil || string(*ref.Namespace) != obj.GetNamespace() {
+			continue
+		}
+		for _, rec := range reconcileGatewaysIfClassMatches(gwc, gateways.Items) {
+			if r.GatewayNN.MatchesNN(rec.NamespacedName) {
+				recs = append(recs, rec)
+			}
+		}
+	}
+	return recs
+}
+
+// listManagedGatewaysForEndpointSlice is a watch predicate which finds the managed Gateway whose provisioned
+// Service the EndpointSlice belongs to and enqueues it for reconciliation.
+func (r *GatewayReconciler) listManagedGatewaysForEndpointSlice(ctx context.Context, obj client.Object) []reconcile.Request {
+	gateways := &gatewayapi.GatewayList{}
+	if err := r.Client.List(ctx, gateways, client.InNamespace(obj.GetNamespace())); err != nil {
+		r.Log.Error(err, "Failed to list gateways in watch", "endpointslice", client.ObjectKeyFromObject(obj))
+		return nil
+	}
+	for _, gateway := range gateways.Items {
+		nn := client.ObjectKeyFromObject(&gateway)
+		if string(gateway.UID) == obj.GetLabels()[ManagedGatewayLabel] && r.GatewayNN.MatchesNN(nn) {
+			return []reconcile.Request{{NamespacedName: nn}}
+		}
+	}
+	return nil
+}
+
+// isManagedDataPlaneObject returns true if the object has been provisioned for a managed Gateway (or, in case
+// of EndpointSlices, inherited the labels of such an object).
+func isManagedDataPlaneObject(obj client.Object) bool {
+	_, ok := obj.GetLabels()[ManagedGatewayLabel]
+	return ok
+}
+
+// -----------------------------------------------------------------------------
+// Gateway Controller - Managed Mode - Admin API
+// -----------------------------------------------------------------------------
+
+// ManagedAdminAPIsDiscoverer discovers Admin APIs backing a Service.
+type ManagedAdminAPIsDiscoverer interface {
+	GetAdminAPIsForService(ctx context.Context, kubeClient client.Client, service k8stypes.NamespacedName) (
+		sets.Set[adminapi.DiscoveredAdminAPI], error,
+	)
+}
+
+// ManagedAdminAPIsNotifier is notified about Admin APIs of the data plane provisioned for a managed Gateway.
+type ManagedAdminAPIsNotifier interface {
+	NotifyManagedGateway(gateway k8stypes.NamespacedName, adminAPIs []adminapi.DiscoveredAdminAPI)
+}
+
+// notifyManagedGatewayAdminAPIs discovers Admin APIs of the data plane provisioned for the Gateway from
+// the admin API Service's EndpointSlices and notifies about them, so that they get configured. Admin APIs are
+// connected to with the certificates from the Admin API TLS Secret provisioned for the Gateway, regardless of
+// the TLS configuration used for the Admin APIs discovered from --kong-admin-svc.
+func (r *GatewayReconciler) notifyManagedGatewayAdminAPIs(
+	ctx context.Context,
+	gateway *gatewayapi.Gateway,
+	adminSvc k8stypes.NamespacedName,
+	adminTLS *corev1.Secret,
+) error {
+	if r.ManagedAdminAPIsDiscoverer == nil || r.ManagedAdminAPIsNotifier == nil {
+		return nil
+	}
+
+	discovered, err := r.ManagedAdminAPIsDiscoverer.GetAdminAPIsForService(ctx, r.Client, adminSvc)
+	if err != nil {
+		return fmt.Errorf("failed to discover admin APIs of data plane %s: %w", adminSvc, err)
+	}
+	tls := adminapi.AdminAPITLS{
+		CACert:     string(adminTLS.Data[corev1.TLSCertKey]),
+		ServerName: managedDataPlaneAdminServerName(adminSvc),
+		ClientCert: string(adminTLS.Data[managedDataPlaneAdminTLSClientCertKey]),
+		ClientKey:  string(adminTLS.Data[managedDataPlaneAdminTLSClientKeyKey]),
+	}
+	adminAPIs := lo.Map(discovered.UnsortedList(), func(d adminapi.DiscoveredAdminAPI, _ int) adminapi.DiscoveredAdminAPI {
+		d.TLS = tls
+		return d
+	})
+	slices.SortFunc(adminAPIs, func(a, b adminapi.DiscoveredAdminAPI) int {
+		return strings.Compare(a.Address, b.Address)
+	})
+	r.ManagedAdminAPIsNotifier.NotifyManagedGateway(client.ObjectKeyFromObject(gateway), adminAPIs)
+	return nil
+}
+
+// forgetManagedGatewayAdminAPIs notifies that the Admin APIs of the data plane provisioned for the Gateway
+// should no longer be configured.
+func (r *GatewayReconciler) forgetManagedGatewayAdminAPIs(gateway k8stypes.NamespacedName) {
+	if !r.ManagedGatewaysEnabled || r.ManagedAdminAPIsNotifier == nil {
+		return
+	}
+	r.ManagedAdminAPIsNotifier.NotifyManagedGateway(gateway, nil)
+}
+
+// -----------------------------------------------------------------------------
+// Gateway Controller - Managed Mode - Data Plane Resources
+// -----------------------------------------------------------------------------
+
+// managedDataPlanePort describes a port exposed by the data plane provisioned for a managed Gateway.
+type managedDataPlanePort struct {
+	name          string
+	protocol      gatewayapi.ProtocolType
+	port          int32
+	containerPort int32
+}
+
+// isGatewayClassManaged returns true if the GatewayClass refers to a KongGatewayConfiguration via its
+// parametersRef, meaning that the controller should provision data planes for its Gateways.
+func isGatewayClassManaged(gwc *gatewayapi.GatewayClass) bool {
+	ref := gwc.Spec.ParametersRef
+	return ref != nil &&
+		string(ref.Group) == incubatorv1alpha1.GroupVersion.Group &&
+		string(ref.Kind) == incubatorv1alpha1.KongGatewayConfigurationKind
+}
+
+// managedDataPlaneName returns the name of the Deployment and Service provisioned for the Gateway.
+// The name is guaranteed to be a valid DNS-1035 label.
+func managedDataPlaneName(gateway *gatewayapi.Gateway) string {
+	return managedDataPlaneNameWithSuffix(gateway, "")
+}
+
+// managedDataPlaneAdminName returns the name of the Admin API Service and TLS Secret provisioned for the Gateway.
+// The name is guaranteed to be a valid DNS-1035 label.
+func managedDataPlaneAdminName(gateway *gatewayapi.Gateway) string {
+	return managedDataPlaneNameWithSuffix(gateway, "-admin")
+}
+
+func managedDataPlaneNameWithSuffix(gateway *gatewayapi.Gateway, suffix string) string {
+	name := "kong-" + strings.ReplaceAll(gateway.Name, ".", "-")
+	if len(name)+len(suffix) <= managedDataPlaneMaxNameLength {
+		return name + suffix
+	}
+	// Keep the name unique by appending a hash of the Gateway's name to the truncated name.
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(gateway.Name)))[:8]
+	prefix := strings.TrimRight(name[:managedDataPlaneMaxNameLength-len(hash)-len(suffix)-1], "-")
+	return prefix + "-" + hash + suffix
+}
+
+// managedDataPlanePorts returns the ports that the data plane has to expose to serve the Gateway's listeners.
+// Listeners sharing a port are served by the same Kong listen, the protocol of the first one is used.
+// Listeners with protocols that are not supported are skipped.
+func managedDataPlanePorts(gateway *gatewayapi.Gateway) []managedDataPlanePort {
+	var ports []managedDataPlanePort
+	seen := make(map[gatewayapi.PortNumber]struct{}, len(gateway.Spec.Listeners))
+	for _, listener := range gateway.Spec.Listeners {
+		if _, ok := seen[listener.Port]; ok {
+			continue
+		}
+		switch listener.Protocol {
+		case gatewayapi.HTTPProtocolType, gatewayapi.HTTPSProtocolType,
+			gatewayapi.TCPProtocolType, gatewayapi.TLSProtocolType, gatewayapi.UDPProtocolType:
+		default:
+			continue
+		}
+		seen[listener.Port] = struct{}{}
+		ports = append(ports, managedDataPlanePort{
+			// Listener names can't be used as they may not be valid port names (IANA_SVC_NAME).
+			name:          fmt.Sprintf("%s-%d", strings.ToLower(string(listener.Protocol)), listener.Port),
+			protocol:      listener.Protocol,
+			port:          int32(listener.Port),
+			containerPort: managedDataPlaneFirstProxyPort + int32(len(ports)),
+		})
+	}
+	return ports
+}
+
+// managedDataPlaneListeners returns the listeners that are provided by the data plane exposing the given ports.
+func managedDataPlaneListeners(ports []managedDataPlanePort) []gatewayapi.Listener {
+	return lo.Map(ports, func(p managedDataPlanePort, _ int) gatewayapi.Listener {
+		return gatewayapi.Listener{
+			Name:     gatewayapi.SectionName(p.name),
+			Protocol: p.protocol,
+			Port:     gatewayapi.PortNumber(p.port),
+		}
+	})
+}
+
+// managedDataPlaneListens returns values of Kong's proxy_listen and stream_listen configuration options
+// for the given ports.
+func managedDataPlaneListens(ports []managedDataPlanePort) (proxyListen string, streamListen string) {
+	var proxyListens, streamListens []string
+	for _, p := range ports {
+		addr := fmt.Sprintf("0.0.0.0:%d", p.containerPort)
+		switch p.protocol { //nolint:exhaustive
+		case gatewayapi.HTTPProtocolType:
+			proxyListens = append(proxyListens, addr)
+		case gatewayapi.HTTPSProtocolType:
+			proxyListens = append(proxyListens, addr+" http2 ssl")
+		case gatewayapi.TCPProtocolType:
+			streamListens = append(streamListens, addr)
+		case gatewayapi.TLSProtocolType:
+			streamListens = append(streamListens, addr+" ssl")
+		case gatewayapi.UDPProtocolType:
+			streamListens = append(streamListens, addr+" udp")
+		}
+	}
+	listenOrOff := func(listens []string) string {
+		if len(listens) == 0 {
+			return "off"
+		}
+		return strings.Join(listens, ", ")
+	}
+	return listenOrOff(proxyListens), listenOrOff(streamListens)
+}
+
+// managedDataPlaneLabels returns the labels identifying the data plane provisioned for the Gateway.
+func managedDataPlaneLabels(gateway *gatewayapi.Gateway) map[string]string {
+	return map[string]string{
+		ManagedGatewayLabel: string(gateway.UID),
+	}
+}
+
+// managedDataPlaneEnv returns the environment variables of the Kong Gateway container. Variables defined
+// in the KongGatewayConfiguration override the ones generated by the controller. The Admin API serves
+// the certificate from the Admin API TLS Secret and accepts only clients presenting its client certificate.
+func managedDataPlaneEnv(gwConfig *incubatorv1alpha1.KongGatewayConfiguration, ports []managedDataPlanePort) []corev1.EnvVar {
+	proxyListen, streamListen := managedDataPlaneListens(ports)
+	env := []corev1.EnvVar{
+		{Name: "KONG_DATABASE", Value: "off"},
+		{Name: "KONG_PROXY_LISTEN", Value: proxyListen},
+		{Name: "KONG_STREAM_LISTEN", Value: streamListen},
+		{Name: "KONG_ADMIN_LISTEN", Value: fmt.Sprintf("0.0.0.0:%d http2 ssl", managedDataPlaneAdminPort)},
+		{Name: "KONG_ADMIN_SSL_CERT", Value: path.Join(managedDataPlaneAdminTLSMountPath, corev1.TLSCertKey)},
+		{Name: "KONG_ADMIN_SSL_CERT_KEY", Value: path.Join(managedDataPlaneAdminTLSMountPath, corev1.TLSPrivateKeyKey)},
+		{Name: "KONG_NGINX_ADMIN_SSL_VERIFY_CLIENT", Value: "on"},
+		{
+			Name:  "KONG_NGINX_ADMIN_SSL_CLIENT_CERTIFICATE",
+			Value: path.Join(managedDataPlaneAdminTLSMountPath, managedDataPlaneAdminTLSClientCertKey),
+		},
+		{Name: "KONG_STATUS_LISTEN", Value: fmt.Sprintf("0.0.0.0:%d", managedDataPlaneStatusPort)},
+		{Name: "KONG_PROXY_ACCESS_LOG", Value: "/dev/stdout"},
+		{Name: "KONG_PROXY_ERROR_LOG", Value: "/dev/stderr"},
+		{Name: "KONG_ADMIN_ACCESS_LOG", Value: "/dev/stdout"},
+		{Name: "KONG_ADMIN_ERROR_LOG", Value: "/dev/stderr"},
+	}
+	for _, override := range gwConfig.Spec.DataPlane.Env {
+		if _, i, ok := lo.FindIndexOf(env, func(e corev1.EnvVar) bool { return e.Name == override.Name }); ok {
+			env[i] = override
+			continue
+		}
+		env = append(env, override)
+	}
+	return env
+}
+
+// configureManagedDataPlaneDeployment sets the desired state of the Kong Gateway Deployment provisioned for the Gateway.
+func configureManagedDataPlaneDeployment(
+	deployment *appsv1.Deployment,
+	gateway *gatewayapi.Gateway,
+	gwConfig *incubatorv1alpha1.KongGatewayConfiguration,
+	ports []managedDataPlanePort,
+	adminTLS *corev1.Secret,
+) {
+	labels := managedDataPlaneLabels(gateway)
+	deployment.Labels = lo.Assign(deployment.Labels, labels)
+
+	// Selector is immutable, it's set only when the Deployment is created.
+	if deployment.Spec.Selector == nil {
+		deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: labels}
+	}
+	deployment.Spec.Replicas = lo.ToPtr(lo.FromPtrOr(gwConfig.Spec.DataPlane.Replicas, 1))
+	deployment.Spec.Template.Labels = lo.Assign(gwConfig.Spec.DataPlane.PodLabels, labels)
+	deployment.Spec.Template.Annotations = lo.Assign(deployment.Spec.Template.Annotations, map[string]string{
+		managedDataPlaneAdminTLSChecksumAnnotation: managedDataPlaneAdminTLSChecksum(adminTLS),
+	})
+
+	image := gwConfig.Spec.DataPlane.Image
+	if image == "" {
+		image = managedDataPlaneDefaultImage
+	}
+	containerPorts := []corev1.ContainerPort{
+		{Name: ManagedDataPlaneAdminPortName, ContainerPort: managedDataPlaneAdminPort, Protocol: corev1.ProtocolTCP},
+		{Name: "status", ContainerPort: managedDataPlaneStatusPort, Protocol: corev1.ProtocolTCP},
+	}
+	for _, p := range ports {
+		containerPorts = append(containerPorts, corev1.ContainerPort{
+			Name:          p.name,
+			ContainerPort: p.containerPort,
+			Protocol:      serviceProtocolForListenerProtocol(p.protocol),
+		})
+	}
+	container := corev1.Container{
+		Name:  managedDataPlaneContainerName,
+		Image: image,
+		Env:   managedDataPlaneEnv(gwConfig, ports),
+		Ports: containerPorts,
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: managedDataPlaneAdminTLSVolumeName, MountPath: managedDataPlaneAdminTLSMountPath, ReadOnly: true},
+		},
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   managedDataPlaneReadinessPath,
+					Port:   intstr.FromString("status"),
+					Scheme: corev1.URISchemeHTTP,
+				},
+			},
+		},
+	}
+	if gwConfig.Spec.DataPlane.Resources != nil {
+		container.Resources = *gwConfig.Spec.DataPlane.Resources
+	}
+	deployment.Spec.Template.Spec.Containers = []corev1.Container{container}
+	deployment.Spec.Template.Spec.Volumes = []corev1.Volume{
+		{
+			Name: managedDataPlaneAdminTLSVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: adminTLS.Name,
+					// The client key is used only by the controller, it's not mounted in the data plane.
+					Items: []corev1.KeyToPath{
+						{Key: corev1.TLSCertKey, Path: corev1.TLSCertKey},
+						{Key: corev1.TLSPrivateKeyKey, Path: corev1.TLSPrivateKeyKey},
+						{Key: managedDataPlaneAdminTLSClientCertKey, Path: managedDataPlaneAdminTLSClientCertKey},
+					},
+				},
+			},
+		},
+	}
+}
+
+// configureManagedDataPlaneAdminService sets the desired state of the headless Service exposing the Admin APIs
+// of the Kong Gateway provisioned for the Gateway.
+func configureManagedDataPlaneAdminService(svc *corev1.Service, gateway *gatewayapi.Gateway) {
+	labels := managedDataPlaneLabels(gateway)
+	svc.Labels = lo.Assign(svc.Labels, labels)
+
+	// ClusterIP is immutable, it's set only when the Service is created.
+	if svc.Spec.ClusterIP == "" {
+		svc.Spec.ClusterIP = corev1.ClusterIPNone
+	}
+	svc.Spec.Type = corev1.ServiceTypeClusterIP
+	svc.Spec.Selector = labels
+	// The data plane's pods become ready only after they get configured, so the Admin APIs of pods that are not
+	// ready yet have to be discoverable as well.
+	svc.Spec.PublishNotReadyAddresses = true
+	svc.Spec.Ports = []corev1.ServicePort{
+		{
+			Name:       ManagedDataPlaneAdminPortName,
+			Protocol:   corev1.ProtocolTCP,
+			Port:       managedDataPlaneAdminPort,
+			TargetPort: intstr.FromInt32(managedDataPlaneAdminPort),
+		},
+	}
+}
+
+// configureManagedDataPlaneService sets the desired state of the Service exposing the Kong Gateway
+// provisioned for the Gateway.
+func configureManagedDataPlaneService(
+	svc *corev1.Service,
+	gateway *gatewayapi.Gateway,
+	gwConfig *incubatorv1alpha1.KongGatewayConfiguration,
+	ports []managedDataPlanePort,
+) {
+	labels := managedDataPlaneLabels(gateway)
+	svc.Labels = lo.Assign(svc.Labels, labels)
+	svc.Annotations = lo.Assign(svc.Annotations, gwConfig.Spec.Service.Annotations)
+
+	svcType := gwConfig.Spec.Service.Type
+	if svcType == "" {
+		svcType = corev1.ServiceTypeLoadBalancer
+	}
+	svc.Spec.Type = svcType
+	svc.Spec.Selector = labels
+
+	servicePorts := make([]corev1.ServicePort, 0, len(ports))
+	for _, p := range ports {
+		servicePort := corev1.ServicePort{
+			Name:       p.name,
+			Protocol:   serviceProtocolForListenerProtocol(p.protocol),
+			Port:       p.port,
+			TargetPort: intstr.FromInt32(p.containerPort),
+		}
+		// Preserve node ports allocated by the API server, so they don't change with every update.
+		if svcType != corev1.ServiceTypeClusterIP {
+			if existing, ok := lo.Find(svc.Spec.Ports, func(sp corev1.ServicePort) bool {
+				return sp.Port == servicePort.Port && sp.Protocol == servicePort.Protocol
+			}); ok {
+				servicePort.NodePort = existing.NodePort
+			}
+		}
+		servicePorts = append(servicePorts, servicePort)
+	}
+	svc.Spec.Ports = servicePorts
+}
+
+// serviceProtocolForListenerProtocol returns the L4 protocol used to serve a Gateway listener's protocol.
+func serviceProtocolForListenerProtocol(protocol gatewayapi.ProtocolType) corev1.Protocol {
+	if protocol == gatewayapi.UDPProtocolType {
+		return corev1.ProtocolUDP
+	}
+	return corev1.ProtocolTCP
+}
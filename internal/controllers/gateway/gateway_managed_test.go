@@ -0,0 +1,368 @@
+package gateway
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/adminapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	managerscheme "github.com/kong/kubernetes-ingress-controller/v3/internal/manager/scheme"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
+)
+
+func TestIsGatewayClassManaged(t *testing.T) {
+	testCases := []struct {
+		name          string
+		parametersRef *gatewayapi.ParametersReference
+		expected      bool
+	}{
+		{
+			name: "no parametersRef",
+		},
+		{
+			name: "parametersRef to KongGatewayConfiguration",
+			parametersRef: &gatewayapi.ParametersReference{
+				Group: gatewayapi.Group(incubatorv1alpha1.GroupVersion.Group),
+				Kind:  gatewayapi.Kind(incubatorv1alpha1.KongGatewayConfigurationKind),
+				Name:  "config",
+			},
+			expected: true,
+		},
+		{
+			name: "parametersRef to another kind",
+			parametersRef: &gatewayapi.ParametersReference{
+				Group: "gateway-operator.konghq.com",
+				Kind:  "GatewayConfiguration",
+				Name:  "config",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gwc := &gatewayapi.GatewayClass{
+				Spec: gatewayapi.GatewayClassSpec{
+					ControllerName: GetControllerName(),
+					ParametersRef:  tc.parametersRef,
+				},
+			}
+			require.Equal(t, tc.expected, isGatewayClassManaged(gwc))
+		})
+	}
+}
+
+func TestManagedDataPlaneName(t *testing.T) {
+	testCases := []struct {
+		name        string
+		gatewayName string
+		expected    string
+	}{
+		{
+			name:        "short name",
+			gatewayName: "gateway",
+			expected:    "kong-gateway",
+		},
+		{
+			name:        "name with dots",
+			gatewayName: "gateway.example.com",
+			expected:    "kong-gateway-example-com",
+		},
+		{
+			name:        "long name is truncated",
+			gatewayName: strings.Repeat("a", 100),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &gatewayapi.Gateway{ObjectMeta: metav1.ObjectMeta{Name: tc.gatewayName}}
+			name := managedDataPlaneName(gateway)
+			require.Empty(t, validation.IsDNS1035Label(name))
+			adminName := managedDataPlaneAdminName(gateway)
+			require.Empty(t, validation.IsDNS1035Label(adminName))
+			require.NotEqual(t, name, adminName)
+			if tc.expected != "" {
+				require.Equal(t, tc.expected, name)
+				require.Equal(t, tc.expected+"-admin", adminName)
+			}
+		})
+	}
+
+	t.Run("truncated names of different gateways differ", func(t *testing.T) {
+		a := managedDataPlaneName(&gatewayapi.Gateway{ObjectMeta: metav1.ObjectMeta{Name: strings.Repeat("a", 100)}})
+		b := managedDataPlaneName(&gatewayapi.Gateway{ObjectMeta: metav1.ObjectMeta{Name: strings.Repeat("a", 99) + "b"}})
+		require.NotEqual(t, a, b)
+	})
+}
+
+func TestManagedDataPlanePortsAndListens(t *testing.T) {
+	gateway := &gatewayapi.Gateway{
+		Spec: gatewayapi.GatewaySpec{
+			Listeners: []gatewayapi.Listener{
+				{Name: "http", Protocol: gatewayapi.HTTPProtocolType, Port: 80},
+				{Name: "http-other-host", Protocol: gatewayapi.HTTPProtocolType, Port: 80},
+				{Name: "https", Protocol: gatewayapi.HTTPSProtocolType, Port: 443},
+				{Name: "tcp", Protocol: gatewayapi.TCPProtocolType, Port: 9000},
+				{Name: "tls", Protocol: gatewayapi.TLSProtocolType, Port: 9443},
+				{Name: "udp", Protocol: gatewayapi.UDPProtocolType, Port: 9999},
+				{Name: "custom", Protocol: "example.com/custom", Port: 1234},
+			},
+		},
+	}
+
+	ports := managedDataPlanePorts(gateway)
+	require.Equal(t, []managedDataPlanePort{
+		{name: "http-80", protocol: gatewayapi.HTTPProtocolType, port: 80, containerPort: 8000},
+		{name: "https-443", protocol: gatewayapi.HTTPSProtocolType, port: 443, containerPort: 8001},
+		{name: "tcp-9000", protocol: gatewayapi.TCPProtocolType, port: 9000, containerPort: 8002},
+		{name: "tls-9443", protocol: gatewayapi.TLSProtocolType, port: 9443, containerPort: 8003},
+		{name: "udp-9999", protocol: gatewayapi.UDPProtocolType, port: 9999, containerPort: 8004},
+	}, ports)
+
+	proxyListen, streamListen := managedDataPlaneListens(ports)
+	assert.Equal(t, "0.0.0.0:8000, 0.0.0.0:8001 http2 ssl", proxyListen)
+	assert.Equal(t, "0.0.0.0:8002, 0.0.0.0:8003 ssl, 0.0.0.0:8004 udp", streamListen)
+
+	proxyListen, streamListen = managedDataPlaneListens(ports[:1])
+	assert.Equal(t, "0.0.0.0:8000", proxyListen)
+	assert.Equal(t, "off", streamListen)
+}
+
+func TestManagedDataPlaneEnv(t *testing.T) {
+	gwConfig := &incubatorv1alpha1.KongGatewayConfiguration{
+		Spec: incubatorv1alpha1.KongGatewayConfigurationSpec{
+			DataPlane: incubatorv1alpha1.KongGatewayDataPlaneOptions{
+				Env: []corev1.EnvVar{
+					{Name: "KONG_PROXY_ACCESS_LOG", Value: "off"},
+					{Name: "KONG_ROUTER_FLAVOR", Value: "expressions"},
+				},
+			},
+		},
+	}
+	env := managedDataPlaneEnv(gwConfig, nil)
+
+	envMap := lo.SliceToMap(env, func(e corev1.EnvVar) (string, string) { return e.Name, e.Value })
+	require.Len(t, envMap, len(env), "environment variables must not be duplicated")
+	assert.Equal(t, "off", envMap["KONG_DATABASE"])
+	assert.Equal(t, "off", envMap["KONG_PROXY_ACCESS_LOG"], "overridden variable should be used")
+	assert.Equal(t, "expressions", envMap["KONG_ROUTER_FLAVOR"], "additional variable should be appended")
+}
+
+type fakeManagedAdminAPIsDiscoverer struct {
+	adminAPIs []adminapi.DiscoveredAdminAPI
+}
+
+func (d fakeManagedAdminAPIsDiscoverer) GetAdminAPIsForService(
+	_ context.Context, _ client.Client, _ k8stypes.NamespacedName,
+) (sets.Set[adminapi.DiscoveredAdminAPI], error) {
+	return sets.New(d.adminAPIs...), nil
+}
+
+type fakeManagedAdminAPIsNotifier struct {
+	notifications map[k8stypes.NamespacedName][]adminapi.DiscoveredAdminAPI
+}
+
+func (n *fakeManagedAdminAPIsNotifier) NotifyManagedGateway(gateway k8stypes.NamespacedName, adminAPIs []adminapi.DiscoveredAdminAPI) {
+	n.notifications[gateway] = adminAPIs
+}
+
+func TestReconcileManagedGateway(t *testing.T) {
+	ctx := context.Background()
+	const namespace = "default"
+
+	gwc := &gatewayapi.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "kong-managed"},
+		Spec: gatewayapi.GatewayClassSpec{
+			ControllerName: GetControllerName(),
+			ParametersRef: &gatewayapi.ParametersReference{
+				Group:     gatewayapi.Group(incubatorv1alpha1.GroupVersion.Group),
+				Kind:      gatewayapi.Kind(incubatorv1alpha1.KongGatewayConfigurationKind),
+				Name:      "config",
+				Namespace: lo.ToPtr(gatewayapi.Namespace(namespace)),
+			},
+		},
+	}
+	gwConfig := &incubatorv1alpha1.KongGatewayConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: namespace},
+		Spec: incubatorv1alpha1.KongGatewayConfigurationSpec{
+			DataPlane: incubatorv1alpha1.KongGatewayDataPlaneOptions{
+				Image:     "kong:3.7",
+				Replicas:  lo.ToPtr(int32(2)),
+				PodLabels: map[string]string{"app": "kong"},
+			},
+			Service: incubatorv1alpha1.KongGatewayServiceOptions{
+				Type: corev1.ServiceTypeLoadBalancer,
+			},
+		},
+	}
+	gateway := &gatewayapi.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: namespace, UID: "gateway-uid", Generation: 1},
+		Spec: gatewayapi.GatewaySpec{
+			GatewayClassName: gatewayapi.ObjectName(gwc.Name),
+			Listeners: []gatewayapi.Listener{
+				{Name: "http", Protocol: gatewayapi.HTTPProtocolType, Port: 80},
+			},
+		},
+	}
+
+	fakeClient := fakeclient.NewClientBuilder().
+		WithScheme(lo.Must(managerscheme.Get())).
+		WithObjects(gwc, gateway).
+		WithStatusSubresource(&gatewayapi.Gateway{}, &appsv1.Deployment{}, &corev1.Service{}).
+		Build()
+	notifier := &fakeManagedAdminAPIsNotifier{notifications: map[k8stypes.NamespacedName][]adminapi.DiscoveredAdminAPI{}}
+	discoveredAdminAPI := adminapi.DiscoveredAdminAPI{
+		Address: "https://10.0.0.2:8444",
+		PodRef:  k8stypes.NamespacedName{Namespace: namespace, Name: "kong-gateway-pod"},
+	}
+	r := &GatewayReconciler{
+		Client:                     fakeClient,
+		Scheme:                     fakeClient.Scheme(),
+		Log:                        logr.Discard(),
+		ManagedGatewaysEnabled:     true,
+		ManagedAdminAPIsDiscoverer: fakeManagedAdminAPIsDiscoverer{adminAPIs: []adminapi.DiscoveredAdminAPI{discoveredAdminAPI}},
+		ManagedAdminAPIsNotifier:   notifier,
+	}
+	gatewayNN := client.ObjectKeyFromObject(gateway)
+	dataPlaneNN := k8stypes.NamespacedName{Namespace: namespace, Name: "kong-gateway"}
+	adminNN := k8stypes.NamespacedName{Namespace: namespace, Name: "kong-gateway-admin"}
+
+	reconcileManagedGateway := func() *gatewayapi.Gateway {
+		t.Helper()
+		gw := &gatewayapi.Gateway{}
+		require.NoError(t, fakeClient.Get(ctx, gatewayNN, gw))
+		_, err := r.reconcileManagedGateway(ctx, r.Log, gw, gwc)
+		require.NoError(t, err)
+		require.NoError(t, fakeClient.Get(ctx, gatewayNN, gw))
+		return gw
+	}
+
+	t.Log("Verifying that gateway is not accepted when KongGatewayConfiguration doesn't exist")
+	gw := reconcileManagedGateway()
+	require.True(t, util.CheckCondition(gw.Status.Conditions,
+		util.ConditionType(gatewayapi.GatewayConditionAccepted),
+		util.ConditionReason(gatewayapi.GatewayReasonInvalidParameters),
+		metav1.ConditionFalse, gw.Generation,
+	))
+
+	require.NoError(t, fakeClient.Create(ctx, gwConfig))
+
+	t.Log("Verifying that the publish service annotation is set to the provisioned service")
+	gw = reconcileManagedGateway()
+	require.Equal(t, []string{dataPlaneNN.String()}, annotations.ExtractGatewayPublishService(gw.Annotations))
+
+	t.Log("Verifying that gateway gets accepted")
+	gw = reconcileManagedGateway()
+	require.True(t, isGatewayAccepted(gw))
+
+	t.Log("Verifying that data plane deployment and service are provisioned and gateway is pending")
+	gw = reconcileManagedGateway()
+	require.False(t, isGatewayProgrammed(gw))
+
+	deployment := &appsv1.Deployment{}
+	require.NoError(t, fakeClient.Get(ctx, dataPlaneNN, deployment))
+	require.Equal(t, int32(2), *deployment.Spec.Replicas)
+	require.Equal(t, map[string]string{ManagedGatewayLabel: "gateway-uid"}, deployment.Spec.Selector.MatchLabels)
+	require.Equal(t, map[string]string{ManagedGatewayLabel: "gateway-uid", "app": "kong"}, deployment.Spec.Template.Labels)
+	require.Len(t, deployment.Spec.Template.Spec.Containers, 1)
+	require.Equal(t, "kong:3.7", deployment.Spec.Template.Spec.Containers[0].Image)
+	require.Equal(t, "/status/ready", deployment.Spec.Template.Spec.Containers[0].ReadinessProbe.HTTPGet.Path)
+	require.Len(t, deployment.OwnerReferences, 1)
+	require.Equal(t, gateway.UID, deployment.OwnerReferences[0].UID)
+
+	svc := &corev1.Service{}
+	require.NoError(t, fakeClient.Get(ctx, dataPlaneNN, svc))
+	require.Equal(t, corev1.ServiceTypeLoadBalancer, svc.Spec.Type)
+	require.Equal(t, map[string]string{ManagedGatewayLabel: "gateway-uid"}, svc.Spec.Selector)
+	require.Len(t, svc.Spec.Ports, 1)
+	require.Equal(t, int32(80), svc.Spec.Ports[0].Port)
+	require.Equal(t, int32(8000), svc.Spec.Ports[0].TargetPort.IntVal)
+	require.Len(t, svc.OwnerReferences, 1)
+	require.Equal(t, gateway.UID, svc.OwnerReferences[0].UID)
+
+	t.Log("Verifying that the admin API is secured with provisioned certificates and registered to be configured")
+	adminTLS := &corev1.Secret{}
+	require.NoError(t, fakeClient.Get(ctx, adminNN, adminTLS))
+	for _, key := range []string{
+		corev1.TLSCertKey, corev1.TLSPrivateKeyKey, managedDataPlaneAdminTLSClientCertKey, managedDataPlaneAdminTLSClientKeyKey,
+	} {
+		require.NotEmpty(t, adminTLS.Data[key], "admin API TLS secret should contain %s", key)
+	}
+	require.Len(t, adminTLS.OwnerReferences, 1)
+	require.Equal(t, gateway.UID, adminTLS.OwnerReferences[0].UID)
+
+	container := deployment.Spec.Template.Spec.Containers[0]
+	envMap := lo.SliceToMap(container.Env, func(e corev1.EnvVar) (string, string) { return e.Name, e.Value })
+	require.Equal(t, "on", envMap["KONG_NGINX_ADMIN_SSL_VERIFY_CLIENT"])
+	require.Equal(t, "/etc/kong/admin-tls/client.crt", envMap["KONG_NGINX_ADMIN_SSL_CLIENT_CERTIFICATE"])
+	require.Len(t, deployment.Spec.Template.Spec.Volumes, 1)
+	require.Equal(t, adminNN.Name, deployment.Spec.Template.Spec.Volumes[0].Secret.SecretName)
+	require.NotContains(t,
+		lo.Map(deployment.Spec.Template.Spec.Volumes[0].Secret.Items, func(i corev1.KeyToPath, _ int) string { return i.Key }),
+		managedDataPlaneAdminTLSClientKeyKey, "client key should not be mounted in the data plane",
+	)
+	require.Equal(t, managedDataPlaneAdminTLSChecksum(adminTLS),
+		deployment.Spec.Template.Annotations[managedDataPlaneAdminTLSChecksumAnnotation])
+
+	adminSvc := &corev1.Service{}
+	require.NoError(t, fakeClient.Get(ctx, adminNN, adminSvc))
+	require.Equal(t, corev1.ClusterIPNone, adminSvc.Spec.ClusterIP)
+	require.True(t, adminSvc.Spec.PublishNotReadyAddresses)
+	require.Equal(t, map[string]string{ManagedGatewayLabel: "gateway-uid"}, adminSvc.Spec.Selector)
+	require.Len(t, adminSvc.Spec.Ports, 1)
+	require.Equal(t, ManagedDataPlaneAdminPortName, adminSvc.Spec.Ports[0].Name)
+
+	expectedAdminAPI := discoveredAdminAPI
+	expectedAdminAPI.TLS = adminapi.AdminAPITLS{
+		CACert:     string(adminTLS.Data[corev1.TLSCertKey]),
+		ServerName: "kong-gateway-admin.default.svc",
+		ClientCert: string(adminTLS.Data[managedDataPlaneAdminTLSClientCertKey]),
+		ClientKey:  string(adminTLS.Data[managedDataPlaneAdminTLSClientKeyKey]),
+	}
+	require.Equal(t, []adminapi.DiscoveredAdminAPI{expectedAdminAPI}, notifier.notifications[gatewayNN])
+
+	t.Log("Verifying that the admin API TLS certificates are not regenerated")
+	reconcileManagedGateway()
+	regeneratedAdminTLS := &corev1.Secret{}
+	require.NoError(t, fakeClient.Get(ctx, adminNN, regeneratedAdminTLS))
+	require.Equal(t, adminTLS.Data, regeneratedAdminTLS.Data)
+
+	t.Log("Verifying that gateway is programmed with addresses and listeners once the data plane is ready")
+	deployment.Status.ReadyReplicas = 1
+	require.NoError(t, fakeClient.Status().Update(ctx, deployment))
+	svc.Spec.ClusterIP = "10.0.0.1"
+	svc.Spec.ClusterIPs = []string{"10.0.0.1"}
+	require.NoError(t, fakeClient.Update(ctx, svc))
+	svc.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "192.0.2.1"}}
+	require.NoError(t, fakeClient.Status().Update(ctx, svc))
+
+	gw = reconcileManagedGateway()
+	require.True(t, isGatewayProgrammed(gw))
+	require.Equal(t, []gatewayapi.GatewayStatusAddress{
+		{Type: lo.ToPtr(gatewayapi.IPAddressType), Value: "192.0.2.1"},
+	}, gw.Status.Addresses)
+	require.Len(t, gw.Status.Listeners, 1)
+	require.True(t, util.CheckCondition(gw.Status.Listeners[0].Conditions,
+		util.ConditionType(gatewayapi.ListenerConditionProgrammed),
+		util.ConditionReason(gatewayapi.ListenerReasonProgrammed),
+		metav1.ConditionTrue, gw.Generation,
+	))
+
+	t.Log("Verifying that the admin APIs are forgotten once the gateway is gone")
+	r.forgetManagedGatewayAdminAPIs(gatewayNN)
+	require.Empty(t, notifier.notifications[gatewayNN])
+}
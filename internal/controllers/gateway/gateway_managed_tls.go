@@ -0,0 +1,117 @@
+package gateway
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+)
+
+const (
+	// managedDataPlaneAdminTLSClientCertKey is the key of the Admin API TLS Secret holding the client certificate
+	// the controller authenticates to the Admin API with. Being self-signed, it's also the only certificate
+	// the Admin API trusts.
+	managedDataPlaneAdminTLSClientCertKey = "client.crt"
+
+	// managedDataPlaneAdminTLSClientKeyKey is the key of the Admin API TLS Secret holding the client key.
+	managedDataPlaneAdminTLSClientKeyKey = "client.key"
+
+	// managedDataPlaneAdminTLSValidity is the validity period of the certificates generated for the Admin API.
+	// Certificates are generated again (and the data plane rolled out) when the Secret is deleted.
+	managedDataPlaneAdminTLSValidity = 10 * 365 * 24 * time.Hour
+)
+
+// configureManagedDataPlaneAdminTLSSecret generates self-signed server and client certificates securing
+// the Admin API of the Kong Gateway provisioned for the Gateway, unless the Secret already holds them.
+func configureManagedDataPlaneAdminTLSSecret(secret *corev1.Secret, gateway *gatewayapi.Gateway) error {
+	secret.Labels = lo.Assign(secret.Labels, managedDataPlaneLabels(gateway))
+	secret.Type = corev1.SecretTypeOpaque
+
+	keys := []string{
+		corev1.TLSCertKey, corev1.TLSPrivateKeyKey,
+		managedDataPlaneAdminTLSClientCertKey, managedDataPlaneAdminTLSClientKeyKey,
+	}
+	if lo.EveryBy(keys, func(k string) bool { return len(secret.Data[k]) > 0 }) {
+		return nil
+	}
+
+	serverName := managedDataPlaneAdminServerName(k8stypes.NamespacedName{Namespace: secret.Namespace, Name: secret.Name})
+	serverCert, serverKey, err := generateSelfSignedCertificate(serverName, x509.ExtKeyUsageServerAuth)
+	if err != nil {
+		return fmt.Errorf("failed to generate admin API server certificate: %w", err)
+	}
+	clientCert, clientKey, err := generateSelfSignedCertificate("kong-ingress-controller", x509.ExtKeyUsageClientAuth)
+	if err != nil {
+		return fmt.Errorf("failed to generate admin API client certificate: %w", err)
+	}
+	secret.Data = map[string][]byte{
+		corev1.TLSCertKey:                     serverCert,
+		corev1.TLSPrivateKeyKey:               serverKey,
+		managedDataPlaneAdminTLSClientCertKey: clientCert,
+		managedDataPlaneAdminTLSClientKeyKey:  clientKey,
+	}
+	return nil
+}
+
+// managedDataPlaneAdminServerName returns the name the Admin API's certificate is issued for. It's the DNS name
+// of the provisioned Admin API Service.
+func managedDataPlaneAdminServerName(adminSvc k8stypes.NamespacedName) string {
+	return fmt.Sprintf("%s.%s.svc", adminSvc.Name, adminSvc.Namespace)
+}
+
+// managedDataPlaneAdminTLSChecksum returns a checksum of the certificates the data plane's Admin API is configured with.
+func managedDataPlaneAdminTLSChecksum(secret *corev1.Secret) string {
+	h := sha256.New()
+	h.Write(secret.Data[corev1.TLSCertKey])
+	h.Write(secret.Data[managedDataPlaneAdminTLSClientCertKey])
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// generateSelfSignedCertificate generates a PEM-encoded self-signed certificate and its private key for
+// the given common name (also used as its DNS name) and usage.
+func generateSelfSignedCertificate(commonName string, usage x509.ExtKeyUsage) (cert []byte, key []byte, err error) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: commonName},
+		DNSNames:              []string{commonName},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(managedDataPlaneAdminTLSValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{usage},
+		BasicConstraintsValid: true,
+		// Self-signed certificates are trusted directly, so they have to be allowed to sign (themselves).
+		IsCA: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		nil
+}
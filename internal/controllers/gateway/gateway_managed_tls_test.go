@@ -0,0 +1,48 @@
+package gateway
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+)
+
+func TestConfigureManagedDataPlaneAdminTLSSecret(t *testing.T) {
+	gateway := &gatewayapi.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "default", UID: "gateway-uid"}}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "kong-gateway-admin", Namespace: "default"}}
+	require.NoError(t, configureManagedDataPlaneAdminTLSSecret(secret, gateway))
+	require.Equal(t, "gateway-uid", secret.Labels[ManagedGatewayLabel])
+
+	verify := func(certKey, keyKey string, opts x509.VerifyOptions) {
+		t.Helper()
+		_, err := tls.X509KeyPair(secret.Data[certKey], secret.Data[keyKey])
+		require.NoError(t, err)
+		block, _ := pem.Decode(secret.Data[certKey])
+		require.NotNil(t, block)
+		cert, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+		opts.Roots = x509.NewCertPool()
+		opts.Roots.AddCert(cert)
+		_, err = cert.Verify(opts)
+		require.NoError(t, err)
+	}
+	verify(corev1.TLSCertKey, corev1.TLSPrivateKeyKey, x509.VerifyOptions{
+		DNSName:   "kong-gateway-admin.default.svc",
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	verify(managedDataPlaneAdminTLSClientCertKey, managedDataPlaneAdminTLSClientKeyKey, x509.VerifyOptions{
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+
+	t.Run("existing certificates are kept", func(t *testing.T) {
+		data := secret.Data
+		require.NoError(t, configureManagedDataPlaneAdminTLSSecret(secret, gateway))
+		require.Equal(t, data, secret.Data)
+	})
+}
@@ -19,6 +19,7 @@ import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
@@ -141,6 +142,45 @@ func listSecretNamesReferredByGateway(gateway *gatewayapi.Gateway) map[k8stypes.
 				Name:      string(certRef.Name),
 			}] = struct{}{}
 		}
+
+		for nsName := range listFrontendValidationCACertificateRefs(gateway, listener, "Secret") {
+			nsNames[nsName] = struct{}{}
+		}
+	}
+	return nsNames
+}
+
+// list namespaced names of ConfigMaps referred by the gateway.
+func listConfigMapNamesReferredByGateway(gateway *gatewayapi.Gateway) map[k8stypes.NamespacedName]struct{} {
+	nsNames := make(map[k8stypes.NamespacedName]struct{})
+	for _, listener := range gateway.Spec.Listeners {
+		for nsName := range listFrontendValidationCACertificateRefs(gateway, listener, "ConfigMap") {
+			nsNames[nsName] = struct{}{}
+		}
+	}
+	return nsNames
+}
+
+// list namespaced names of objects of the given core kind referred by the listener's tls.frontendValidation.
+func listFrontendValidationCACertificateRefs(
+	gateway *gatewayapi.Gateway, listener gatewayapi.Listener, kind gatewayapi.Kind,
+) map[k8stypes.NamespacedName]struct{} {
+	nsNames := make(map[k8stypes.NamespacedName]struct{})
+	if listener.TLS == nil || listener.TLS.FrontendValidation == nil {
+		return nsNames
+	}
+	for _, caCertRef := range listener.TLS.FrontendValidation.CACertificateRefs {
+		if (caCertRef.Group != "" && caCertRef.Group != "core") || caCertRef.Kind != kind {
+			continue
+		}
+		refNamespace := gateway.Namespace
+		if caCertRef.Namespace != nil {
+			refNamespace = string(*caCertRef.Namespace)
+		}
+		nsNames[k8stypes.NamespacedName{
+			Namespace: refNamespace,
+			Name:      string(caCertRef.Name),
+		}] = struct{}{}
 	}
 	return nsNames
 }
@@ -239,6 +279,7 @@ func getListenerStatus(
 	kongProtocolsToPort := buildKongPortMap(kongListens)
 	conflictedPorts := make(map[gatewayapi.PortNumber]bool, len(gateway.Spec.Listeners))
 	conflictedHostnames := make(map[gatewayapi.PortNumber]map[gatewayapi.Hostname]bool, len(gateway.Spec.Listeners))
+	tlsModeConflictedListeners := getTLSModeConflictedListeners(gateway.Spec.Listeners)
 
 	// TODO we should check transition time rather than always nowing, which we do throughout the below
 	// https://github.com/Kong/kubernetes-ingress-controller/issues/2556
@@ -286,6 +327,19 @@ func getListenerStatus(
 					tlsResolvedRefReason = string(gatewayapi.ListenerReasonInvalidCertificateRef)
 				}
 			}
+			// Kong can terminate TLS at TLS listeners only with a certificate (it's not required for Passthrough).
+			if listener.Protocol == gatewayapi.TLSProtocolType &&
+				listenerTLSMode(listener) == gatewayapi.TLSModeTerminate &&
+				len(listener.TLS.CertificateRefs) == 0 {
+				tlsResolvedRefReason = string(gatewayapi.ListenerReasonInvalidCertificateRef)
+			}
+			if tlsResolvedRefReason == string(gatewayapi.ListenerReasonResolvedRefs) && listener.TLS.FrontendValidation != nil {
+				reason, err := getFrontendValidationResolvedRefsReason(ctx, client, gateway, listener.TLS.FrontendValidation, referenceGrants)
+				if err != nil {
+					return nil, err
+				}
+				tlsResolvedRefReason = reason
+			}
 			if gatewayapi.ListenerConditionReason(tlsResolvedRefReason) != gatewayapi.ListenerReasonResolvedRefs {
 				ResolvedRefsReason = gatewayapi.ListenerConditionReason(tlsResolvedRefReason)
 			}
@@ -358,6 +412,19 @@ func getListenerStatus(
 			}
 		}
 
+		if tlsModeConflictedListeners[listener.Name] && !lo.ContainsBy(status.Conditions, func(c metav1.Condition) bool {
+			return c.Type == string(gatewayapi.ListenerConditionConflicted)
+		}) {
+			status.Conditions = append(status.Conditions, metav1.Condition{
+				Type:               string(gatewayapi.ListenerConditionConflicted),
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: gateway.Generation,
+				LastTransitionTime: metav1.Now(),
+				Reason:             string(gatewayapi.ListenerReasonHostnameConflict),
+				Message:            "TLS listeners with the same hostname must use the same TLS mode",
+			})
+		}
+
 		// independent of conflict detection. for example, two TCP Listeners both requesting the same port that Kong
 		// does not provide should be both Conflicted and Detached
 		if len(kongProtocolsToPort[listener.Protocol]) == 0 {
@@ -523,7 +590,100 @@ func getListenerStatus(
 	return statusArray, nil
 }
 
+// getTLSModeConflictedListeners returns names of TLS listeners sharing a hostname with a TLS listener using
+// a different TLS mode. Kong routes TLS streams by SNI on all its TLS stream listens, so it can't pass through
+// connections for a hostname and terminate others for the same hostname, regardless of the listeners' ports.
+func getTLSModeConflictedListeners(listeners []gatewayapi.Listener) map[gatewayapi.SectionName]bool {
+	conflicted := make(map[gatewayapi.SectionName]bool)
+	for i, a := range listeners {
+		if a.Protocol != gatewayapi.TLSProtocolType {
+			continue
+		}
+		for _, b := range listeners[i+1:] {
+			if b.Protocol != gatewayapi.TLSProtocolType || listenerTLSMode(a) == listenerTLSMode(b) {
+				continue
+			}
+			if lo.FromPtr(a.Hostname) == lo.FromPtr(b.Hostname) {
+				conflicted[a.Name] = true
+				conflicted[b.Name] = true
+			}
+		}
+	}
+	return conflicted
+}
+
+// listenerTLSMode returns the TLS mode of the listener, defaulting to Terminate as the Gateway API does.
+func listenerTLSMode(listener gatewayapi.Listener) gatewayapi.TLSModeType {
+	if listener.TLS == nil || listener.TLS.Mode == nil {
+		return gatewayapi.TLSModeTerminate
+	}
+	return *listener.TLS.Mode
+}
+
+// getFrontendValidationResolvedRefsReason returns the ResolvedRefs condition reason for the CA certificates
+// referenced by a listener's tls.frontendValidation. The references are resolved if they point to existing ConfigMaps
+// or Secrets (in the Gateway's namespace or granted by a ReferenceGrant) holding a PEM encoded certificate in the
+// translator.GatewayListenerCACertificateKey key.
+func getFrontendValidationResolvedRefsReason(
+	ctx context.Context,
+	cl client.Client,
+	gateway *gatewayapi.Gateway,
+	frontendValidation *gatewayapi.FrontendTLSValidation,
+	referenceGrants []gatewayapi.ReferenceGrant,
+) (string, error) {
+	if len(frontendValidation.CACertificateRefs) == 0 {
+		return string(gatewayapi.ListenerReasonInvalidCertificateRef), nil
+	}
+	for _, caCertRef := range frontendValidation.CACertificateRefs {
+		if (caCertRef.Group != "" && caCertRef.Group != "core") ||
+			(caCertRef.Kind != "ConfigMap" && caCertRef.Kind != "Secret") {
+			return string(gatewayapi.ListenerReasonInvalidCertificateRef), nil
+		}
+
+		namespace := gateway.Namespace
+		if caCertRef.Namespace != nil {
+			namespace = string(*caCertRef.Namespace)
+		}
+		if reason := getReferenceGrantConditionReason(gateway.Namespace, gatewayapi.SecretObjectReference{
+			Group:     lo.ToPtr(caCertRef.Group),
+			Kind:      lo.ToPtr(caCertRef.Kind),
+			Name:      caCertRef.Name,
+			Namespace: caCertRef.Namespace,
+		}, referenceGrants); reason != string(gatewayapi.ListenerReasonResolvedRefs) {
+			return reason, nil
+		}
+
+		nn := k8stypes.NamespacedName{Namespace: namespace, Name: string(caCertRef.Name)}
+		var data []byte
+		switch caCertRef.Kind {
+		case "ConfigMap":
+			configMap := &corev1.ConfigMap{}
+			if err := cl.Get(ctx, nn, configMap); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return "", err
+				}
+				return string(gatewayapi.ListenerReasonInvalidCertificateRef), nil
+			}
+			data = []byte(configMap.Data[translator.GatewayListenerCACertificateKey])
+		case "Secret":
+			secret := &corev1.Secret{}
+			if err := cl.Get(ctx, nn, secret); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return "", err
+				}
+				return string(gatewayapi.ListenerReasonInvalidCertificateRef), nil
+			}
+			data = secret.Data[translator.GatewayListenerCACertificateKey]
+		}
+		if p, _ := pem.Decode(data); p == nil {
+			return string(gatewayapi.ListenerReasonInvalidCertificateRef), nil
+		}
+	}
+	return string(gatewayapi.ListenerReasonResolvedRefs), nil
+}
+
 // getReferenceGrantConditionReason gets a certRef belonging to a specific listener and a slice of referenceGrants.
+// The kind of the referenced object defaults to Secret.
 func getReferenceGrantConditionReason(
 	gatewayNamespace string,
 	certRef gatewayapi.SecretObjectReference,
@@ -535,22 +695,26 @@ func getReferenceGrantConditionReason(
 	}
 
 	certRefNamespace := string(*certRef.Namespace)
+	certRefKind := gatewayapi.Kind("Secret")
+	if certRef.Kind != nil {
+		certRefKind = *certRef.Kind
+	}
 	for _, grant := range referenceGrants {
 		// the grant must exist in the same namespace of the referenced resource
 		if grant.Namespace != certRefNamespace {
 			continue
 		}
 		for _, from := range grant.Spec.From {
-			// we are interested only in grants for gateways that want to reference secrets
+			// we are interested only in grants for gateways that want to reference the kind of the referenced object
 			if from.Group != gatewayapi.V1Group || from.Kind != "Gateway" {
 				continue
 			}
 			if from.Namespace == gatewayapi.Namespace(gatewayNamespace) {
 				for _, to := range grant.Spec.To {
-					if (to.Group != "" && to.Group != "core") || to.Kind != "Secret" {
+					if (to.Group != "" && to.Group != "core") || to.Kind != certRefKind {
 						continue
 					}
-					// if all the above conditions are satisfied, and the name of the referenced secret matches
+					// if all the above conditions are satisfied, and the name of the referenced object matches
 					// the granted resource name, then return a reason "ResolvedRefs"
 					if to.Name == nil || string(*to.Name) == string(certRef.Name) {
 						return string(gatewayapi.ListenerReasonResolvedRefs)
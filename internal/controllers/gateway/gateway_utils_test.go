@@ -7,6 +7,7 @@ import (
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8stypes "k8s.io/apimachinery/pkg/types"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+	"github.com/kong/kubernetes-ingress-controller/v3/test/helpers/certificate"
 )
 
 func TestGetListenerSupportedRouteKinds(t *testing.T) {
@@ -281,6 +283,258 @@ func TestGetListenerStatus(t *testing.T) {
 	}
 }
 
+func TestGetListenerStatus_FrontendValidation(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, gatewayapi.InstallV1(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	caCert, _ := certificate.MustGenerateSelfSignedCertPEMFormat(certificate.WithCATrue())
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ca"},
+			Data:       map[string]string{"ca.crt": string(caCert)},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "ca"},
+			Data:       map[string]string{"ca.crt": string(caCert)},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "no-ca"},
+			Data:       map[string]string{"other": "value"},
+		},
+	).Build()
+	kongListens := []gatewayapi.Listener{{Port: 443, Protocol: gatewayapi.HTTPSProtocolType}}
+	newGateway := func(caCertificateRef gatewayapi.ObjectReference) *gatewayapi.Gateway {
+		return &gatewayapi.Gateway{
+			TypeMeta: gatewayapi.V1GatewayTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "gateway",
+			},
+			Spec: gatewayapi.GatewaySpec{
+				GatewayClassName: "kong",
+				Listeners: []gatewayapi.Listener{
+					{
+						Name:     "https",
+						Port:     443,
+						Protocol: gatewayapi.HTTPSProtocolType,
+						TLS: &gatewayapi.GatewayTLSConfig{
+							FrontendValidation: &gatewayapi.FrontendTLSValidation{
+								CACertificateRefs: []gatewayapi.ObjectReference{caCertificateRef},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	configMapGrant := gatewayapi.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "grant"},
+		Spec: gatewayapi.ReferenceGrantSpec{
+			From: []gatewayapi.ReferenceGrantFrom{{Group: gatewayapi.V1Group, Kind: "Gateway", Namespace: "default"}},
+			To:   []gatewayapi.ReferenceGrantTo{{Group: "", Kind: "ConfigMap"}},
+		},
+	}
+	otherNamespace := lo.ToPtr(gatewayapi.Namespace("other"))
+
+	testCases := []struct {
+		name             string
+		caCertificateRef gatewayapi.ObjectReference
+		referenceGrants  []gatewayapi.ReferenceGrant
+		expectedReason   gatewayapi.ListenerConditionReason
+	}{
+		{
+			name:             "ConfigMap in the Gateway's namespace",
+			caCertificateRef: gatewayapi.ObjectReference{Kind: "ConfigMap", Name: "ca"},
+			expectedReason:   gatewayapi.ListenerReasonResolvedRefs,
+		},
+		{
+			name:             "missing ConfigMap",
+			caCertificateRef: gatewayapi.ObjectReference{Kind: "ConfigMap", Name: "missing"},
+			expectedReason:   gatewayapi.ListenerReasonInvalidCertificateRef,
+		},
+		{
+			name:             "ConfigMap without a CA certificate",
+			caCertificateRef: gatewayapi.ObjectReference{Kind: "ConfigMap", Name: "no-ca"},
+			expectedReason:   gatewayapi.ListenerReasonInvalidCertificateRef,
+		},
+		{
+			name:             "unsupported kind",
+			caCertificateRef: gatewayapi.ObjectReference{Group: "example.com", Kind: "Bundle", Name: "ca"},
+			expectedReason:   gatewayapi.ListenerReasonInvalidCertificateRef,
+		},
+		{
+			name:             "ConfigMap in another namespace without a ReferenceGrant",
+			caCertificateRef: gatewayapi.ObjectReference{Kind: "ConfigMap", Name: "ca", Namespace: otherNamespace},
+			expectedReason:   gatewayapi.ListenerReasonRefNotPermitted,
+		},
+		{
+			name:             "ConfigMap in another namespace with a ReferenceGrant",
+			caCertificateRef: gatewayapi.ObjectReference{Kind: "ConfigMap", Name: "ca", Namespace: otherNamespace},
+			referenceGrants:  []gatewayapi.ReferenceGrant{configMapGrant},
+			expectedReason:   gatewayapi.ListenerReasonResolvedRefs,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			statuses, err := getListenerStatus(ctx, newGateway(tc.caCertificateRef), kongListens, tc.referenceGrants, client)
+			require.NoError(t, err)
+			require.Len(t, statuses, 1)
+			assertOnlyOneConditionForType(t, statuses[0].Conditions)
+
+			resolvedRefs, ok := lo.Find(statuses[0].Conditions, func(c metav1.Condition) bool {
+				return c.Type == string(gatewayapi.ListenerConditionResolvedRefs)
+			})
+			require.True(t, ok, "ResolvedRefs condition should be set")
+			assert.Equal(t, string(tc.expectedReason), resolvedRefs.Reason)
+
+			programmed, ok := lo.Find(statuses[0].Conditions, func(c metav1.Condition) bool {
+				return c.Type == string(gatewayapi.ListenerConditionProgrammed)
+			})
+			require.True(t, ok, "Programmed condition should be set")
+			if tc.expectedReason == gatewayapi.ListenerReasonResolvedRefs {
+				assert.Equal(t, metav1.ConditionTrue, resolvedRefs.Status)
+				assert.Equal(t, metav1.ConditionTrue, programmed.Status)
+			} else {
+				assert.Equal(t, metav1.ConditionFalse, resolvedRefs.Status)
+				assert.Equal(t, metav1.ConditionFalse, programmed.Status)
+			}
+		})
+	}
+}
+
+func TestGetListenerStatus_TLSModes(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, gatewayapi.InstallV1(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	cert, key := certificate.MustGenerateSelfSignedCertPEMFormat()
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cert"},
+			Data:       map[string][]byte{"tls.crt": cert, "tls.key": key},
+		},
+	).Build()
+	kongListens := []gatewayapi.Listener{
+		{Port: 443, Protocol: gatewayapi.TLSProtocolType},
+		{Port: 8443, Protocol: gatewayapi.TLSProtocolType},
+	}
+	passthrough := &gatewayapi.GatewayTLSConfig{Mode: lo.ToPtr(gatewayapi.TLSModePassthrough)}
+	terminate := &gatewayapi.GatewayTLSConfig{
+		Mode:            lo.ToPtr(gatewayapi.TLSModeTerminate),
+		CertificateRefs: []gatewayapi.SecretObjectReference{{Name: "cert"}},
+	}
+	newListener := func(name string, port gatewayapi.PortNumber, hostname string, tls *gatewayapi.GatewayTLSConfig) gatewayapi.Listener {
+		return gatewayapi.Listener{
+			Name:     gatewayapi.SectionName(name),
+			Port:     port,
+			Protocol: gatewayapi.TLSProtocolType,
+			Hostname: lo.ToPtr(gatewayapi.Hostname(hostname)),
+			TLS:      tls,
+		}
+	}
+
+	testCases := []struct {
+		name                string
+		listeners           []gatewayapi.Listener
+		expectedProgrammed  map[gatewayapi.SectionName]bool
+		expectedConditionOf map[gatewayapi.SectionName]metav1.Condition
+	}{
+		{
+			name: "passthrough and terminate listeners with different hostnames",
+			listeners: []gatewayapi.Listener{
+				newListener("passthrough", 443, "passthrough.example.com", passthrough),
+				newListener("terminate", 8443, "terminate.example.com", terminate),
+			},
+			expectedProgrammed: map[gatewayapi.SectionName]bool{"passthrough": true, "terminate": true},
+		},
+		{
+			name: "terminate listener without a certificate",
+			listeners: []gatewayapi.Listener{
+				newListener("terminate", 443, "terminate.example.com", &gatewayapi.GatewayTLSConfig{
+					Mode: lo.ToPtr(gatewayapi.TLSModeTerminate),
+				}),
+			},
+			expectedProgrammed: map[gatewayapi.SectionName]bool{"terminate": false},
+			expectedConditionOf: map[gatewayapi.SectionName]metav1.Condition{
+				"terminate": {
+					Type:   string(gatewayapi.ListenerConditionResolvedRefs),
+					Status: metav1.ConditionFalse,
+					Reason: string(gatewayapi.ListenerReasonInvalidCertificateRef),
+				},
+			},
+		},
+		{
+			name: "passthrough and terminate listeners on different ports with the same hostname",
+			listeners: []gatewayapi.Listener{
+				newListener("passthrough", 443, "example.com", passthrough),
+				newListener("terminate", 8443, "example.com", terminate),
+			},
+			expectedProgrammed: map[gatewayapi.SectionName]bool{"passthrough": false, "terminate": false},
+			expectedConditionOf: map[gatewayapi.SectionName]metav1.Condition{
+				"passthrough": {
+					Type:   string(gatewayapi.ListenerConditionConflicted),
+					Status: metav1.ConditionTrue,
+					Reason: string(gatewayapi.ListenerReasonHostnameConflict),
+				},
+				"terminate": {
+					Type:   string(gatewayapi.ListenerConditionConflicted),
+					Status: metav1.ConditionTrue,
+					Reason: string(gatewayapi.ListenerReasonHostnameConflict),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &gatewayapi.Gateway{
+				TypeMeta: gatewayapi.V1GatewayTypeMeta,
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "gateway",
+				},
+				Spec: gatewayapi.GatewaySpec{
+					GatewayClassName: "kong",
+					Listeners:        tc.listeners,
+				},
+			}
+			statuses, err := getListenerStatus(ctx, gateway, kongListens, nil, client)
+			require.NoError(t, err)
+			require.Len(t, statuses, len(tc.listeners))
+
+			for _, status := range statuses {
+				assertOnlyOneConditionForType(t, status.Conditions)
+				programmed, ok := lo.Find(status.Conditions, func(c metav1.Condition) bool {
+					return c.Type == string(gatewayapi.ListenerConditionProgrammed)
+				})
+				require.Truef(t, ok, "listener %s should have the Programmed condition", status.Name)
+				expectedProgrammed := metav1.ConditionFalse
+				if tc.expectedProgrammed[status.Name] {
+					expectedProgrammed = metav1.ConditionTrue
+				}
+				assert.Equalf(t, expectedProgrammed, programmed.Status, "listener %s Programmed condition", status.Name)
+
+				expectedCondition, ok := tc.expectedConditionOf[status.Name]
+				if !ok {
+					continue
+				}
+				assert.Truef(t,
+					lo.ContainsBy(status.Conditions, func(c metav1.Condition) bool {
+						return c.Type == expectedCondition.Type && c.Status == expectedCondition.Status &&
+							c.Reason == expectedCondition.Reason
+					}),
+					"listener %s should have condition %s=%s with reason %s: found conditions:\n%#v",
+					status.Name, expectedCondition.Type, expectedCondition.Status, expectedCondition.Reason, status.Conditions,
+				)
+			}
+		})
+	}
+}
+
 func assertOnlyOneConditionForType(t *testing.T, conditions []metav1.Condition) {
 	conditionsNum := lo.CountValuesBy(conditions, func(c metav1.Condition) string {
 		return c.Type
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -58,6 +59,9 @@ type GatewayClassReconciler struct { //nolint:revive
 	Log              logr.Logger
 	Scheme           *runtime.Scheme
 	CacheSyncTimeout time.Duration
+
+	// SupportedFeatures are the Gateway API features published in the status of managed GatewayClasses.
+	SupportedFeatures []gatewayapi.SupportedFeature
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -123,6 +127,7 @@ func (r *GatewayClassReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	log.V(util.DebugLevel).Info("Processing gatewayclass", "name", req.Name)
 
 	if isGatewayClassControlled(gwc) {
+		statusChanged := false
 		alreadyAccepted := util.CheckCondition(
 			gwc.Status.Conditions,
 			util.ConditionType(gatewayapi.GatewayClassConditionStatusAccepted),
@@ -141,6 +146,17 @@ func (r *GatewayClassReconciler) Reconcile(ctx context.Context, req ctrl.Request
 				Message:            "the gatewayclass has been accepted by the controller",
 			}
 			setGatewayClassCondition(gwc, acceptedCondtion)
+			statusChanged = true
+		}
+
+		// Supported features depend on the controller's configuration (feature gates, router flavor, enabled
+		// controllers), so they are brought in sync whenever it changes between controller restarts.
+		if !slices.Equal(gwc.Status.SupportedFeatures, r.SupportedFeatures) {
+			gwc.Status.SupportedFeatures = r.SupportedFeatures
+			statusChanged = true
+		}
+
+		if statusChanged {
 			return ctrl.Result{}, r.Status().Update(ctx, pruneGatewayClassStatusConds(gwc))
 		}
 	}
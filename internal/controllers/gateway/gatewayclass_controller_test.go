@@ -1,12 +1,20 @@
 package gateway
 
 import (
+	"context"
 	"testing"
 
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/scheme"
 )
 
 func TestSetGatewayClassCondtion(t *testing.T) {
@@ -112,3 +120,47 @@ func TestSetGatewayClassCondtion(t *testing.T) {
 		})
 	}
 }
+
+func TestGatewayClassReconciler_SupportedFeatures(t *testing.T) {
+	gwc := &gatewayapi.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "kong",
+			Generation: 1,
+		},
+		Spec: gatewayapi.GatewayClassSpec{
+			ControllerName: GetControllerName(),
+		},
+		Status: gatewayapi.GatewayClassStatus{
+			SupportedFeatures: []gatewayapi.SupportedFeature{"Gateway", "TLSRoute"},
+		},
+	}
+	client := fakectrlruntimeclient.NewClientBuilder().
+		WithScheme(lo.Must(scheme.Get())).
+		WithObjects(gwc).
+		WithStatusSubresource(gwc).
+		Build()
+
+	reconcile := func(supportedFeatures ...gatewayapi.SupportedFeature) *gatewayapi.GatewayClass {
+		t.Helper()
+		r := &GatewayClassReconciler{
+			Client:            client,
+			Log:               logr.Discard(),
+			SupportedFeatures: supportedFeatures,
+		}
+		_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: k8stypes.NamespacedName{Name: gwc.Name}})
+		require.NoError(t, err)
+		updated := &gatewayapi.GatewayClass{}
+		require.NoError(t, client.Get(context.Background(), k8stypes.NamespacedName{Name: gwc.Name}, updated))
+		return updated
+	}
+
+	t.Log("Reconciling GatewayClass should accept it and publish supported features")
+	updated := reconcile("Gateway", "HTTPRoute")
+	require.Equal(t, []gatewayapi.SupportedFeature{"Gateway", "HTTPRoute"}, updated.Status.SupportedFeatures)
+	require.Len(t, updated.Status.Conditions, 1)
+	require.Equal(t, string(gatewayapi.GatewayClassConditionStatusAccepted), updated.Status.Conditions[0].Type)
+
+	t.Log("Reconciling GatewayClass with changed controller configuration should update supported features")
+	updated = reconcile("Gateway", "HTTPRoute", "HTTPRouteQueryParamMatching")
+	require.Equal(t, []gatewayapi.SupportedFeature{"Gateway", "HTTPRoute", "HTTPRouteQueryParamMatching"}, updated.Status.SupportedFeatures)
+}
@@ -492,6 +492,10 @@ func (r *GRPCRouteReconciler) ensureGatewayReferenceStatusAdded(ctx context.Cont
 		statusChangesWereMade = true
 	}
 
+	frontendValidationConflictedChanged := setRouteConditionFrontendValidationConflicted(grpcroute.Generation, parentStatuses,
+		getListenersWithoutFrontendValidation(grpcroute.Namespace, grpcroute.Spec.ParentRefs, gateways),
+	)
+
 	// initialize "programmed" condition to Unknown.
 	// do not update the condition If a "Programmed" condition is already present.
 	programmedConditionChanged := false
@@ -510,7 +514,7 @@ func (r *GRPCRouteReconciler) ensureGatewayReferenceStatusAdded(ctx context.Cont
 	}
 
 	// if we didn't have to actually make any changes, no status update is needed
-	if !statusChangesWereMade && !programmedConditionChanged {
+	if !statusChangesWereMade && !frontendValidationConflictedChanged && !programmedConditionChanged {
 		return false, nil
 	}
 
@@ -7,12 +7,14 @@ import (
 	"reflect"
 	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -29,12 +31,16 @@ import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers"
 	ctrlutils "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/utils"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	k8sobj "github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object/status"
+	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 // -----------------------------------------------------------------------------
@@ -60,6 +66,14 @@ type HTTPRouteReconciler struct {
 	// If GatewayNN is set,
 	// only resources managed by the specified Gateway are reconciled.
 	GatewayNN controllers.OptionalNamespacedName
+
+	// KongServiceFacadeEnabled determines whether KongServiceFacades are accepted as HTTPRoute's backendRefs.
+	KongServiceFacadeEnabled bool
+	// KongUpstreamTargetEnabled determines whether KongUpstreamTargets are accepted as HTTPRoute's backendRefs.
+	KongUpstreamTargetEnabled bool
+	// KongUpstreamPolicyEnabled determines whether KongUpstreamPolicies of HTTPRoute's backends are checked for
+	// conflicts with sessionPersistence of its rules.
+	KongUpstreamPolicyEnabled bool
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -112,6 +126,27 @@ func (r *HTTPRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		)
 	}
 
+	// if a KongServiceFacade or a KongUpstreamTarget used as a backendRef changes, we need to enqueue
+	// the HTTPRoutes referencing it to update their ResolvedRefs condition.
+	if r.KongServiceFacadeEnabled {
+		blder.Watches(&incubatorv1alpha1.KongServiceFacade{},
+			handler.EnqueueRequestsFromMapFunc(r.listHTTPRoutesForBackend),
+		)
+	}
+	if r.KongUpstreamTargetEnabled {
+		blder.Watches(&incubatorv1alpha1.KongUpstreamTarget{},
+			handler.EnqueueRequestsFromMapFunc(r.listHTTPRoutesForBackend),
+		)
+	}
+
+	// if a KongUpstreamPolicy changes, we need to enqueue the HTTPRoutes using sessionPersistence with backends
+	// in its namespace to update their SessionPersistenceConflicted condition.
+	if r.KongUpstreamPolicyEnabled {
+		blder.Watches(&kongv1beta1.KongUpstreamPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.listHTTPRoutesForKongUpstreamPolicy),
+		)
+	}
+
 	if r.StatusQueue != nil {
 		blder.WatchesRawSource(
 			source.Channel(
@@ -202,6 +237,92 @@ func referenceGrantHasHTTPRouteFrom(obj client.Object) bool {
 	return false
 }
 
+// listHTTPRoutesForBackend is a watch predicate which finds all HTTPRoutes
+// referencing the given backend object (KongServiceFacade or KongUpstreamTarget) in their backendRefs.
+func (r *HTTPRouteReconciler) listHTTPRoutesForBackend(ctx context.Context, obj client.Object) []reconcile.Request {
+	var kind gatewayapi.Kind
+	switch obj.(type) {
+	case *incubatorv1alpha1.KongServiceFacade:
+		kind = incubatorv1alpha1.KongServiceFacadeKind
+	case *incubatorv1alpha1.KongUpstreamTarget:
+		kind = incubatorv1alpha1.KongUpstreamTargetKind
+	default:
+		r.Log.Error(
+			fmt.Errorf("unexpected object type"),
+			"Backend watch predicate received unexpected object type",
+			"expected", "*incubatorv1alpha1.KongServiceFacade or *incubatorv1alpha1.KongUpstreamTarget", "found", reflect.TypeOf(obj),
+		)
+		return nil
+	}
+
+	httproutes := &gatewayapi.HTTPRouteList{}
+	if err := r.Client.List(ctx, httproutes); err != nil {
+		r.Log.Error(err, "Failed to list httproutes in watch", "kind", kind, "namespace", obj.GetNamespace(), "name", obj.GetName())
+		return nil
+	}
+	var recs []reconcile.Request
+	for _, httproute := range httproutes.Items {
+		if httpRouteReferencesBackend(httproute, kind, obj) {
+			recs = append(recs, reconcile.Request{
+				NamespacedName: k8stypes.NamespacedName{
+					Namespace: httproute.Namespace,
+					Name:      httproute.Name,
+				},
+			})
+		}
+	}
+	return recs
+}
+
+// httpRouteReferencesBackend returns true if any of the HTTPRoute's backendRefs refers to the given
+// incubator.ingress-controller.konghq.com backend object of the given kind.
+// listHTTPRoutesForKongUpstreamPolicy is a watch predicate which finds all HTTPRoutes with rules using
+// sessionPersistence and backends in the KongUpstreamPolicy's namespace.
+func (r *HTTPRouteReconciler) listHTTPRoutesForKongUpstreamPolicy(ctx context.Context, obj client.Object) []reconcile.Request {
+	httproutes := &gatewayapi.HTTPRouteList{}
+	if err := r.Client.List(ctx, httproutes); err != nil {
+		r.Log.Error(err, "Failed to list HTTPRoutes in watch", "kongupstreampolicy", client.ObjectKeyFromObject(obj))
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, httproute := range httproutes.Items {
+		if lo.ContainsBy(httproute.Spec.Rules, func(rule gatewayapi.HTTPRouteRule) bool {
+			return rule.SessionPersistence != nil && lo.ContainsBy(rule.BackendRefs, func(ref gatewayapi.HTTPBackendRef) bool {
+				return backendRefNamespace(httproute.Namespace, ref.BackendRef) == obj.GetNamespace()
+			})
+		}) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&httproute)})
+		}
+	}
+	return requests
+}
+
+// backendRefNamespace returns the namespace of the backend referenced from a route in the given namespace.
+func backendRefNamespace(routeNamespace string, ref gatewayapi.BackendRef) string {
+	if ref.Namespace != nil && *ref.Namespace != "" {
+		return string(*ref.Namespace)
+	}
+	return routeNamespace
+}
+
+func httpRouteReferencesBackend(httproute gatewayapi.HTTPRoute, kind gatewayapi.Kind, backend client.Object) bool {
+	for _, rule := range httproute.Spec.Rules {
+		for _, backendRef := range httpRouteRuleBackendRefs(rule) {
+			backendNamespace := httproute.Namespace
+			if backendRef.Namespace != nil && *backendRef.Namespace != "" {
+				backendNamespace = string(*backendRef.Namespace)
+			}
+			if backendRef.Group != nil && *backendRef.Group == gatewayapi.Group(incubatorv1alpha1.GroupVersion.Group) &&
+				backendRef.Kind != nil && *backendRef.Kind == kind &&
+				backendNamespace == backend.GetNamespace() && string(backendRef.Name) == backend.GetName() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // listHTTPRoutesForGatewayClass is a controller-runtime event.Handler which
 // produces a list of HTTPRoutes which were bound to a Gateway which is or was
 // bound to this GatewayClass. This implementation effectively does a map-reduce
@@ -586,6 +707,15 @@ func (r *HTTPRouteReconciler) ensureGatewayReferenceStatusAdded(ctx context.Cont
 		return false, err
 	}
 
+	sessionPersistenceConflictedChanged, err := r.setRouteConditionSessionPersistenceConflicted(ctx, httproute, parentStatuses)
+	if err != nil {
+		return false, err
+	}
+
+	frontendValidationConflictedChanged := setRouteConditionFrontendValidationConflicted(httproute.Generation, parentStatuses,
+		getListenersWithoutFrontendValidation(httproute.Namespace, httproute.Spec.ParentRefs, gateways),
+	)
+
 	// initialize "programmed" condition to Unknown.
 	// do not update the condition If a "Programmed" condition is already present.
 	programmedConditionChanged := false
@@ -604,7 +734,8 @@ func (r *HTTPRouteReconciler) ensureGatewayReferenceStatusAdded(ctx context.Cont
 	}
 
 	// if we didn't have to actually make any changes, no status update is needed
-	if !statusChangesWereMade && !resolvedRefsChanged && !programmedConditionChanged {
+	if !statusChangesWereMade && !resolvedRefsChanged && !sessionPersistenceConflictedChanged &&
+		!frontendValidationConflictedChanged && !programmedConditionChanged {
 		return false, nil
 	}
 
@@ -670,9 +801,98 @@ func (r *HTTPRouteReconciler) setRouteConditionResolvedRefsCondition(
 	return parentStatuses, changed, nil
 }
 
-func (r *HTTPRouteReconciler) getHTTPRouteRuleReason(ctx context.Context, httpRoute gatewayapi.HTTPRoute) (gatewayapi.RouteConditionReason, error) {
+// setRouteConditionSessionPersistenceConflicted sets a condition of type SessionPersistenceConflicted on the route
+// status when sessionPersistence of its rules conflicts with hashing configured by KongUpstreamPolicies of their
+// backends, and removes it otherwise.
+func (r *HTTPRouteReconciler) setRouteConditionSessionPersistenceConflicted(
+	ctx context.Context,
+	httpRoute *gatewayapi.HTTPRoute,
+	parentStatuses map[string]*gatewayapi.RouteParentStatus,
+) (bool, error) {
+	conflictingPolicies, err := r.getSessionPersistenceConflictingPolicies(ctx, *httpRoute)
+	if err != nil {
+		return false, err
+	}
+
+	var changed bool
+	for _, parentStatus := range parentStatuses {
+		if len(conflictingPolicies) == 0 {
+			if meta.RemoveStatusCondition(&parentStatus.Conditions, ConditionTypeSessionPersistenceConflicted) {
+				changed = true
+			}
+			continue
+		}
+		if meta.SetStatusCondition(&parentStatus.Conditions, metav1.Condition{
+			Type:               ConditionTypeSessionPersistenceConflicted,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: httpRoute.Generation,
+			Reason:             string(ConditionReasonKongUpstreamPolicyConfiguresHashing),
+			Message: fmt.Sprintf("sessionPersistence conflicts with hashing configured by KongUpstreamPolicies "+
+				"which take precedence: %s", strings.Join(conflictingPolicies, ", ")),
+		}) {
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+// getSessionPersistenceConflictingPolicies returns sorted keys of KongUpstreamPolicies of backends of the route's
+// rules using sessionPersistence that configure hashing.
+func (r *HTTPRouteReconciler) getSessionPersistenceConflictingPolicies(
+	ctx context.Context, httpRoute gatewayapi.HTTPRoute,
+) ([]string, error) {
+	if !r.KongUpstreamPolicyEnabled {
+		return nil, nil
+	}
+
+	var conflictingPolicies []string
 	for _, rule := range httpRoute.Spec.Rules {
+		if rule.SessionPersistence == nil {
+			continue
+		}
 		for _, backendRef := range rule.BackendRefs {
+			if !util.IsBackendRefGroupKindSupported(backendRef.Group, backendRef.Kind) {
+				continue
+			}
+			backend, ok := r.newBackendRefObject(backendRef)
+			if !ok {
+				continue
+			}
+			// KongUpstreamTargets are translated into upstreams of their own, not subject to KongUpstreamPolicies.
+			if _, isTarget := backend.(*incubatorv1alpha1.KongUpstreamTarget); isTarget {
+				continue
+			}
+			backendNamespace := backendRefNamespace(httpRoute.Namespace, backendRef.BackendRef)
+			if err := r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: backendNamespace, Name: string(backendRef.Name)}, backend); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, err
+			}
+			policyName, ok := annotations.ExtractUpstreamPolicy(backend.GetAnnotations())
+			if !ok {
+				continue
+			}
+			policy := &kongv1beta1.KongUpstreamPolicy{}
+			if err := r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: backendNamespace, Name: policyName}, policy); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, err
+			}
+			if kongstate.KongUpstreamPolicyConfiguresHashing(policy.Spec) {
+				conflictingPolicies = append(conflictingPolicies, backendNamespace+"/"+policyName)
+			}
+		}
+	}
+	conflictingPolicies = lo.Uniq(conflictingPolicies)
+	slices.Sort(conflictingPolicies)
+	return conflictingPolicies, nil
+}
+
+func (r *HTTPRouteReconciler) getHTTPRouteRuleReason(ctx context.Context, httpRoute gatewayapi.HTTPRoute) (gatewayapi.RouteConditionReason, error) {
+	for _, rule := range httpRoute.Spec.Rules {
+		for _, backendRef := range httpRouteRuleBackendRefs(rule) {
 			backendNamespace := httpRoute.Namespace
 			if backendRef.Namespace != nil && *backendRef.Namespace != "" {
 				backendNamespace = string(*backendRef.Namespace)
@@ -683,10 +903,14 @@ func (r *HTTPRouteReconciler) getHTTPRouteRuleReason(ctx context.Context, httpRo
 				return gatewayapi.RouteReasonInvalidKind, nil
 			}
 
+			// Check if the BackendRef kind is enabled
+			backend, ok := r.newBackendRefObject(backendRef)
+			if !ok {
+				return gatewayapi.RouteReasonInvalidKind, nil
+			}
+
 			// Check if all the objects referenced actually exist
-			// Only services are currently supported as BackendRef objects
-			service := &corev1.Service{}
-			err := r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: backendNamespace, Name: string(backendRef.Name)}, service)
+			err := r.Client.Get(ctx, k8stypes.NamespacedName{Namespace: backendNamespace, Name: string(backendRef.Name)}, backend)
 			if err != nil {
 				if !apierrors.IsNotFound(err) {
 					return "", err
@@ -724,6 +948,35 @@ func (r *HTTPRouteReconciler) getHTTPRouteRuleReason(ctx context.Context, httpRo
 	return gatewayapi.RouteReasonResolvedRefs, nil
 }
 
+// newBackendRefObject returns an empty object of the type referenced by the backendRef. The backendRef's Group and Kind
+// are expected to be already verified as supported. It returns false if the type is not enabled in the reconciler.
+func (r *HTTPRouteReconciler) newBackendRefObject(backendRef gatewayapi.HTTPBackendRef) (client.Object, bool) {
+	switch *backendRef.Kind {
+	case incubatorv1alpha1.KongServiceFacadeKind:
+		return &incubatorv1alpha1.KongServiceFacade{}, r.KongServiceFacadeEnabled
+	case incubatorv1alpha1.KongUpstreamTargetKind:
+		return &incubatorv1alpha1.KongUpstreamTarget{}, r.KongUpstreamTargetEnabled
+	default:
+		return &corev1.Service{}, true
+	}
+}
+
+// httpRouteRuleBackendRefs returns all the backendRefs of the rule, including the ones
+// referenced by RequestMirror filters.
+func httpRouteRuleBackendRefs(rule gatewayapi.HTTPRouteRule) []gatewayapi.HTTPBackendRef {
+	backendRefs := slices.Clone(rule.BackendRefs)
+	for _, filter := range rule.Filters {
+		if filter.Type == gatewayapi.HTTPRouteFilterRequestMirror && filter.RequestMirror != nil {
+			backendRefs = append(backendRefs, gatewayapi.HTTPBackendRef{
+				BackendRef: gatewayapi.BackendRef{
+					BackendObjectReference: filter.RequestMirror.BackendRef,
+				},
+			})
+		}
+	}
+	return backendRefs
+}
+
 // SetLogger sets the logger.
 func (r *HTTPRouteReconciler) SetLogger(l logr.Logger) {
 	r.Log = l
@@ -1,12 +1,22 @@
 package gateway
 
 import (
+	"context"
 	"testing"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
+	"github.com/kong/kubernetes-ingress-controller/v3/pkg/clientset/scheme"
 )
 
 func TestEnsureNoStaleParentStatus(t *testing.T) {
@@ -225,3 +235,252 @@ func TestParentReferenceKey(t *testing.T) {
 		})
 	}
 }
+
+func TestGetHTTPRouteRuleReason(t *testing.T) {
+	const namespace = "test-namespace"
+
+	routeWithBackendRefs := func(backendRefs ...gatewayapi.BackendRef) gatewayapi.HTTPRoute {
+		return gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-route",
+				Namespace: namespace,
+			},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Rules: []gatewayapi.HTTPRouteRule{
+					{
+						BackendRefs: lo.Map(backendRefs, func(b gatewayapi.BackendRef, _ int) gatewayapi.HTTPBackendRef {
+							return gatewayapi.HTTPBackendRef{BackendRef: b}
+						}),
+					},
+				},
+			},
+		}
+	}
+	facadeRef := builder.NewBackendRef("facade").
+		WithGroup(incubatorv1alpha1.GroupVersion.Group).
+		WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+		Build()
+	upstreamTargetRef := builder.NewBackendRef("upstream-target").
+		WithGroup(incubatorv1alpha1.GroupVersion.Group).
+		WithKind(incubatorv1alpha1.KongUpstreamTargetKind).
+		Build()
+	objects := []client.Object{
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "service", Namespace: namespace}},
+		&incubatorv1alpha1.KongServiceFacade{ObjectMeta: metav1.ObjectMeta{Name: "facade", Namespace: namespace}},
+		&incubatorv1alpha1.KongUpstreamTarget{ObjectMeta: metav1.ObjectMeta{Name: "upstream-target", Namespace: namespace}},
+	}
+
+	testCases := []struct {
+		name                      string
+		route                     gatewayapi.HTTPRoute
+		kongServiceFacadeEnabled  bool
+		kongUpstreamTargetEnabled bool
+		expected                  gatewayapi.RouteConditionReason
+	}{
+		{
+			name:     "existing Service",
+			route:    routeWithBackendRefs(builder.NewBackendRef("service").WithPort(80).Build()),
+			expected: gatewayapi.RouteReasonResolvedRefs,
+		},
+		{
+			name:     "non existing Service",
+			route:    routeWithBackendRefs(builder.NewBackendRef("missing").WithPort(80).Build()),
+			expected: gatewayapi.RouteReasonBackendNotFound,
+		},
+		{
+			name:                     "existing KongServiceFacade",
+			route:                    routeWithBackendRefs(facadeRef),
+			kongServiceFacadeEnabled: true,
+			expected:                 gatewayapi.RouteReasonResolvedRefs,
+		},
+		{
+			name:     "KongServiceFacade when not enabled",
+			route:    routeWithBackendRefs(facadeRef),
+			expected: gatewayapi.RouteReasonInvalidKind,
+		},
+		{
+			name:                      "existing KongUpstreamTarget",
+			route:                     routeWithBackendRefs(upstreamTargetRef),
+			kongUpstreamTargetEnabled: true,
+			expected:                  gatewayapi.RouteReasonResolvedRefs,
+		},
+		{
+			name: "non existing KongUpstreamTarget",
+			route: routeWithBackendRefs(builder.NewBackendRef("missing").
+				WithGroup(incubatorv1alpha1.GroupVersion.Group).
+				WithKind(incubatorv1alpha1.KongUpstreamTargetKind).
+				Build()),
+			kongUpstreamTargetEnabled: true,
+			expected:                  gatewayapi.RouteReasonBackendNotFound,
+		},
+		{
+			name:     "KongUpstreamTarget when not enabled",
+			route:    routeWithBackendRefs(upstreamTargetRef),
+			expected: gatewayapi.RouteReasonInvalidKind,
+		},
+		{
+			name:     "unsupported kind",
+			route:    routeWithBackendRefs(builder.NewBackendRef("pod").WithKind("Pod").Build()),
+			expected: gatewayapi.RouteReasonInvalidKind,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &HTTPRouteReconciler{
+				Client:                    fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build(),
+				KongServiceFacadeEnabled:  tc.kongServiceFacadeEnabled,
+				KongUpstreamTargetEnabled: tc.kongUpstreamTargetEnabled,
+			}
+			reason, err := r.getHTTPRouteRuleReason(context.Background(), tc.route)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, reason)
+		})
+	}
+}
+
+func TestSetRouteConditionSessionPersistenceConflicted(t *testing.T) {
+	const namespace = "test-namespace"
+
+	serviceWithPolicy := func(name, policy string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: map[string]string{kongv1beta1.KongUpstreamPolicyAnnotationKey: policy},
+			},
+		}
+	}
+	routeWithSessionPersistence := func(sessionPersistence *gatewayapi.SessionPersistence, service string) *gatewayapi.HTTPRoute {
+		return &gatewayapi.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-route", Namespace: namespace, Generation: 1},
+			Spec: gatewayapi.HTTPRouteSpec{
+				Rules: []gatewayapi.HTTPRouteRule{{
+					BackendRefs:        builder.NewHTTPBackendRef(service).WithPort(80).ToSlice(),
+					SessionPersistence: sessionPersistence,
+				}},
+			},
+		}
+	}
+	objects := []client.Object{
+		serviceWithPolicy("service-hashing", "hashing"),
+		serviceWithPolicy("service-round-robin", "round-robin"),
+		serviceWithPolicy("service-consistent-hashing", "consistent-hashing"),
+		&kongv1beta1.KongUpstreamPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "hashing", Namespace: namespace},
+			Spec: kongv1beta1.KongUpstreamPolicySpec{
+				HashOn: &kongv1beta1.KongUpstreamHash{Header: lo.ToPtr("x-user")},
+			},
+		},
+		&kongv1beta1.KongUpstreamPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "round-robin", Namespace: namespace},
+			Spec:       kongv1beta1.KongUpstreamPolicySpec{Algorithm: lo.ToPtr("round-robin")},
+		},
+		&kongv1beta1.KongUpstreamPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "consistent-hashing", Namespace: namespace},
+			Spec:       kongv1beta1.KongUpstreamPolicySpec{Algorithm: lo.ToPtr("consistent-hashing")},
+		},
+	}
+	sessionPersistence := &gatewayapi.SessionPersistence{SessionName: lo.ToPtr("session")}
+	conflictedCondition := func(message string) metav1.Condition {
+		return metav1.Condition{
+			Type:               ConditionTypeSessionPersistenceConflicted,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 1,
+			Reason:             string(ConditionReasonKongUpstreamPolicyConfiguresHashing),
+			Message:            message,
+		}
+	}
+
+	testCases := []struct {
+		name               string
+		route              *gatewayapi.HTTPRoute
+		existingConditions []metav1.Condition
+		expectedChanged    bool
+		expectedConditions []metav1.Condition
+	}{
+		{
+			name:            "policy configuring hashing",
+			route:           routeWithSessionPersistence(sessionPersistence, "service-hashing"),
+			expectedChanged: true,
+			expectedConditions: []metav1.Condition{
+				conflictedCondition("sessionPersistence conflicts with hashing configured by KongUpstreamPolicies " +
+					"which take precedence: test-namespace/hashing"),
+			},
+		},
+		{
+			name:            "policy configuring algorithm not using hashing",
+			route:           routeWithSessionPersistence(sessionPersistence, "service-round-robin"),
+			expectedChanged: true,
+			expectedConditions: []metav1.Condition{
+				conflictedCondition("sessionPersistence conflicts with hashing configured by KongUpstreamPolicies " +
+					"which take precedence: test-namespace/round-robin"),
+			},
+		},
+		{
+			name:  "policy not configuring hashing",
+			route: routeWithSessionPersistence(sessionPersistence, "service-consistent-hashing"),
+		},
+		{
+			name:  "rule without sessionPersistence",
+			route: routeWithSessionPersistence(nil, "service-hashing"),
+		},
+		{
+			name:  "conflict resolved",
+			route: routeWithSessionPersistence(sessionPersistence, "service-consistent-hashing"),
+			existingConditions: []metav1.Condition{
+				conflictedCondition("sessionPersistence conflicts with hashing configured by KongUpstreamPolicies " +
+					"which take precedence: test-namespace/consistent-hashing"),
+			},
+			expectedChanged: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &HTTPRouteReconciler{
+				Client:                    fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build(),
+				KongUpstreamPolicyEnabled: true,
+			}
+			parentStatus := &gatewayapi.RouteParentStatus{Conditions: tc.existingConditions}
+			changed, err := r.setRouteConditionSessionPersistenceConflicted(
+				context.Background(), tc.route, map[string]*gatewayapi.RouteParentStatus{"parent": parentStatus},
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedChanged, changed)
+			for i := range parentStatus.Conditions {
+				parentStatus.Conditions[i].LastTransitionTime = metav1.Time{}
+			}
+			assert.ElementsMatch(t, tc.expectedConditions, parentStatus.Conditions)
+		})
+	}
+}
+
+func TestHTTPRouteReferencesBackend(t *testing.T) {
+	backend := &incubatorv1alpha1.KongUpstreamTarget{ObjectMeta: metav1.ObjectMeta{Name: "upstream-target", Namespace: "backend-namespace"}}
+	route := gatewayapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-route", Namespace: "route-namespace"},
+		Spec: gatewayapi.HTTPRouteSpec{
+			Rules: []gatewayapi.HTTPRouteRule{
+				{
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						{
+							BackendRef: builder.NewBackendRef("upstream-target").
+								WithGroup(incubatorv1alpha1.GroupVersion.Group).
+								WithKind(incubatorv1alpha1.KongUpstreamTargetKind).
+								WithNamespace("backend-namespace").
+								Build(),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	assert.True(t, httpRouteReferencesBackend(route, incubatorv1alpha1.KongUpstreamTargetKind, backend))
+	assert.False(t, httpRouteReferencesBackend(route, incubatorv1alpha1.KongServiceFacadeKind, backend))
+
+	backendInOtherNamespace := backend.DeepCopy()
+	backendInOtherNamespace.Namespace = "route-namespace"
+	assert.False(t, httpRouteReferencesBackend(route, incubatorv1alpha1.KongUpstreamTargetKind, backendInOtherNamespace))
+}
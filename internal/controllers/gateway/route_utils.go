@@ -5,12 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/samber/lo"
 	"github.com/samber/mo"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -31,6 +34,16 @@ const (
 	ConditionReasonProgrammedUnknown   gatewayapi.RouteConditionReason = "Unknown"
 	ConditionReasonConfiguredInGateway gatewayapi.RouteConditionReason = "ConfiguredInGateway"
 	ConditionReasonTranslationError    gatewayapi.RouteConditionReason = "TranslationError"
+
+	// ConditionTypeSessionPersistenceConflicted is set on HTTPRoute's parents when sessionPersistence of its rules
+	// is not applied as a KongUpstreamPolicy of their backends configures hashing which takes precedence.
+	ConditionTypeSessionPersistenceConflicted                                          = "SessionPersistenceConflicted"
+	ConditionReasonKongUpstreamPolicyConfiguresHashing gatewayapi.RouteConditionReason = "KongUpstreamPolicyConfiguresHashing"
+
+	// ConditionTypeFrontendValidationConflicted is set on HTTPRoute's and GRPCRoute's parents when the route is attached
+	// to listeners with tls.frontendValidation and to listeners without it, which then require client certificates too.
+	ConditionTypeFrontendValidationConflicted                                                   = "FrontendValidationConflicted"
+	ConditionReasonAttachedToListenersWithoutFrontendValidation gatewayapi.RouteConditionReason = "AttachedToListenersWithoutFrontendValidation"
 )
 
 var (
@@ -384,7 +397,7 @@ func routeTypeMatchesListenerType[T gatewayapi.RouteT](route T, listener gateway
 		if !(listener.Protocol == gatewayapi.HTTPProtocolType || listener.Protocol == gatewayapi.HTTPSProtocolType) {
 			return false
 		}
-		if listener.TLS != nil && *listener.TLS.Mode != gatewayapi.TLSModeTerminate {
+		if listener.TLS != nil && listenerTLSMode(listener) != gatewayapi.TLSModeTerminate {
 			return false
 		}
 	case *gatewayapi.TCPRoute:
@@ -394,7 +407,7 @@ func routeTypeMatchesListenerType[T gatewayapi.RouteT](route T, listener gateway
 		// TCPRoutes support Terminate only
 		// Note: this is a guess we are doing as the upstream documentation is unclear at the moment.
 		// see https://github.com/kubernetes-sigs/gateway-api/issues/1474
-		if listener.TLS != nil && *listener.TLS.Mode != gatewayapi.TLSModeTerminate {
+		if listener.TLS != nil && listenerTLSMode(listener) != gatewayapi.TLSModeTerminate {
 			return false
 		}
 	case *gatewayapi.UDPRoute:
@@ -409,10 +422,9 @@ func routeTypeMatchesListenerType[T gatewayapi.RouteT](route T, listener gateway
 		if listener.Protocol != gatewayapi.TLSProtocolType {
 			return false
 		}
-		// TLSRoutes currently support Passthrough only
-		// Note: this is a guess we are doing as the upstream documentation is unclear at the moment.
-		// see https://github.com/kubernetes-sigs/gateway-api/issues/1474
-		if listener.TLS != nil && *listener.TLS.Mode != gatewayapi.TLSModePassthrough {
+		// TLSRoutes support both Passthrough (routed by SNI only) and Terminate (TLS is terminated by Kong
+		// with the listener's certificate and plain TCP is forwarded to backends) listeners.
+		if listener.TLS == nil {
 			return false
 		}
 	case *gatewayapi.GRPCRoute:
@@ -697,6 +709,87 @@ func isRouteAccepted(gateways []supportedGatewayWithCondition) bool {
 	return false
 }
 
+// getListenersWithoutFrontendValidation returns sorted keys (namespace/gateway/listener) of HTTP and HTTPS listeners
+// of accepting Gateways the route is attached to with its parentRefs that don't have tls.frontendValidation, when
+// the route is also attached to a listener with tls.frontendValidation. As Kong doesn't separate routes by listeners,
+// client certificates are required for the route at all of them.
+func getListenersWithoutFrontendValidation(
+	routeNamespace string,
+	parentRefs []gatewayapi.ParentReference,
+	gateways []supportedGatewayWithCondition,
+) []string {
+	var (
+		attachedToValidated bool
+		notValidated        []string
+	)
+	for _, gateway := range gateways {
+		if gateway.condition.Status != metav1.ConditionTrue {
+			continue
+		}
+		for _, ref := range parentRefs {
+			if (ref.Group != nil && *ref.Group != gatewayapi.V1Group) || (ref.Kind != nil && *ref.Kind != "Gateway") {
+				continue
+			}
+			refNamespace := routeNamespace
+			if ref.Namespace != nil {
+				refNamespace = string(*ref.Namespace)
+			}
+			if refNamespace != gateway.gateway.Namespace || string(ref.Name) != gateway.gateway.Name {
+				continue
+			}
+			for _, listener := range gateway.gateway.Spec.Listeners {
+				if listener.Protocol != gatewayapi.HTTPProtocolType && listener.Protocol != gatewayapi.HTTPSProtocolType {
+					continue
+				}
+				if (ref.SectionName != nil && *ref.SectionName != listener.Name) || (ref.Port != nil && *ref.Port != listener.Port) {
+					continue
+				}
+				if listener.TLS != nil && listener.TLS.FrontendValidation != nil {
+					attachedToValidated = true
+					continue
+				}
+				notValidated = append(notValidated, fmt.Sprintf("%s/%s/%s", gateway.gateway.Namespace, gateway.gateway.Name, listener.Name))
+			}
+		}
+	}
+	if !attachedToValidated || len(notValidated) == 0 {
+		return nil
+	}
+	notValidated = lo.Uniq(notValidated)
+	slices.Sort(notValidated)
+	return notValidated
+}
+
+// setRouteConditionFrontendValidationConflicted sets a condition of type FrontendValidationConflicted on the route's
+// parent statuses when the route is attached to the given listeners without tls.frontendValidation next to ones with
+// it, and removes it otherwise. It returns true if any of the parent statuses changed.
+func setRouteConditionFrontendValidationConflicted(
+	generation int64,
+	parentStatuses map[string]*gatewayapi.RouteParentStatus,
+	listenersWithoutFrontendValidation []string,
+) bool {
+	var changed bool
+	for _, parentStatus := range parentStatuses {
+		if len(listenersWithoutFrontendValidation) == 0 {
+			if meta.RemoveStatusCondition(&parentStatus.Conditions, ConditionTypeFrontendValidationConflicted) {
+				changed = true
+			}
+			continue
+		}
+		if meta.SetStatusCondition(&parentStatus.Conditions, metav1.Condition{
+			Type:               ConditionTypeFrontendValidationConflicted,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: generation,
+			Reason:             string(ConditionReasonAttachedToListenersWithoutFrontendValidation),
+			Message: fmt.Sprintf("client certificates are required by the route also at listeners without "+
+				"frontendValidation: %s", strings.Join(listenersWithoutFrontendValidation, ", ")),
+		}) {
+			changed = true
+		}
+	}
+	return changed
+}
+
 // isHTTPReferenceGranted checks that the backendRef referenced by the HTTPRoute is granted by a ReferenceGrant.
 func isHTTPReferenceGranted(grantSpec gatewayapi.ReferenceGrantSpec, backendRef gatewayapi.HTTPBackendRef, fromNamespace string) bool {
 	var backendRefGroup gatewayapi.Group
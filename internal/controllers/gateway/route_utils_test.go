@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/go-logr/logr"
@@ -1182,7 +1183,7 @@ func TestGetSupportedGatewayForRoute(t *testing.T) {
 				},
 			},
 			{
-				name:  "basic TLSRoute does not get accepted because there is no listener with TLS in passthrough mode",
+				name:  "basic TLSRoute gets accepted by a listener with TLS in terminate mode",
 				route: basicTLSRoute(),
 				objects: []client.Object{
 					func() *gatewayapi.Gateway {
@@ -1198,6 +1199,27 @@ func TestGetSupportedGatewayForRoute(t *testing.T) {
 					gatewayClass,
 					namespace,
 				},
+				expected: []expected{
+					{
+						condition: routeConditionAccepted(metav1.ConditionTrue, gatewayapi.RouteReasonAccepted),
+					},
+				},
+			},
+			{
+				name:  "basic TLSRoute does not get accepted because the TLS listener has no TLS configuration",
+				route: basicTLSRoute(),
+				objects: []client.Object{
+					func() *gatewayapi.Gateway {
+						gw := gatewayWithTLS443PassthroughReady()
+						gw.Spec.Listeners = builder.NewListener("tls").
+							WithPort(443).
+							TLS().
+							IntoSlice()
+						return gw
+					}(),
+					gatewayClass,
+					namespace,
+				},
 				expected: []expected{
 					{
 						condition: routeConditionAccepted(metav1.ConditionFalse, gatewayapi.RouteReasonNoMatchingParent),
@@ -2564,3 +2586,137 @@ func TestIsRouteAcceptedByListener(t *testing.T) {
 		})
 	}
 }
+
+func TestSetRouteConditionFrontendValidationConflicted(t *testing.T) {
+	gateway := &gatewayapi.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gateway",
+			Namespace: "default",
+		},
+		Spec: gatewayapi.GatewaySpec{
+			Listeners: []gatewayapi.Listener{
+				{
+					Name:     "https-mtls",
+					Protocol: gatewayapi.HTTPSProtocolType,
+					Port:     443,
+					TLS: &gatewayapi.GatewayTLSConfig{
+						FrontendValidation: &gatewayapi.FrontendTLSValidation{
+							CACertificateRefs: []gatewayapi.ObjectReference{{Kind: "ConfigMap", Name: "ca"}},
+						},
+					},
+				},
+				{
+					Name:     "https",
+					Protocol: gatewayapi.HTTPSProtocolType,
+					Port:     8443,
+					TLS:      &gatewayapi.GatewayTLSConfig{},
+				},
+				{
+					Name:     "http",
+					Protocol: gatewayapi.HTTPProtocolType,
+					Port:     80,
+				},
+				{
+					Name:     "tcp",
+					Protocol: gatewayapi.TCPProtocolType,
+					Port:     9000,
+				},
+			},
+		},
+	}
+	accepted := []supportedGatewayWithCondition{
+		{
+			gateway: gateway,
+			condition: metav1.Condition{
+				Type:   string(gatewayapi.RouteConditionAccepted),
+				Status: metav1.ConditionTrue,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name              string
+		parentRefs        []gatewayapi.ParentReference
+		gateways          []supportedGatewayWithCondition
+		expectedListeners []string
+	}{
+		{
+			name:              "attached to the whole Gateway",
+			parentRefs:        []gatewayapi.ParentReference{{Name: "gateway"}},
+			gateways:          accepted,
+			expectedListeners: []string{"default/gateway/http", "default/gateway/https"},
+		},
+		{
+			name: "attached to listeners with and without frontendValidation by their names and ports",
+			parentRefs: []gatewayapi.ParentReference{
+				{Name: "gateway", SectionName: lo.ToPtr(gatewayapi.SectionName("https-mtls"))},
+				{Name: "gateway", Port: lo.ToPtr(gatewayapi.PortNumber(80))},
+			},
+			gateways:          accepted,
+			expectedListeners: []string{"default/gateway/http"},
+		},
+		{
+			name: "attached only to the listener with frontendValidation",
+			parentRefs: []gatewayapi.ParentReference{
+				{Name: "gateway", SectionName: lo.ToPtr(gatewayapi.SectionName("https-mtls"))},
+			},
+			gateways: accepted,
+		},
+		{
+			name: "attached only to listeners without frontendValidation",
+			parentRefs: []gatewayapi.ParentReference{
+				{Name: "gateway", SectionName: lo.ToPtr(gatewayapi.SectionName("https"))},
+				{Name: "gateway", SectionName: lo.ToPtr(gatewayapi.SectionName("http"))},
+			},
+			gateways: accepted,
+		},
+		{
+			name:       "not accepted by the Gateway",
+			parentRefs: []gatewayapi.ParentReference{{Name: "gateway"}},
+			gateways: []supportedGatewayWithCondition{
+				{
+					gateway: gateway,
+					condition: metav1.Condition{
+						Type:   string(gatewayapi.RouteConditionAccepted),
+						Status: metav1.ConditionFalse,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			listeners := getListenersWithoutFrontendValidation("default", tc.parentRefs, tc.gateways)
+			require.Equal(t, tc.expectedListeners, listeners)
+
+			parentStatuses := map[string]*gatewayapi.RouteParentStatus{
+				"default/gateway/": {
+					Conditions: []metav1.Condition{
+						{
+							Type:   ConditionTypeFrontendValidationConflicted,
+							Status: metav1.ConditionTrue,
+							Reason: "Outdated",
+						},
+					},
+				},
+			}
+			changed := setRouteConditionFrontendValidationConflicted(1, parentStatuses, listeners)
+			require.True(t, changed)
+			condition, ok := lo.Find(parentStatuses["default/gateway/"].Conditions, func(c metav1.Condition) bool {
+				return c.Type == ConditionTypeFrontendValidationConflicted
+			})
+			if len(tc.expectedListeners) == 0 {
+				require.False(t, ok, "condition should be removed")
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, metav1.ConditionTrue, condition.Status)
+			assert.Equal(t, string(ConditionReasonAttachedToListenersWithoutFrontendValidation), condition.Reason)
+			assert.Contains(t, condition.Message, strings.Join(tc.expectedListeners, ", "))
+
+			require.False(t, setRouteConditionFrontendValidationConflicted(1, parentStatuses, listeners),
+				"setting the same condition again should not change the status")
+		})
+	}
+}
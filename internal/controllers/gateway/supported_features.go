@@ -0,0 +1,72 @@
+package gateway
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/gateway-api/pkg/features"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+)
+
+// SupportedFeaturesOptions holds the configuration that determines which Gateway API features are supported.
+type SupportedFeaturesOptions struct {
+	// TranslatorFeatures are the effective translator feature flags, reflecting both the feature gates
+	// and the router flavor of Kong Gateway.
+	TranslatorFeatures translator.FeatureFlags
+
+	// HTTPRouteEnabled indicates whether HTTPRoutes are reconciled.
+	HTTPRouteEnabled bool
+	// GRPCRouteEnabled indicates whether GRPCRoutes are reconciled.
+	GRPCRouteEnabled bool
+	// ReferenceGrantEnabled indicates whether ReferenceGrants are reconciled.
+	ReferenceGrantEnabled bool
+	// GatewayAlphaEnabled indicates whether Gateway API alpha kinds (TCPRoute, UDPRoute, TLSRoute) are reconciled.
+	GatewayAlphaEnabled bool
+}
+
+// GetSupportedFeatures returns the Gateway API features (as defined by the Gateway API conformance suite)
+// supported with the given options, sorted by name.
+//
+// The RewriteURIs translator feature doesn't affect the result as it governs the Ingress konghq.com/rewrite
+// annotation only.
+func GetSupportedFeatures(opts SupportedFeaturesOptions) []gatewayapi.SupportedFeature {
+	supported := sets.New(features.SupportGateway)
+
+	if opts.ReferenceGrantEnabled {
+		supported.Insert(features.SupportReferenceGrant)
+	}
+	if opts.HTTPRouteEnabled {
+		supported.Insert(
+			features.SupportHTTPRoute,
+			features.SupportHTTPRouteResponseHeaderModification,
+			features.SupportHTTPRoutePathRewrite,
+			features.SupportHTTPRouteHostRewrite,
+			features.SupportHTTPRouteRequestMirror,
+		)
+		// Matching by query parameters and methods is implemented with the expression router only.
+		if opts.TranslatorFeatures.ExpressionRoutes {
+			supported.Insert(
+				features.SupportHTTPRouteQueryParamMatching,
+				features.SupportHTTPRouteMethodMatching,
+			)
+		}
+	}
+	if opts.GRPCRouteEnabled {
+		supported.Insert(features.SupportGRPCRoute)
+	}
+	if opts.GatewayAlphaEnabled {
+		supported.Insert(
+			features.SupportTLSRoute,
+			features.SupportUDPRoute,
+		)
+	}
+
+	result := make([]gatewayapi.SupportedFeature, 0, supported.Len())
+	for _, f := range supported.UnsortedList() {
+		result = append(result, gatewayapi.SupportedFeature(f))
+	}
+	slices.Sort(result)
+	return result
+}
@@ -0,0 +1,78 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+)
+
+func TestGetSupportedFeatures(t *testing.T) {
+	testCases := []struct {
+		name     string
+		opts     SupportedFeaturesOptions
+		expected []gatewayapi.SupportedFeature
+	}{
+		{
+			name:     "Gateway only",
+			expected: []gatewayapi.SupportedFeature{"Gateway"},
+		},
+		{
+			name: "traditional router",
+			opts: SupportedFeaturesOptions{
+				HTTPRouteEnabled:      true,
+				GRPCRouteEnabled:      true,
+				ReferenceGrantEnabled: true,
+			},
+			expected: []gatewayapi.SupportedFeature{
+				"GRPCRoute",
+				"Gateway",
+				"HTTPRoute",
+				"HTTPRouteHostRewrite",
+				"HTTPRoutePathRewrite",
+				"HTTPRouteRequestMirror",
+				"HTTPRouteResponseHeaderModification",
+				"ReferenceGrant",
+			},
+		},
+		{
+			name: "expression router",
+			opts: SupportedFeaturesOptions{
+				TranslatorFeatures: translator.FeatureFlags{ExpressionRoutes: true},
+				HTTPRouteEnabled:   true,
+			},
+			expected: []gatewayapi.SupportedFeature{
+				"Gateway",
+				"HTTPRoute",
+				"HTTPRouteHostRewrite",
+				"HTTPRouteMethodMatching",
+				"HTTPRoutePathRewrite",
+				"HTTPRouteQueryParamMatching",
+				"HTTPRouteRequestMirror",
+				"HTTPRouteResponseHeaderModification",
+			},
+		},
+		{
+			name: "expression router with HTTPRoute disabled",
+			opts: SupportedFeaturesOptions{
+				TranslatorFeatures: translator.FeatureFlags{ExpressionRoutes: true},
+			},
+			expected: []gatewayapi.SupportedFeature{"Gateway"},
+		},
+		{
+			name: "Gateway API alpha kinds",
+			opts: SupportedFeaturesOptions{
+				GatewayAlphaEnabled: true,
+			},
+			expected: []gatewayapi.SupportedFeature{"Gateway", "TLSRoute", "UDPRoute"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, GetSupportedFeatures(tc.opts))
+		})
+	}
+}
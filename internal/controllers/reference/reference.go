@@ -15,6 +15,7 @@ import (
 const (
 	VersionV1      = "v1"
 	KindSecret     = "Secret"
+	KindConfigMap  = "ConfigMap"
 	CACertLabelKey = "konghq.com/ca-cert"
 )
 
@@ -115,9 +116,81 @@ func removeOutdatedReferencesToSecret(
 	return nil
 }
 
+// UpdateReferencesToConfigMap updates the reference records between referrer and each ConfigMap
+// in namespacedNames in record cache.
+func UpdateReferencesToConfigMap(
+	ctx context.Context,
+	c client.Client, indexers CacheIndexers, dataplaneClient controllers.DataPlaneClient,
+	referrer client.Object, referencedConfigMapNameMap map[k8stypes.NamespacedName]struct{},
+) error {
+	for nsName := range referencedConfigMapNameMap {
+		configMap := &corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: VersionV1,
+				Kind:       KindConfigMap,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: nsName.Namespace,
+				Name:      nsName.Name,
+			},
+		}
+
+		// Here we update the reference relationship even when the referred ConfigMap does not exist yet
+		// If the referred ConfigMap is created, it could be reconciled in ConfigMap controller.
+		referrerCopy := referrer.DeepCopyObject().(client.Object)
+		if err := indexers.SetObjectReference(
+			referrerCopy, configMap.DeepCopy()); err != nil {
+			return err
+		}
+
+		if err := c.Get(ctx, nsName, configMap); err != nil {
+			return err
+		}
+
+		if err := dataplaneClient.UpdateObject(configMap); err != nil {
+			return err
+		}
+	}
+
+	return removeOutdatedReferencesToConfigMap(indexers, dataplaneClient, referrer, referencedConfigMapNameMap)
+}
+
+// removeOutdatedReferencesToConfigMap removes outdated reference records to ConfigMaps in reference indexer.
+// ConfigMaps that are referred by referrer are passed in referredConfigMapNameMap parameter.
+// If a ConfigMap is not referenced by any other object after deleting outdated reference records,
+// it is removed from the object cache inside KongClient.
+func removeOutdatedReferencesToConfigMap(
+	indexers CacheIndexers, dataplaneClient controllers.DataPlaneClient,
+	referrer client.Object, referredConfigMapNameMap map[k8stypes.NamespacedName]struct{},
+) error {
+	referents, err := indexers.ListReferredObjects(referrer)
+	if err != nil {
+		return err
+	}
+	for _, obj := range referents {
+		if !isConfigMap(obj) {
+			continue
+		}
+		namespacedName := k8stypes.NamespacedName{
+			Namespace: obj.GetNamespace(),
+			Name:      obj.GetName(),
+		}
+		if _, ok := referredConfigMapNameMap[namespacedName]; ok {
+			continue
+		}
+		if err := indexers.DeleteObjectReference(referrer, obj); err != nil {
+			return err
+		}
+		if err := indexers.DeleteObjectIfNotReferred(obj, dataplaneClient); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // DeleteReferencesByReferrer deletes all reference records with specified referrer
 // in reference cache.
-// If the affected secret is not referred by any other objects, it deletes the secret in object cache.
+// If the affected secret or ConfigMap is not referred by any other objects, it deletes it in object cache.
 func DeleteReferencesByReferrer(indexers CacheIndexers, dataplaneClient controllers.DataPlaneClient, referrer client.Object) error {
 	referents, err := indexers.ListReferredObjects(referrer)
 	if err != nil {
@@ -137,10 +210,10 @@ func DeleteReferencesByReferrer(indexers CacheIndexers, dataplaneClient controll
 		}
 	}
 
-	// delete the referent in object cache if it is a secret and it is not referenced anymore.
+	// delete the referent in object cache if it is a secret or a ConfigMap and it is not referenced anymore.
 	for _, referent := range referents {
 		gvk := referent.GetObjectKind().GroupVersionKind()
-		if !(gvk.Group == corev1.GroupName && gvk.Version == VersionV1 && gvk.Kind == KindSecret) {
+		if !(gvk.Group == corev1.GroupName && gvk.Version == VersionV1 && gvk.Kind == KindSecret) && !isConfigMap(referent) {
 			continue
 		}
 		err := indexers.DeleteObjectIfNotReferred(referent, dataplaneClient)
@@ -151,3 +224,9 @@ func DeleteReferencesByReferrer(indexers CacheIndexers, dataplaneClient controll
 
 	return nil
 }
+
+// isConfigMap returns true if the object stored in reference indexer is a ConfigMap.
+func isConfigMap(obj client.Object) bool {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	return gvk.Group == corev1.GroupName && gvk.Version == VersionV1 && gvk.Kind == KindConfigMap
+}
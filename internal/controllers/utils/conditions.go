@@ -1,9 +1,14 @@
 package utils
 
 import (
+	"fmt"
+	"maps"
+	"strconv"
+
 	"github.com/samber/lo"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
@@ -32,11 +37,23 @@ func WithUnknownMessage(message string) ProgrammedConditionOption {
 	}
 }
 
+// WithConfigurationHash appends the hash of the configuration the object was applied with to the message of
+// the desired Programmed condition if the configuration status is Succeeded. The message is refreshed whenever
+// the object gets applied with a configuration with a different hash, so that it always reflects the applied one.
+func WithConfigurationHash(hash string) ProgrammedConditionOption {
+	return func(status object.ConfigurationStatus, condition *metav1.Condition) {
+		if status == object.ConfigurationStatusSucceeded && hash != "" {
+			condition.Message = fmt.Sprintf("%s Configuration hash: %s.", condition.Message, hash)
+		}
+	}
+}
+
 // EnsureProgrammedCondition ensures that the programmed condition is present in the conditions slice with the
 // status reflecting the current configuration status of the object.
-// If the condition is already present with the correct status, the conditions slice is returned unmodified and false is
-// returned as the second return value. If the condition is not present or has the wrong status, the conditions slice is
-// returned with the condition updated and true is returned.
+// If the condition is already present with the correct status and message, the conditions slice is returned unmodified
+// and false is returned as the second return value. If the condition is not present or has the wrong status, the
+// conditions slice is returned with the condition updated and true is returned. If only the message differs (e.g.
+// the configuration hash changed), the condition's message is updated without changing its last transition time.
 func EnsureProgrammedCondition(
 	configurationStatus object.ConfigurationStatus,
 	objectGeneration int64,
@@ -46,6 +63,96 @@ func EnsureProgrammedCondition(
 	updatedConditions []metav1.Condition,
 	updateNeeded bool,
 ) {
+	desiredCondition := desiredProgrammedCondition(configurationStatus, objectGeneration, options...)
+
+	hasMatchingCondition := util.CheckCondition(
+		conditions,
+		util.ConditionType(desiredCondition.Type),
+		util.ConditionReason(desiredCondition.Reason),
+		desiredCondition.Status,
+		desiredCondition.ObservedGeneration,
+	)
+
+	_, idx, ok := lo.FindIndexOf(conditions, func(c metav1.Condition) bool { return c.Type == string(kongv1.ConditionProgrammed) })
+	if !ok {
+		return append(conditions, desiredCondition), true
+	}
+	if hasMatchingCondition {
+		if conditions[idx].Message == desiredCondition.Message {
+			return conditions, false
+		}
+		desiredCondition.LastTransitionTime = conditions[idx].LastTransitionTime
+	}
+	conditions[idx] = desiredCondition
+
+	return conditions, true
+}
+
+// IsProgrammedConditionNeeded returns false for objects whose configuration status is Unknown and that have no
+// Programmed condition yet. It's used for kinds that are not necessarily used in Kong configuration (e.g. Services)
+// to not add the Programmed condition to objects unrelated to Kong.
+func IsProgrammedConditionNeeded(configurationStatus object.ConfigurationStatus, conditions []metav1.Condition) bool {
+	if configurationStatus != object.ConfigurationStatusUnknown {
+		return true
+	}
+	return lo.ContainsBy(conditions, func(c metav1.Condition) bool { return c.Type == string(kongv1.ConditionProgrammed) })
+}
+
+// EnsureProgrammedAnnotations ensures that the annotations reflecting the Programmed condition (see
+// annotations.ProgrammedKey) are present in the annotations map with values reflecting the current configuration
+// status of the object. It's used for objects whose status has no conditions.
+// If the annotations are already present with the correct values, the annotations map is returned unmodified and
+// false is returned as the second return value. Otherwise, a copy of the annotations map is returned with the
+// annotations updated and true is returned. Similarly to the Programmed condition's message, the configuration hash
+// annotation is refreshed whenever the object gets applied with a configuration with a different hash.
+func EnsureProgrammedAnnotations(
+	configurationStatus object.ConfigurationStatus,
+	objectGeneration int64,
+	configHash string,
+	objectAnnotations map[string]string,
+) (
+	updatedAnnotations map[string]string,
+	updateNeeded bool,
+) {
+	var (
+		desiredCondition = desiredProgrammedCondition(configurationStatus, objectGeneration)
+		statusKey        = annotations.AnnotationPrefix + annotations.ProgrammedKey
+		reasonKey        = annotations.AnnotationPrefix + annotations.ProgrammedReasonKey
+		generationKey    = annotations.AnnotationPrefix + annotations.ProgrammedGenerationKey
+		configHashKey    = annotations.AnnotationPrefix + annotations.ProgrammedConfigHashKey
+		generation       = strconv.FormatInt(objectGeneration, 10)
+	)
+	if configurationStatus != object.ConfigurationStatusSucceeded {
+		configHash = ""
+	}
+	if objectAnnotations[statusKey] == string(desiredCondition.Status) &&
+		objectAnnotations[reasonKey] == desiredCondition.Reason &&
+		objectAnnotations[generationKey] == generation &&
+		objectAnnotations[configHashKey] == configHash {
+		return objectAnnotations, false
+	}
+
+	updatedAnnotations = maps.Clone(objectAnnotations)
+	if updatedAnnotations == nil {
+		updatedAnnotations = make(map[string]string)
+	}
+	updatedAnnotations[statusKey] = string(desiredCondition.Status)
+	updatedAnnotations[reasonKey] = desiredCondition.Reason
+	updatedAnnotations[generationKey] = generation
+	if configHash != "" {
+		updatedAnnotations[configHashKey] = configHash
+	} else {
+		delete(updatedAnnotations, configHashKey)
+	}
+	return updatedAnnotations, true
+}
+
+// desiredProgrammedCondition returns the Programmed condition reflecting the configuration status of an object.
+func desiredProgrammedCondition(
+	configurationStatus object.ConfigurationStatus,
+	objectGeneration int64,
+	options ...ProgrammedConditionOption,
+) metav1.Condition {
 	var (
 		status  metav1.ConditionStatus
 		reason  kongv1.ConditionReason
@@ -66,7 +173,7 @@ func EnsureProgrammedCondition(
 		message = ProgrammedConditionFalsePendingMessage
 	}
 
-	desiredCondition := metav1.Condition{
+	condition := metav1.Condition{
 		Type:               string(kongv1.ConditionProgrammed),
 		Status:             status,
 		ObservedGeneration: objectGeneration,
@@ -75,27 +182,7 @@ func EnsureProgrammedCondition(
 		Message:            message,
 	}
 	for _, opt := range options {
-		opt(configurationStatus, &desiredCondition)
-	}
-
-	hasMatchingCondition := util.CheckCondition(
-		conditions,
-		util.ConditionType(desiredCondition.Type),
-		util.ConditionReason(desiredCondition.Reason),
-		desiredCondition.Status,
-		desiredCondition.ObservedGeneration,
-	)
-
-	if hasMatchingCondition {
-		return conditions, false
-	}
-
-	_, idx, ok := lo.FindIndexOf(conditions, func(c metav1.Condition) bool { return c.Type == string(kongv1.ConditionProgrammed) })
-	if !ok {
-		conditions = append(conditions, desiredCondition)
-	} else {
-		conditions[idx] = desiredCondition
+		opt(configurationStatus, &condition)
 	}
-
-	return conditions, true
+	return condition
 }
@@ -1,6 +1,7 @@
 package utils_test
 
 import (
+	"maps"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -8,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/utils"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
@@ -128,6 +130,76 @@ func TestEnsureProgrammedCondition(t *testing.T) {
 			expectedUpdatedConditions: []metav1.Condition{expectedProgrammedConditionTrue},
 			expectedUpdateNeeded:      true,
 		},
+		{
+			name:                "condition for Succeeded status with configuration hash",
+			configurationStatus: object.ConfigurationStatusSucceeded,
+			conditions:          nil,
+			options: []utils.ProgrammedConditionOption{
+				utils.WithConfigurationHash("abc123"),
+			},
+			expectedUpdatedConditions: []metav1.Condition{
+				func() metav1.Condition {
+					cond := expectedProgrammedConditionTrue
+					cond.Message = utils.ProgrammedConditionTrueMessage + " Configuration hash: abc123."
+					return cond
+				}(),
+			},
+			expectedUpdateNeeded: true,
+		},
+		{
+			name:                "condition for Failed status not affected by configuration hash",
+			configurationStatus: object.ConfigurationStatusFailed,
+			conditions:          nil,
+			options: []utils.ProgrammedConditionOption{
+				utils.WithConfigurationHash("abc123"),
+			},
+			expectedUpdatedConditions: []metav1.Condition{expectedProgrammedConditionFalse},
+			expectedUpdateNeeded:      true,
+		},
+		{
+			name:                "condition already present with the same configuration hash",
+			configurationStatus: object.ConfigurationStatusSucceeded,
+			conditions: []metav1.Condition{
+				func() metav1.Condition {
+					cond := expectedProgrammedConditionTrue
+					cond.Message = utils.ProgrammedConditionTrueMessage + " Configuration hash: abc123."
+					return cond
+				}(),
+			},
+			options: []utils.ProgrammedConditionOption{
+				utils.WithConfigurationHash("abc123"),
+			},
+			expectedUpdatedConditions: []metav1.Condition{
+				func() metav1.Condition {
+					cond := expectedProgrammedConditionTrue
+					cond.Message = utils.ProgrammedConditionTrueMessage + " Configuration hash: abc123."
+					return cond
+				}(),
+			},
+			expectedUpdateNeeded: false,
+		},
+		{
+			name:                "condition already present with a different configuration hash",
+			configurationStatus: object.ConfigurationStatusSucceeded,
+			conditions: []metav1.Condition{
+				func() metav1.Condition {
+					cond := expectedProgrammedConditionTrue
+					cond.Message = utils.ProgrammedConditionTrueMessage + " Configuration hash: old."
+					return cond
+				}(),
+			},
+			options: []utils.ProgrammedConditionOption{
+				utils.WithConfigurationHash("abc123"),
+			},
+			expectedUpdatedConditions: []metav1.Condition{
+				func() metav1.Condition {
+					cond := expectedProgrammedConditionTrue
+					cond.Message = utils.ProgrammedConditionTrueMessage + " Configuration hash: abc123."
+					return cond
+				}(),
+			},
+			expectedUpdateNeeded: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -141,3 +213,170 @@ func TestEnsureProgrammedCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestEnsureProgrammedCondition_ConfigurationHashChangeKeepsLastTransitionTime(t *testing.T) {
+	lastTransitionTime := metav1.Unix(1, 0)
+	conditions := []metav1.Condition{
+		{
+			Type:               string(kongv1.ConditionProgrammed),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 1,
+			LastTransitionTime: lastTransitionTime,
+			Reason:             string(kongv1.ReasonProgrammed),
+			Message:            utils.ProgrammedConditionTrueMessage + " Configuration hash: old.",
+		},
+	}
+
+	updated, updateNeeded := utils.EnsureProgrammedCondition(
+		object.ConfigurationStatusSucceeded, 1, conditions, utils.WithConfigurationHash("new"),
+	)
+	assert.True(t, updateNeeded)
+	assert.Equal(t, utils.ProgrammedConditionTrueMessage+" Configuration hash: new.", updated[0].Message)
+	assert.Equal(t, lastTransitionTime, updated[0].LastTransitionTime)
+}
+
+func TestEnsureProgrammedAnnotations(t *testing.T) {
+	const testObjectGeneration = 2
+	var (
+		statusKey     = annotations.AnnotationPrefix + annotations.ProgrammedKey
+		reasonKey     = annotations.AnnotationPrefix + annotations.ProgrammedReasonKey
+		generationKey = annotations.AnnotationPrefix + annotations.ProgrammedGenerationKey
+		configHashKey = annotations.AnnotationPrefix + annotations.ProgrammedConfigHashKey
+
+		programmedAnnotations = map[string]string{
+			"other":       "value",
+			statusKey:     "True",
+			reasonKey:     string(kongv1.ReasonProgrammed),
+			generationKey: "2",
+			configHashKey: "abc123",
+		}
+	)
+
+	testCases := []struct {
+		name string
+
+		configurationStatus object.ConfigurationStatus
+		annotations         map[string]string
+
+		expectedUpdatedAnnotations map[string]string
+		expectedUpdateNeeded       bool
+	}{
+		{
+			name:                       "annotations already present with correct status and generation",
+			configurationStatus:        object.ConfigurationStatusSucceeded,
+			annotations:                programmedAnnotations,
+			expectedUpdatedAnnotations: programmedAnnotations,
+			expectedUpdateNeeded:       false,
+		},
+		{
+			name:                "no annotations",
+			configurationStatus: object.ConfigurationStatusSucceeded,
+			annotations:         nil,
+			expectedUpdatedAnnotations: map[string]string{
+				statusKey:     "True",
+				reasonKey:     string(kongv1.ReasonProgrammed),
+				generationKey: "2",
+				configHashKey: "abc123",
+			},
+			expectedUpdateNeeded: true,
+		},
+		{
+			name:                "annotations present with older generation",
+			configurationStatus: object.ConfigurationStatusUnknown,
+			annotations: map[string]string{
+				"other":       "value",
+				statusKey:     "True",
+				reasonKey:     string(kongv1.ReasonProgrammed),
+				generationKey: "1",
+				configHashKey: "old",
+			},
+			expectedUpdatedAnnotations: map[string]string{
+				"other":       "value",
+				statusKey:     "False",
+				reasonKey:     string(kongv1.ReasonPending),
+				generationKey: "2",
+			},
+			expectedUpdateNeeded: true,
+		},
+		{
+			name:                "annotations present with a different configuration hash",
+			configurationStatus: object.ConfigurationStatusSucceeded,
+			annotations: map[string]string{
+				"other":       "value",
+				statusKey:     "True",
+				reasonKey:     string(kongv1.ReasonProgrammed),
+				generationKey: "2",
+				configHashKey: "old",
+			},
+			expectedUpdatedAnnotations: programmedAnnotations,
+			expectedUpdateNeeded:       true,
+		},
+		{
+			name:                "annotations present with different status",
+			configurationStatus: object.ConfigurationStatusFailed,
+			annotations:         programmedAnnotations,
+			expectedUpdatedAnnotations: map[string]string{
+				"other":       "value",
+				statusKey:     "False",
+				reasonKey:     string(kongv1.ReasonInvalid),
+				generationKey: "2",
+			},
+			expectedUpdateNeeded: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			original := maps.Clone(tc.annotations)
+			updated, updateNeeded := utils.EnsureProgrammedAnnotations(tc.configurationStatus, testObjectGeneration, "abc123", tc.annotations)
+			assert.Equal(t, tc.expectedUpdateNeeded, updateNeeded)
+			assert.Equal(t, tc.expectedUpdatedAnnotations, updated)
+			assert.Equal(t, original, tc.annotations, "input annotations should not be modified")
+		})
+	}
+}
+
+func TestIsProgrammedConditionNeeded(t *testing.T) {
+	programmedConditions := []metav1.Condition{
+		{
+			Type:   string(kongv1.ConditionProgrammed),
+			Status: metav1.ConditionTrue,
+			Reason: string(kongv1.ReasonProgrammed),
+		},
+	}
+
+	testCases := []struct {
+		name                string
+		configurationStatus object.ConfigurationStatus
+		conditions          []metav1.Condition
+		expected            bool
+	}{
+		{
+			name:                "succeeded without condition",
+			configurationStatus: object.ConfigurationStatusSucceeded,
+			expected:            true,
+		},
+		{
+			name:                "failed without condition",
+			configurationStatus: object.ConfigurationStatusFailed,
+			expected:            true,
+		},
+		{
+			name:                "unknown without condition",
+			configurationStatus: object.ConfigurationStatusUnknown,
+			expected:            false,
+		},
+		{
+			name:                "unknown with condition",
+			configurationStatus: object.ConfigurationStatusUnknown,
+			conditions:          programmedConditions,
+			expected:            true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, utils.IsProgrammedConditionNeeded(tc.configurationStatus, tc.conditions))
+		})
+	}
+}
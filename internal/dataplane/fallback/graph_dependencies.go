@@ -37,6 +37,8 @@ func ResolveDependencies(cache store.CacheStores, obj client.Object) ([]client.O
 		return resolveUDPRouteDependencies(cache, obj), nil
 	case *gatewayapi.GRPCRoute:
 		return resolveGRPCRouteDependencies(cache, obj), nil
+	case *gatewayapi.BackendTLSPolicy:
+		return resolveBackendTLSPolicyDependencies(cache, obj), nil
 	// Kong specific objects.
 	case *kongv1.KongPlugin:
 		return resolveKongPluginDependencies(cache, obj), nil
@@ -55,13 +57,16 @@ func ResolveDependencies(cache store.CacheStores, obj client.Object) ([]client.O
 	// Object types that have no dependencies.
 	case *netv1.IngressClass,
 		*corev1.Secret,
+		*corev1.ConfigMap,
 		*discoveryv1.EndpointSlice,
+		*corev1.Namespace,
 		*gatewayapi.ReferenceGrant,
 		*gatewayapi.Gateway,
 		*kongv1.KongIngress,
 		*kongv1beta1.KongUpstreamPolicy,
 		*kongv1alpha1.IngressClassParameters,
-		*kongv1alpha1.KongVault:
+		*kongv1alpha1.KongVault,
+		*incubatorv1alpha1.KongUpstreamTarget:
 		return nil, nil
 	case *kongv1alpha1.KongCustomEntity:
 		// TODO: KongCustomEnity is not supported in failure domain yet.
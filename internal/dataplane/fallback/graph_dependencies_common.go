@@ -56,3 +56,12 @@ func fetchSecret(cache store.CacheStores, nn k8stypes.NamespacedName) (client.Ob
 	}
 	return secret.(client.Object), true
 }
+
+// fetchConfigMap retrieves a ConfigMap object as client.Object from the cache.
+func fetchConfigMap(cache store.CacheStores, nn k8stypes.NamespacedName) (client.Object, bool) {
+	configMap, exists, err := cache.ConfigMap.GetByKey(nn.String())
+	if err != nil || !exists {
+		return nil, false
+	}
+	return configMap.(client.Object), true
+}
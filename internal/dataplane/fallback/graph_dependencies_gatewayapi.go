@@ -5,15 +5,17 @@ import (
 	"slices"
 
 	"github.com/samber/lo"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 // resolveHTTPRouteDependencies resolves potential dependencies for a given HTTPRoute object:
-// - Service
+// - Service, KongServiceFacade or KongUpstreamTarget (including RequestMirror filters' targets)
 // - KongPlugin
 // - KongClusterPlugin.
 func resolveHTTPRouteDependencies(cache store.CacheStores, route *gatewayapi.HTTPRoute) []client.Object {
@@ -24,7 +26,7 @@ func resolveHTTPRouteDependencies(cache store.CacheStores, route *gatewayapi.HTT
 }
 
 // resolveTCPRouteDependencies resolves potential dependencies for a given TCPRoute object:
-// - Service
+// - Service, KongServiceFacade or KongUpstreamTarget
 // - KongPlugin
 // - KongClusterPlugin.
 func resolveTCPRouteDependencies(cache store.CacheStores, route *gatewayapi.TCPRoute) []client.Object {
@@ -35,7 +37,7 @@ func resolveTCPRouteDependencies(cache store.CacheStores, route *gatewayapi.TCPR
 }
 
 // resolveUDPRouteDependencies resolves potential dependencies for a given UDPRoute object:
-// - Service
+// - Service, KongServiceFacade or KongUpstreamTarget
 // - KongPlugin
 // - KongClusterPlugin.
 func resolveUDPRouteDependencies(cache store.CacheStores, route *gatewayapi.UDPRoute) []client.Object {
@@ -46,7 +48,7 @@ func resolveUDPRouteDependencies(cache store.CacheStores, route *gatewayapi.UDPR
 }
 
 // resolveTLSRouteDependencies resolves potential dependencies for a given TLSRoute object:
-// - Service
+// - Service, KongServiceFacade or KongUpstreamTarget
 // - KongPlugin
 // - KongClusterPlugin.
 func resolveTLSRouteDependencies(cache store.CacheStores, route *gatewayapi.TLSRoute) []client.Object {
@@ -57,7 +59,7 @@ func resolveTLSRouteDependencies(cache store.CacheStores, route *gatewayapi.TLSR
 }
 
 // resolveGRPCRouteDependencies resolves potential dependencies for a given GRPCRoute object:
-// - Service
+// - Service, KongServiceFacade or KongUpstreamTarget
 // - KongPlugin
 // - KongClusterPlugin.
 func resolveGRPCRouteDependencies(cache store.CacheStores, route *gatewayapi.GRPCRoute) []client.Object {
@@ -84,20 +86,43 @@ func resolveGatewayAPIRouteDependenciesBackendRefs[T gatewayAPIRoute](cache stor
 		if backendRef.Namespace != nil {
 			ns = string(*backendRef.Namespace)
 		}
-		ingressClass, exists, err := cache.Service.GetByKey(fmt.Sprintf("%s/%s", ns, backendRef.Name))
+		key := fmt.Sprintf("%s/%s", ns, backendRef.Name)
+
+		// IsBackendRefGroupKindSupported guarantees the Group matches the Kind, so we can rely on the Kind only.
+		var (
+			backend any
+			exists  bool
+			err     error
+		)
+		switch *backendRef.Kind {
+		case incubatorv1alpha1.KongServiceFacadeKind:
+			backend, exists, err = cache.KongServiceFacade.GetByKey(key)
+		case incubatorv1alpha1.KongUpstreamTargetKind:
+			backend, exists, err = cache.KongUpstreamTarget.GetByKey(key)
+		default:
+			backend, exists, err = cache.Service.GetByKey(key)
+		}
 		if err == nil && exists {
-			dependencies = append(dependencies, ingressClass.(client.Object))
+			dependencies = append(dependencies, backend.(client.Object))
 		}
 	}
 	return dependencies
 }
 
+// getHTTPRouteBackendRefs returns backendRefs of all the HTTPRoute's rules including the ones used by
+// RequestMirror filters.
 func getHTTPRouteBackendRefs(route *gatewayapi.HTTPRoute) []gatewayapi.BackendRef {
 	var backendRefs []gatewayapi.BackendRef
 	for _, rule := range route.Spec.Rules {
 		backendRefs = append(backendRefs, lo.Map(rule.BackendRefs, func(b gatewayapi.HTTPBackendRef, _ int) gatewayapi.BackendRef {
 			return b.BackendRef
 		})...)
+		backendRefs = append(backendRefs, lo.FilterMap(rule.Filters, func(f gatewayapi.HTTPRouteFilter, _ int) (gatewayapi.BackendRef, bool) {
+			if f.Type != gatewayapi.HTTPRouteFilterRequestMirror || f.RequestMirror == nil {
+				return gatewayapi.BackendRef{}, false
+			}
+			return gatewayapi.BackendRef{BackendObjectReference: f.RequestMirror.BackendRef}, true
+		})...)
 	}
 	return backendRefs
 }
@@ -135,3 +160,36 @@ func getGRPCRouteBackendRefs(route *gatewayapi.GRPCRoute) []gatewayapi.BackendRe
 	}
 	return backendRefs
 }
+
+// resolveBackendTLSPolicyDependencies resolves potential dependencies for a given BackendTLSPolicy object:
+// - Service (targets)
+// - ConfigMap or Secret (CA certificates).
+func resolveBackendTLSPolicyDependencies(cache store.CacheStores, policy *gatewayapi.BackendTLSPolicy) []client.Object {
+	var dependencies []client.Object
+	for _, targetRef := range policy.Spec.TargetRefs {
+		if (targetRef.Group != "" && targetRef.Group != "core") || targetRef.Kind != "Service" {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s", policy.Namespace, targetRef.Name)
+		if svc, exists, err := cache.Service.GetByKey(key); err == nil && exists {
+			dependencies = append(dependencies, svc.(client.Object))
+		}
+	}
+	for _, ref := range policy.Spec.Validation.CACertificateRefs {
+		if ref.Group != "" {
+			continue
+		}
+		nn := k8stypes.NamespacedName{Namespace: policy.Namespace, Name: string(ref.Name)}
+		switch ref.Kind {
+		case "ConfigMap":
+			if configMap, ok := fetchConfigMap(cache, nn); ok {
+				dependencies = append(dependencies, configMap)
+			}
+		case "Secret":
+			if secret, ok := fetchSecret(cache, nn); ok {
+				dependencies = append(dependencies, secret)
+			}
+		}
+	}
+	return dependencies
+}
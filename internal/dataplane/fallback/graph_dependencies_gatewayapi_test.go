@@ -9,6 +9,7 @@ import (
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 func TestResolveDependencies_HTTPRoute(t *testing.T) {
@@ -72,6 +73,104 @@ func TestResolveDependencies_HTTPRoute(t *testing.T) {
 				testService(t, "2"),
 			},
 		},
+		{
+			name: "HTTPRoute -> Service (RequestMirror filter)",
+			object: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-route",
+					Namespace: "test-namespace",
+				},
+				Spec: gatewayapi.HTTPRouteSpec{
+					Rules: []gatewayapi.HTTPRouteRule{
+						{
+							BackendRefs: []gatewayapi.HTTPBackendRef{
+								{
+									BackendRef: gatewayapi.BackendRef{
+										BackendObjectReference: gatewayapi.BackendObjectReference{
+											Name: "1",
+											Kind: lo.ToPtr(gatewayapi.Kind("Service")),
+										},
+									},
+								},
+							},
+							Filters: []gatewayapi.HTTPRouteFilter{
+								{
+									Type: gatewayapi.HTTPRouteFilterRequestMirror,
+									RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+										BackendRef: gatewayapi.BackendObjectReference{
+											Name: "2",
+											Kind: lo.ToPtr(gatewayapi.Kind("Service")),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testService(t, "1"),
+				testService(t, "2"),
+			),
+			expected: []client.Object{
+				testService(t, "1"),
+				testService(t, "2"),
+			},
+		},
+		{
+			name: "HTTPRoute -> KongServiceFacade, KongUpstreamTarget",
+			object: &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-route",
+					Namespace: "test-namespace",
+				},
+				Spec: gatewayapi.HTTPRouteSpec{
+					Rules: []gatewayapi.HTTPRouteRule{
+						{
+							BackendRefs: []gatewayapi.HTTPBackendRef{
+								{
+									BackendRef: gatewayapi.BackendRef{
+										BackendObjectReference: gatewayapi.BackendObjectReference{
+											Name:  "1",
+											Group: lo.ToPtr(gatewayapi.Group(incubatorv1alpha1.GroupVersion.Group)),
+											Kind:  lo.ToPtr(gatewayapi.Kind(incubatorv1alpha1.KongServiceFacadeKind)),
+										},
+									},
+								},
+								{
+									BackendRef: gatewayapi.BackendRef{
+										BackendObjectReference: gatewayapi.BackendObjectReference{
+											Name:  "1",
+											Group: lo.ToPtr(gatewayapi.Group(incubatorv1alpha1.GroupVersion.Group)),
+											Kind:  lo.ToPtr(gatewayapi.Kind(incubatorv1alpha1.KongUpstreamTargetKind)),
+										},
+									},
+								},
+								{
+									BackendRef: gatewayapi.BackendRef{
+										BackendObjectReference: gatewayapi.BackendObjectReference{
+											Name:  "2",
+											Group: lo.ToPtr(gatewayapi.Group("unknown.group")),
+											Kind:  lo.ToPtr(gatewayapi.Kind(incubatorv1alpha1.KongUpstreamTargetKind)),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testService(t, "1"),
+				testKongServiceFacade(t, "1"),
+				testKongUpstreamTarget(t, "1"),
+				testKongUpstreamTarget(t, "2"),
+			),
+			expected: []client.Object{
+				testKongServiceFacade(t, "1"),
+				testKongUpstreamTarget(t, "1"),
+			},
+		},
 		{
 			name: "HTTPRoute -> KongPlugin, KongClusterPlugin",
 			object: &gatewayapi.HTTPRoute{
@@ -458,3 +557,65 @@ func TestResolveDependencies_GRPCRoute(t *testing.T) {
 		runResolveDependenciesTest(t, tc)
 	}
 }
+
+func TestResolveDependencies_BackendTLSPolicy(t *testing.T) {
+	testCases := []resolveDependenciesTestCase{
+		{
+			name: "no dependencies",
+			object: &gatewayapi.BackendTLSPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-policy",
+					Namespace: "test-namespace",
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testService(t, "1"),
+				testConfigMap(t, "1"),
+				testSecret(t, "1"),
+			),
+			expected: []client.Object{},
+		},
+		{
+			name: "BackendTLSPolicy -> Service, ConfigMap, Secret",
+			object: &gatewayapi.BackendTLSPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-policy",
+					Namespace: "test-namespace",
+				},
+				Spec: gatewayapi.BackendTLSPolicySpec{
+					TargetRefs: []gatewayapi.LocalPolicyTargetReferenceWithSectionName{
+						{
+							LocalPolicyTargetReference: gatewayapi.LocalPolicyTargetReference{
+								Kind: "Service",
+								Name: "1",
+							},
+						},
+					},
+					Validation: gatewayapi.BackendTLSPolicyValidation{
+						CACertificateRefs: []gatewayapi.LocalObjectReference{
+							{Kind: "ConfigMap", Name: "1"},
+							{Kind: "Secret", Name: "1"},
+						},
+					},
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testService(t, "1"),
+				testService(t, "2"),
+				testConfigMap(t, "1"),
+				testConfigMap(t, "2"),
+				testSecret(t, "1"),
+				testSecret(t, "2"),
+			),
+			expected: []client.Object{
+				testService(t, "1"),
+				testConfigMap(t, "1"),
+				testSecret(t, "1"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		runResolveDependenciesTest(t, tc)
+	}
+}
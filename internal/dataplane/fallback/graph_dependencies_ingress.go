@@ -53,26 +53,35 @@ func resolveIngressDependenciesIngressClass(cache store.CacheStores, ingress *ne
 	return dependencies
 }
 
-// resolveIngressDependenciesService resolves Service and KongServiceFacade dependencies for an Ingress object.
+// resolveIngressDependenciesService resolves Service and KongServiceFacade dependencies for an Ingress object
+// (both from its rules and its default backend).
 func resolveIngressDependenciesService(cache store.CacheStores, ingress *netv1.Ingress) []client.Object {
-	var dependencies []client.Object
+	var backends []netv1.IngressBackend
+	if ingress.Spec.DefaultBackend != nil {
+		backends = append(backends, *ingress.Spec.DefaultBackend)
+	}
 	for _, rule := range ingress.Spec.Rules {
 		if rule.HTTP == nil {
 			continue
 		}
 		for _, path := range rule.HTTP.Paths {
-			if path.Backend.Service != nil {
-				service, exists, err := cache.Service.GetByKey(fmt.Sprintf("%s/%s", ingress.GetNamespace(), path.Backend.Service.Name))
-				if err == nil && exists {
-					dependencies = append(dependencies, service.(client.Object))
-				}
+			backends = append(backends, path.Backend)
+		}
+	}
+
+	var dependencies []client.Object
+	for _, backend := range backends {
+		if backend.Service != nil {
+			service, exists, err := cache.Service.GetByKey(fmt.Sprintf("%s/%s", ingress.GetNamespace(), backend.Service.Name))
+			if err == nil && exists {
+				dependencies = append(dependencies, service.(client.Object))
 			}
+		}
 
-			if resource := path.Backend.Resource; resource != nil && subtranslator.IsKongServiceFacade(resource) {
-				kongServiceFacade, exists, err := cache.KongServiceFacade.GetByKey(fmt.Sprintf("%s/%s", ingress.GetNamespace(), resource.Name))
-				if err == nil && exists {
-					dependencies = append(dependencies, kongServiceFacade.(client.Object))
-				}
+		if resource := backend.Resource; resource != nil && subtranslator.IsKongServiceFacade(resource) {
+			kongServiceFacade, exists, err := cache.KongServiceFacade.GetByKey(fmt.Sprintf("%s/%s", ingress.GetNamespace(), resource.Name))
+			if err == nil && exists {
+				dependencies = append(dependencies, kongServiceFacade.(client.Object))
 			}
 		}
 	}
@@ -158,6 +158,51 @@ func TestResolveDependencies_Ingress(t *testing.T) {
 				testKongServiceFacade(t, "2"),
 			},
 		},
+		{
+			name: "Ingress -> default backend Service, KongServiceFacade",
+			object: &netv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-ingress",
+					Namespace: "test-namespace",
+				},
+				Spec: netv1.IngressSpec{
+					DefaultBackend: &netv1.IngressBackend{
+						Service: &netv1.IngressServiceBackend{
+							Name: "1",
+						},
+					},
+					Rules: []netv1.IngressRule{
+						{
+							IngressRuleValue: netv1.IngressRuleValue{
+								HTTP: &netv1.HTTPIngressRuleValue{
+									Paths: []netv1.HTTPIngressPath{
+										{
+											Backend: netv1.IngressBackend{
+												Resource: &corev1.TypedLocalObjectReference{
+													Name:     "2",
+													Kind:     "KongServiceFacade",
+													APIGroup: lo.ToPtr(incubatorv1alpha1.SchemeGroupVersion.Group),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testService(t, "1"),
+				testService(t, "2"),
+				testKongServiceFacade(t, "1"),
+				testKongServiceFacade(t, "2"),
+			),
+			expected: []client.Object{
+				testService(t, "1"),
+				testKongServiceFacade(t, "2"),
+			},
+		},
 		{
 			name: "Ingress -> KongPlugin, KongClusterPlugin",
 			object: &netv1.Ingress{
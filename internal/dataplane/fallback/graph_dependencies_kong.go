@@ -14,63 +14,69 @@ import (
 )
 
 // resolveKongPluginDependencies resolves potential dependencies for a KongPlugin object:
-// - Secret.
+// - Secret
+// - ConfigMap.
 func resolveKongPluginDependencies(cache store.CacheStores, kongPlugin *kongv1.KongPlugin) []client.Object {
 	var dependencies []client.Object
 	if cf := kongPlugin.ConfigFrom; cf != nil {
-		if s, ok := fetchSecret(
-			cache,
-			k8stypes.NamespacedName{
-				Namespace: kongPlugin.Namespace,
-				Name:      cf.SecretValue.Secret,
-			},
-		); ok {
-			dependencies = append(dependencies, s)
+		if obj, ok := fetchConfigSource(cache, kongPlugin.Namespace, *cf); ok {
+			dependencies = append(dependencies, obj)
 		}
 	}
 	for _, cp := range kongPlugin.ConfigPatches {
-		if s, ok := fetchSecret(
-			cache,
-			k8stypes.NamespacedName{
-				Namespace: kongPlugin.Namespace,
-				Name:      cp.ValueFrom.SecretValue.Secret,
-			},
-		); ok {
-			dependencies = append(dependencies, s)
+		if obj, ok := fetchConfigSource(cache, kongPlugin.Namespace, cp.ValueFrom); ok {
+			dependencies = append(dependencies, obj)
 		}
 	}
 	return dependencies
 }
 
 // resolveKongClusterPluginDependencies resolves potential dependencies for a KongClusterPlugin object:
-// - Secret.
+// - Secret
+// - ConfigMap.
 func resolveKongClusterPluginDependencies(cache store.CacheStores, kongClusterPlugin *kongv1.KongClusterPlugin) []client.Object {
 	var dependencies []client.Object
 	if cf := kongClusterPlugin.ConfigFrom; cf != nil {
-		if s, ok := fetchSecret(
-			cache,
-			k8stypes.NamespacedName{
-				Namespace: cf.SecretValue.Namespace,
-				Name:      cf.SecretValue.Secret,
-			},
-		); ok {
-			dependencies = append(dependencies, s)
+		if obj, ok := fetchNamespacedConfigSource(cache, *cf); ok {
+			dependencies = append(dependencies, obj)
 		}
 	}
 	for _, cp := range kongClusterPlugin.ConfigPatches {
-		if s, ok := fetchSecret(
-			cache,
-			k8stypes.NamespacedName{
-				Namespace: cp.ValueFrom.SecretValue.Namespace,
-				Name:      cp.ValueFrom.SecretValue.Secret,
-			},
-		); ok {
-			dependencies = append(dependencies, s)
+		if obj, ok := fetchNamespacedConfigSource(cache, cp.ValueFrom); ok {
+			dependencies = append(dependencies, obj)
 		}
 	}
 	return dependencies
 }
 
+// fetchConfigSource retrieves the Secret or the ConfigMap in the namespace referenced by a KongPlugin's
+// configuration source from the cache.
+func fetchConfigSource(
+	cache store.CacheStores,
+	namespace string,
+	source kongv1.ConfigSource,
+) (client.Object, bool) {
+	if source.ConfigMapValue != nil {
+		return fetchConfigMap(cache, k8stypes.NamespacedName{Namespace: namespace, Name: source.ConfigMapValue.ConfigMap})
+	}
+	return fetchSecret(cache, k8stypes.NamespacedName{Namespace: namespace, Name: source.SecretValue.Secret})
+}
+
+// fetchNamespacedConfigSource retrieves the Secret or the ConfigMap referenced by a KongClusterPlugin's
+// configuration source from the cache.
+func fetchNamespacedConfigSource(cache store.CacheStores, source kongv1.NamespacedConfigSource) (client.Object, bool) {
+	if source.ConfigMapValue != nil {
+		return fetchConfigMap(cache, k8stypes.NamespacedName{
+			Namespace: source.ConfigMapValue.Namespace,
+			Name:      source.ConfigMapValue.ConfigMap,
+		})
+	}
+	return fetchSecret(cache, k8stypes.NamespacedName{
+		Namespace: source.SecretValue.Namespace,
+		Name:      source.SecretValue.Secret,
+	})
+}
+
 // resolveKongConsumerDependencies resolves potential dependencies for a KongConsumer object:
 // - KongPlugin
 // - KongClusterPlugin
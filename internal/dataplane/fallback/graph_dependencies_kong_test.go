@@ -104,6 +104,56 @@ func TestResolveDependencies_KongPlugin(t *testing.T) {
 			),
 			expected: []client.Object{testSecret(t, "1"), testSecret(t, "2")},
 		},
+		{
+			name: "KongPlugin -> ConfigMap referenced by ConfigFrom",
+			object: &kongv1.KongPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-KongPlugin",
+					Namespace: testNamespace,
+				},
+				ConfigFrom: &kongv1.ConfigSource{
+					ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+						ConfigMap: "1",
+					},
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testConfigMap(t, "1"),
+				testSecret(t, "1"),
+			),
+			expected: []client.Object{testConfigMap(t, "1")},
+		},
+		{
+			name: "KongPlugin -> ConfigMap and Secret referenced by ConfigPatches",
+			object: &kongv1.KongPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-KongPlugin",
+					Namespace: testNamespace,
+				},
+				ConfigPatches: []kongv1.ConfigPatch{
+					{
+						ValueFrom: kongv1.ConfigSource{
+							ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+								ConfigMap: "1",
+							},
+						},
+					},
+					{
+						ValueFrom: kongv1.ConfigSource{
+							SecretValue: kongv1.SecretValueFromSource{
+								Secret: "2",
+							},
+						},
+					},
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testConfigMap(t, "1"),
+				testConfigMap(t, "2"),
+				testSecret(t, "2"),
+			),
+			expected: []client.Object{testConfigMap(t, "1"), testSecret(t, "2")},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -147,6 +197,25 @@ func TestResolveDependencies_KongClusterPlugin(t *testing.T) {
 			),
 			expected: []client.Object{testSecret(t, "1")},
 		},
+		{
+			name: "KongClusterPlugin -> ConfigMap referenced by ConfigFrom",
+			object: &kongv1.KongClusterPlugin{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-KongClusterPlugin",
+				},
+				ConfigFrom: &kongv1.NamespacedConfigSource{
+					ConfigMapValue: &kongv1.NamespacedConfigMapValueFromSource{
+						Namespace: testNamespace,
+						ConfigMap: "1",
+					},
+				},
+			},
+			cache: cacheStoresFromObjs(t,
+				testConfigMap(t, "1"),
+				testConfigMap(t, "2"),
+			),
+			expected: []client.Object{testConfigMap(t, "1")},
+		},
 		{
 			name: "KongClusterPlugin -> Secret referenced by ConfigFrom does not exists",
 			object: &kongv1.KongClusterPlugin{
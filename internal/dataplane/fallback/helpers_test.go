@@ -57,6 +57,15 @@ func testSecret(t *testing.T, name string, modifiers ...func(s *corev1.Secret))
 	return s
 }
 
+func testConfigMap(t *testing.T, name string) *corev1.ConfigMap {
+	return helpers.WithTypeMeta(t, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+		},
+	})
+}
+
 func testKongServiceFacade(t *testing.T, name string) *incubatorv1alpha1.KongServiceFacade {
 	return helpers.WithTypeMeta(t, &incubatorv1alpha1.KongServiceFacade{
 		ObjectMeta: metav1.ObjectMeta{
@@ -66,6 +75,15 @@ func testKongServiceFacade(t *testing.T, name string) *incubatorv1alpha1.KongSer
 	})
 }
 
+func testKongUpstreamTarget(t *testing.T, name string) *incubatorv1alpha1.KongUpstreamTarget {
+	return helpers.WithTypeMeta(t, &incubatorv1alpha1.KongUpstreamTarget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+		},
+	})
+}
+
 func testKongPlugin(t *testing.T, name string) *kongv1.KongPlugin {
 	return helpers.WithTypeMeta(t, &kongv1.KongPlugin{
 		ObjectMeta: metav1.ObjectMeta{
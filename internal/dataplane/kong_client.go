@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"reflect"
 	"slices"
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -180,6 +182,34 @@ type KongClient struct {
 	// While lastProcessedSnapshotHash keeps track of the last processed cache snapshot (the one kept in KongClient.cache),
 	// lastValidCacheSnapshot can also represent the fallback cache snapshot that was successfully synced with gateways.
 	lastValidCacheSnapshot *store.CacheStores
+
+	// pushDeferred is set when a configuration push to any of the gateways was deferred by the adaptive push
+	// scheduler during the current sync.
+	pushDeferred atomic.Bool
+
+	// workspaceClientFactory creates clients for Kong workspaces assigned to namespaces. It's set only when
+	// the `MultiWorkspace` feature gate is turned on.
+	workspaceClientFactory WorkspaceClientFactory
+
+	// workspaceClients are clients of Kong workspaces assigned to namespaces by workspaces' names.
+	workspaceClients map[string]*adminapi.Client
+
+	// workspacesSHAs are configuration hashes sent in the last sync by workspaces' names.
+	workspacesSHAs map[string]string
+
+	// workspacesDiscovered tells whether workspaces configured before the controller started were discovered.
+	workspacesDiscovered bool
+
+	// discoveredWorkspaces are workspaces configured before the controller started that are yet to be cleaned up
+	// unless they get assigned to a namespace.
+	discoveredWorkspaces []string
+
+	// syncEvent records the outcome of the current sync. It's nil when sync events are disabled.
+	syncEvent *syncEventRecorder
+
+	// certificateExpiryWarningPeriod is the period before certificates' expiry in which Warning Events are recorded
+	// on objects referring to them. Warnings are disabled when it's zero.
+	certificateExpiryWarningPeriod time.Duration
 }
 
 // NewKongClient provides a new KongClient object after connecting to the
@@ -405,6 +435,14 @@ func (c *KongClient) KubernetesObjectConfigurationStatus(obj client.Object) k8so
 	return c.kubernetesObjectReportsFilter.Get(obj)
 }
 
+// KubernetesObjectsConfigurationHash returns the hash of the configuration that the most recent
+// configuration statuses of Kubernetes objects were reported for.
+func (c *KongClient) KubernetesObjectsConfigurationHash() string {
+	c.kubernetesObjectReportLock.RLock()
+	defer c.kubernetesObjectReportLock.RUnlock()
+	return c.kubernetesObjectReportsFilter.ConfigHash()
+}
+
 // -----------------------------------------------------------------------------
 // Dataplane Client - Kong - Interface Implementation
 // -----------------------------------------------------------------------------
@@ -423,8 +461,9 @@ func (c *KongClient) Update(ctx context.Context) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	// If Kong is running in dbless mode, we can fetch and store the last good configuration.
-	if c.dbmode.IsDBLessMode() {
+	// If Kong is running in dbless mode, we can fetch and store the last good configuration. It's not done when
+	// GatewaySharding is enabled as configuration loaded by a single gateway doesn't cover all the Gateways.
+	if c.dbmode.IsDBLessMode() && !c.kongConfig.GatewaySharding {
 		// Fetch the last valid configuration from the proxy only in case there is no valid
 		// configuration already stored in memory. This can happen when KIC restarts and there
 		// already is a Kong Proxy with a valid configuration loaded.
@@ -463,8 +502,15 @@ func (c *KongClient) Update(ctx context.Context) error {
 		c.kongConfigBuilder.UpdateCache(cacheSnapshot)
 	}
 
+	c.startSyncEvent()
+	defer c.maybeSendSyncEvent()
+
 	c.logger.V(util.DebugLevel).Info("Parsing kubernetes objects into data-plane configuration")
 	parsingResult := c.kongConfigBuilder.BuildKongConfig()
+	c.syncEvent.recordTranslationFailures(parsingResult.TranslationFailures)
+	c.maybeSendObjectsProvenanceDiagnostics(ctx, parsingResult.ObjectsProvenance)
+	c.maybeAssociateGatewayClients(parsingResult.GatewaysPods)
+	c.recordCertificateInventory(parsingResult.CertificateInventory)
 	if failuresCount := len(parsingResult.TranslationFailures); failuresCount > 0 {
 		c.prometheusMetrics.RecordTranslationFailure()
 		c.prometheusMetrics.RecordTranslationBrokenResources(failuresCount)
@@ -477,14 +523,16 @@ func (c *KongClient) Update(ctx context.Context) error {
 	}
 
 	const isFallback = false
-	shas, gatewaysSyncErr := c.sendOutToGatewayClients(ctx, parsingResult.KongState, c.kongConfig, isFallback)
+	shas, pushDeferred, gatewaysSyncErr := c.sendOutToGatewayClients(ctx, parsingResult.KongState, c.kongConfig, isFallback)
 	konnectSyncErr := c.maybeSendOutToKonnectClient(ctx, parsingResult.KongState, c.kongConfig, isFallback)
+	previousWorkspacesSHAs := maps.Clone(c.workspacesSHAs)
+	workspacesSyncErrs := c.maybeSendOutToWorkspaces(ctx, parsingResult.WorkspacesKongStates, c.kongConfig)
 
 	// Taking into account the results of syncing configuration with Gateways and Konnect, and potential translation
 	// failures, calculate the config status and update it.
 	c.updateConfigStatus(ctx, clients.CalculateConfigStatus(
 		clients.CalculateConfigStatusInput{
-			GatewaysFailed:              gatewaysSyncErr != nil,
+			GatewaysFailed:              gatewaysSyncErr != nil || len(workspacesSyncErrs) > 0,
 			KonnectFailed:               konnectSyncErr != nil,
 			TranslationFailuresOccurred: len(parsingResult.TranslationFailures) > 0,
 		},
@@ -504,17 +552,28 @@ func (c *KongClient) Update(ctx context.Context) error {
 		return gatewaysSyncErr
 	}
 
+	// Pushes to some gateways were deferred, so they're not synced with the current configuration yet. Neither
+	// the last valid cache snapshot nor the status of Kubernetes objects can be updated until the deferred pushes
+	// are retried in the next sync.
+	if pushDeferred {
+		c.logger.V(util.DebugLevel).Info("Configuration push was deferred; resource status update not possible, skipping")
+		return nil
+	}
+
 	// Gateways were successfully synced with the current configuration, so we can update the last valid cache snapshot.
 	c.maybePreserveTheLastValidConfigCache(cacheSnapshot)
 
 	// report on configured Kubernetes objects if enabled
 	if c.AreKubernetesObjectReportsEnabled() {
 		// if the configuration SHAs that have just been pushed are different than
-		// what's been previously pushed.
-		if !slices.Equal(shas, c.SHAs) {
+		// what's been previously pushed (or any workspace failed to be configured).
+		if !slices.Equal(shas, c.SHAs) || !maps.Equal(previousWorkspacesSHAs, c.workspacesSHAs) || len(workspacesSyncErrs) > 0 {
+			configuredObjects, workspacesFailures := workspacesSyncFailures(
+				parsingResult.ConfiguredKubernetesObjects, parsingResult.NamespacesWorkspaces, workspacesSyncErrs,
+			)
 			c.logger.V(util.DebugLevel).Info("Triggering report for configured Kubernetes objects", "count",
-				len(parsingResult.ConfiguredKubernetesObjects))
-			c.triggerKubernetesObjectReport(parsingResult.ConfiguredKubernetesObjects, parsingResult.TranslationFailures)
+				len(configuredObjects))
+			c.triggerKubernetesObjectReport(configuredObjects, append(parsingResult.TranslationFailures, workspacesFailures...), configHash(shas))
 		} else {
 			c.logger.V(util.DebugLevel).Info("No configuration change; resource status update not necessary, skipping")
 		}
@@ -547,9 +606,11 @@ func (c *KongClient) maybeTryRecoveringFromGatewaysSyncError(
 	cacheSnapshot store.CacheStores,
 	gatewaysSyncErr error,
 ) error {
-	// If the error is not of the expected UpdateError type, we should log it and skip the recovery.
+	// If the error is not of the expected UpdateError type, we should log it and skip the recovery. Failed canary
+	// rollouts are an exception as canaries have to be rolled back not to serve the configuration alone.
 	updateErr := sendconfig.UpdateError{}
-	if !errors.As(gatewaysSyncErr, &updateErr) {
+	isCanaryRolloutErr := errors.As(gatewaysSyncErr, &canaryRolloutError{})
+	if !errors.As(gatewaysSyncErr, &updateErr) && !isCanaryRolloutErr {
 		c.logger.V(util.DebugLevel).Info("Skipping recovery from gateways sync error - not enough details to recover",
 			"error", gatewaysSyncErr)
 		return nil
@@ -573,7 +634,12 @@ func (c *KongClient) maybeTryRecoveringFromGatewaysSyncError(
 	// apply the last valid configuration to the gateways.
 	if state, found := c.kongConfigFetcher.LastValidConfig(); found {
 		const isFallback = true
-		if _, fallbackSyncErr := c.sendOutToGatewayClients(ctx, state, c.kongConfig, isFallback); fallbackSyncErr != nil {
+		start := time.Now()
+		_, _, fallbackSyncErr := c.sendOutToGatewayClients(ctx, state, c.kongConfig, isFallback)
+		if isCanaryRolloutErr {
+			c.prometheusMetrics.RecordConfigRolloutPhase(metrics.RolloutPhaseRollback, time.Since(start), fallbackSyncErr)
+		}
+		if fallbackSyncErr != nil {
 			return errors.Join(gatewaysSyncErr, fallbackSyncErr)
 		}
 		c.logger.V(util.DebugLevel).Info("Due to errors in the current config, the last valid config has been pushed to Gateways")
@@ -594,6 +660,7 @@ func (c *KongClient) tryRecoveringWithFallbackConfiguration(
 		return fmt.Errorf("failed to generate fallback configuration: %w", err)
 	}
 	c.logFallbackCacheMetadata(generatedCacheMetadata)
+	c.syncEvent.recordFallbackCacheMetadata(generatedCacheMetadata)
 	if err := c.maybeSendFallbackConfigDiagnostics(ctx, generatedCacheMetadata); err != nil {
 		return fmt.Errorf("failed to send fallback configuration diagnostics: %w", err)
 	}
@@ -601,6 +668,7 @@ func (c *KongClient) tryRecoveringWithFallbackConfiguration(
 	// Update the KongConfigBuilder with the fallback configuration and build the KongConfig.
 	c.kongConfigBuilder.UpdateCache(fallbackCache)
 	fallbackParsingResult := c.kongConfigBuilder.BuildKongConfig()
+	c.maybeSendObjectsProvenanceDiagnostics(ctx, fallbackParsingResult.ObjectsProvenance)
 
 	if failuresCount := len(fallbackParsingResult.TranslationFailures); failuresCount > 0 {
 		c.recordResourceFailureEvents(fallbackParsingResult.TranslationFailures, FallbackKongConfigurationTranslationFailedEventReason)
@@ -613,7 +681,7 @@ func (c *KongClient) tryRecoveringWithFallbackConfiguration(
 	}
 
 	const isFallback = true
-	_, gatewaysSyncErr := c.sendOutToGatewayClients(ctx, fallbackParsingResult.KongState, c.kongConfig, isFallback)
+	_, _, gatewaysSyncErr := c.sendOutToGatewayClients(ctx, fallbackParsingResult.KongState, c.kongConfig, isFallback)
 	if gatewaysSyncErr != nil {
 		return fmt.Errorf("failed to sync fallback configuration with gateways: %w", gatewaysSyncErr)
 	}
@@ -664,13 +732,14 @@ func extractBrokenObjectsFromUpdateError(err sendconfig.UpdateError) []fallback.
 }
 
 // sendOutToGatewayClients will generate deck content (config) from the provided kong state
-// and send it out to each of the configured gateway clients.
+// and send it out to each of the configured gateway clients. It returns true if a push to any of the gateways
+// was deferred by the adaptive push scheduler, in which case the configuration is not stored as the last valid one.
 func (c *KongClient) sendOutToGatewayClients(
 	ctx context.Context,
 	s *kongstate.KongState,
 	config sendconfig.Config,
 	isFallback bool,
-) ([]string, error) {
+) (previousSHAs []string, pushDeferred bool, err error) {
 	gatewayClients := c.clientsProvider.GatewayClients()
 	if len(gatewayClients) == 0 {
 		c.logger.Error(
@@ -678,18 +747,23 @@ func (c *KongClient) sendOutToGatewayClients(
 			"Could not send configuration to gateways",
 		)
 		// Should not store the configuration in last valid config because the configuration is not validated on Kong gateway.
-		return c.SHAs, nil
+		return c.SHAs, false, nil
 	}
 
 	gatewayClientsToConfigure := c.clientsProvider.GatewayClientsToConfigure()
+	c.pushDeferred.Store(false)
 	configureGatewayClientURLs := lo.Map(gatewayClientsToConfigure, func(cl *adminapi.Client, _ int) string { return cl.BaseRootURL() })
 	c.logger.V(util.DebugLevel).Info("Sending configuration to gateway clients", "urls", configureGatewayClientURLs)
 
-	shas, err := iter.MapErr(gatewayClientsToConfigure, func(client **adminapi.Client) (string, error) {
-		return c.sendToClient(ctx, *client, s, config, isFallback)
-	})
+	var shas []string
+	// Fallback configurations are meant to recover gateways as soon as possible, hence they're never rolled out progressively.
+	if rolloutStrategy := c.resolveRolloutStrategy(); rolloutStrategy != nil && !isFallback {
+		shas, err = c.rolloutToGatewayClients(ctx, rolloutStrategy, gatewayClientsToConfigure, s, config)
+	} else {
+		shas, err = c.sendToGatewayClients(ctx, gatewayClientsToConfigure, s, config, isFallback)
+	}
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	// After a successful configuration update in DB mode,
@@ -703,13 +777,112 @@ func (c *KongClient) sendOutToGatewayClients(
 		}
 	}
 
-	previousSHAs := c.SHAs
+	previousSHAs = c.SHAs
 	sort.Strings(shas)
 	c.SHAs = shas
 
+	// Pushes to some gateways were deferred, so the configuration wasn't validated by all of them yet and can't be
+	// stored as the last valid one. The processed snapshot hash is reset to retry the deferred pushes in the next sync
+	// even if the cache doesn't change in the meantime.
+	if c.pushDeferred.Load() {
+		c.lastProcessedSnapshotHash = store.SnapshotHashEmpty
+		return previousSHAs, true, nil
+	}
+
 	c.kongConfigFetcher.StoreLastValidConfig(s)
 
-	return previousSHAs, nil
+	return previousSHAs, false, nil
+}
+
+// sendToGatewayClients sends out the configuration to all the given gateway clients in parallel.
+func (c *KongClient) sendToGatewayClients(
+	ctx context.Context,
+	gatewayClients []*adminapi.Client,
+	s *kongstate.KongState,
+	config sendconfig.Config,
+	isFallback bool,
+) ([]string, error) {
+	return iter.MapErr(gatewayClients, func(client **adminapi.Client) (string, error) {
+		return c.sendToClient(ctx, *client, c.stateForGatewayClient(*client, s), config, isFallback)
+	})
+}
+
+// resolveRolloutStrategy returns the RolloutStrategy if the update strategy resolver supports progressive rollouts
+// and one is configured. Otherwise, it returns nil.
+func (c *KongClient) resolveRolloutStrategy() sendconfig.RolloutStrategy {
+	if resolver, ok := c.updateStrategyResolver.(sendconfig.RolloutStrategyResolver); ok {
+		return resolver.ResolveRolloutStrategy()
+	}
+	return nil
+}
+
+// rolloutToGatewayClients sends out the configuration to canary gateway clients first, waits for them to become ready
+// and soak, and only then sends it out to the remaining gateway clients. Canaries are waited for only when their
+// configuration changed. If configuring canaries fails, a canaryRolloutError is returned and the remaining gateway
+// clients are not configured at all (canaries are rolled back in maybeTryRecoveringFromGatewaysSyncError).
+func (c *KongClient) rolloutToGatewayClients(
+	ctx context.Context,
+	rolloutStrategy sendconfig.RolloutStrategy,
+	gatewayClients []*adminapi.Client,
+	s *kongstate.KongState,
+	config sendconfig.Config,
+) ([]string, error) {
+	// Sort the clients so that the same gateways are picked as canaries in consecutive rollouts.
+	gatewayClients = slices.Clone(gatewayClients)
+	slices.SortFunc(gatewayClients, func(a, b *adminapi.Client) int {
+		return strings.Compare(a.BaseRootURL(), b.BaseRootURL())
+	})
+	const isFallback = false
+	canariesCount := rolloutStrategy.CanariesCount(len(gatewayClients))
+	if canariesCount >= len(gatewayClients) {
+		return c.sendToGatewayClients(ctx, gatewayClients, s, config, isFallback)
+	}
+	canaries, remaining := gatewayClients[:canariesCount], gatewayClients[canariesCount:]
+	previousCanariesSHAs := lo.Map(canaries, func(cl *adminapi.Client, _ int) string { return string(cl.LastConfigSHA()) })
+
+	start := time.Now()
+	canariesSHAs, err := c.sendToGatewayClients(ctx, canaries, s, config, isFallback)
+	c.prometheusMetrics.RecordConfigRolloutPhase(metrics.RolloutPhaseCanary, time.Since(start), err)
+	if err == nil && !slices.Equal(previousCanariesSHAs, canariesSHAs) {
+		c.logger.V(util.DebugLevel).Info("Waiting for canary gateway clients to become ready",
+			"canaries", lo.Map(canaries, func(cl *adminapi.Client, _ int) string { return cl.BaseRootURL() }),
+		)
+		start = time.Now()
+		err = rolloutStrategy.WaitForCanaries(ctx, lo.Map(canaries, func(cl *adminapi.Client, _ int) sendconfig.ReadinessClient {
+			return cl
+		}))
+		c.prometheusMetrics.RecordConfigRolloutPhase(metrics.RolloutPhaseSoak, time.Since(start), err)
+	}
+	if err != nil {
+		// Canaries' configuration is forgotten so that they're configured and waited for again in the next sync
+		// even if rolling them back fails.
+		for _, cl := range canaries {
+			cl.SetLastConfigSHA(nil)
+		}
+		return nil, canaryRolloutError{err: err}
+	}
+
+	start = time.Now()
+	remainingSHAs, err := c.sendToGatewayClients(ctx, remaining, s, config, isFallback)
+	c.prometheusMetrics.RecordConfigRolloutPhase(metrics.RolloutPhaseRemaining, time.Since(start), err)
+	if err != nil {
+		return nil, err
+	}
+	return append(canariesSHAs, remainingSHAs...), nil
+}
+
+// canaryRolloutError is returned when canary gateways rejected the configuration or didn't become ready after
+// being configured. The remaining gateways were not configured.
+type canaryRolloutError struct {
+	err error
+}
+
+func (e canaryRolloutError) Error() string {
+	return fmt.Sprintf("canary configuration rollout failed, remaining gateways were not configured: %s", e.err)
+}
+
+func (e canaryRolloutError) Unwrap() error {
+	return e.err
 }
 
 // maybeSendOutToKonnectClient sends out the configuration to Konnect when KonnectClient is provided.
@@ -770,8 +943,24 @@ func (c *KongClient) sendToClient(
 	s *kongstate.KongState,
 	config sendconfig.Config,
 	isFallback bool,
+) (string, error) {
+	return c.sendToClientInWorkspace(ctx, client, "", s, config, isFallback)
+}
+
+// sendToClientInWorkspace sends the configuration to the client. Workspace is non-empty only for clients scoped
+// to Kong workspaces assigned to namespaces and is used to distinguish their diagnostics.
+func (c *KongClient) sendToClientInWorkspace(
+	ctx context.Context,
+	client sendconfig.AdminAPIClient,
+	workspace string,
+	s *kongstate.KongState,
+	config sendconfig.Config,
+	isFallback bool,
 ) (string, error) {
 	logger := c.logger.WithValues("url", client.AdminAPIClient().BaseRootURL())
+	if workspace != "" {
+		logger = logger.WithValues("workspace", workspace)
+	}
 
 	// If the client is Konnect and the feature flag is turned on,
 	// we should sanitize the configuration before sending it out.
@@ -809,6 +998,23 @@ func (c *KongClient) sendToClient(
 		c.configChangeDetector,
 		isFallback,
 	)
+	syncResult := diagnostics.ClientSyncResult{
+		URL:       client.BaseRootURL(),
+		Workspace: workspace,
+		Konnect:   client.IsKonnect(),
+		Hash:      string(newConfigSHA),
+		Fallback:  isFallback,
+	}
+	// A deferred push is neither a success nor a failure - the gateway keeps its current configuration
+	// and the push will be retried in the next sync.
+	if errors.As(err, &sendconfig.PushDeferredError{}) {
+		c.pushDeferred.Store(true)
+		syncResult.Hash, syncResult.Deferred = string(client.LastConfigSHA()), true
+		c.syncEvent.recordClientResult(syncResult)
+		return string(client.LastConfigSHA()), nil
+	}
+	syncResult.Err = err
+	c.syncEvent.recordClientResult(syncResult)
 	// Only record events on applying configuration to Kong gateway here.
 	// Nil error is expected to be passed to indicate success.
 	if !client.IsKonnect() {
@@ -830,20 +1036,29 @@ func (c *KongClient) sendToClient(
 		if errors.As(err, &responseParsingErr) {
 			rawResponseBody = responseParsingErr.ResponseBody()
 		}
-		sendDiagnostic(diagnostics.DumpMeta{Failed: true, Hash: string(newConfigSHA)}, rawResponseBody)
+		sendDiagnostic(diagnostics.DumpMeta{Failed: true, Hash: string(newConfigSHA), Workspace: workspace}, rawResponseBody)
 
 		if err := ctx.Err(); err != nil {
 			logger.Error(err, "Exceeded Kong API timeout, consider increasing --proxy-timeout-seconds")
 		}
 		return "", fmt.Errorf("performing update for %s failed: %w", client.BaseRootURL(), err)
 	}
-	sendDiagnostic(diagnostics.DumpMeta{Failed: false, Hash: string(newConfigSHA)}, nil) // No error occurred.
+	sendDiagnostic(diagnostics.DumpMeta{Failed: false, Hash: string(newConfigSHA), Workspace: workspace}, nil) // No error occurred.
 	// update the lastConfigSHA with the new updated checksum
 	client.SetLastConfigSHA(newConfigSHA)
 
 	return string(newConfigSHA), nil
 }
 
+// PushSchedulerStatuses returns the state of the adaptive push scheduler for every gateway. It returns nil if
+// adaptive push scheduling is not enabled.
+func (c *KongClient) PushSchedulerStatuses() []sendconfig.GatewayPushStatus {
+	if provider, ok := c.updateStrategyResolver.(sendconfig.PushSchedulerStatusProvider); ok {
+		return provider.PushSchedulerStatuses()
+	}
+	return nil
+}
+
 // SetConfigStatusNotifier sets a notifier which notifies subscribers about configuration sending results.
 // Currently it is used for uploading the node status to konnect control plane.
 func (c *KongClient) SetConfigStatusNotifier(n clients.ConfigStatusNotifier) {
@@ -870,7 +1085,7 @@ func prepareSendDiagnosticFn(
 	deckGenParams deckgen.GenerateDeckContentParams,
 	isFallback bool,
 ) sendDiagnosticFn {
-	if diagnosticConfig == (diagnostics.ConfigDumpDiagnostic{}) {
+	if diagnosticConfig.Configs == nil {
 		// noop, diagnostics won't be sent
 		return func(diagnostics.DumpMeta, []byte) {}
 	}
@@ -897,8 +1112,9 @@ func prepareSendDiagnosticFn(
 		select {
 		case diagnosticConfig.Configs <- diagnostics.ConfigDump{
 			Meta: diagnostics.DumpMeta{
-				Failed:   meta.Failed,
-				Fallback: isFallback,
+				Failed:    meta.Failed,
+				Fallback:  isFallback,
+				Workspace: meta.Workspace,
 			},
 			Config:          *config,
 			RawResponseBody: rawResponseBody,
@@ -914,10 +1130,15 @@ func prepareSendDiagnosticFn(
 // enables filtering for which objects are currently applied to the data-plane,
 // as well as updating the c.kubernetesObjectStatusQueue to queue those objects
 // for reconciliation so their statuses can be properly updated.
-func (c *KongClient) triggerKubernetesObjectReport(reportedObjects []client.Object, translationFailures []failures.ResourceFailure) {
+func (c *KongClient) triggerKubernetesObjectReport(
+	reportedObjects []client.Object,
+	translationFailures []failures.ResourceFailure,
+	configHash string,
+) {
 	// first a new set of the included objects for the most recent configuration
 	// needs to be generated.
 	set := k8sobj.ConfigurationStatusSet{}
+	set.SetConfigHash(configHash)
 	for _, obj := range reportedObjects {
 		set.Insert(obj, true)
 	}
@@ -942,6 +1163,14 @@ func (c *KongClient) triggerKubernetesObjectReport(reportedObjects []client.Obje
 	}
 }
 
+// configHash returns a single hash identifying the configuration sent to gateways. Gateways are configured with
+// the same configuration unless GatewaySharding is enabled, in which case their sorted distinct hashes are joined.
+func configHash(shas []string) string {
+	hashes := lo.Uniq(shas)
+	slices.Sort(hashes)
+	return strings.Join(hashes, ",")
+}
+
 func UniqueObjects(reportedObjects []client.Object, resourceFailures []failures.ResourceFailure) []client.Object {
 	allCausingObjects := lo.FlatMap(resourceFailures, func(f failures.ResourceFailure, _ int) []client.Object {
 		return f.CausingObjects()
@@ -1082,3 +1311,17 @@ func (c *KongClient) maybeSendFallbackConfigDiagnostics(ctx context.Context, gen
 	}
 	return nil
 }
+
+// maybeSendObjectsProvenanceDiagnostics ships the mapping of Kubernetes objects to the translated Kong entities
+// to the diagnostics server if it's enabled. It never blocks, dropping the mapping if the buffer is full.
+func (c *KongClient) maybeSendObjectsProvenanceDiagnostics(ctx context.Context, provenance translator.ObjectsProvenance) {
+	if ch := c.diagnostic.ObjectsProvenance; ch != nil {
+		select {
+		case ch <- provenance:
+			c.logger.V(util.DebugLevel).Info("Shipping objects provenance to diagnostics server")
+		case <-ctx.Done():
+		default:
+			c.logger.Error(nil, "Objects provenance buffer full, dropping diagnostics")
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package dataplane
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+)
+
+// KongCertificateExpiringEventReason defines an event reason used for warning about certificates expiring soon.
+const KongCertificateExpiringEventReason = "KongCertificateExpiring"
+
+// EnableCertificateExpiryWarnings turns on recording Warning Events on objects referring to TLS Secrets whose
+// certificates expire within the provided period.
+func (c *KongClient) EnableCertificateExpiryWarnings(period time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.certificateExpiryWarningPeriod = period
+}
+
+// recordCertificateInventory exports expiry times of the certificates translated into Kong certificates as metrics
+// and, if enabled, records Warning Events on objects referring to certificates that are about to expire. Already
+// expired certificates are reported as translation failures by the translator, so they're not warned about here.
+func (c *KongClient) recordCertificateInventory(inventory []translator.CertificateInventoryEntry) {
+	expiries := make(map[k8stypes.NamespacedName]time.Time, len(inventory))
+	for _, entry := range inventory {
+		expiries[entry.Secret] = entry.NotAfter
+	}
+	c.prometheusMetrics.RecordCertificateExpiries(expiries)
+
+	if c.certificateExpiryWarningPeriod <= 0 {
+		return
+	}
+	now := time.Now()
+	for _, entry := range inventory {
+		if !entry.NotAfter.After(now) || entry.NotAfter.Sub(now) > c.certificateExpiryWarningPeriod {
+			continue
+		}
+		message := fmt.Sprintf("certificate %q in secret %s expires at %s",
+			entry.Subject, entry.Secret, entry.NotAfter.UTC().Format(time.RFC3339))
+		for _, obj := range entry.ReferencingObjects {
+			c.eventRecorder.Event(obj, corev1.EventTypeWarning, KongCertificateExpiringEventReason, message)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package dataplane
+
+import (
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/adminapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+)
+
+// GatewayClientsAssociator associates gateway clients with Gateways whose publish Services are backed by their pods.
+// It's implemented by clients.AdminAPIClientsManager.
+type GatewayClientsAssociator interface {
+	AssociateGatewaysPods(gatewaysPods map[k8stypes.NamespacedName][]k8stypes.NamespacedName)
+	GatewaysForClient(client *adminapi.Client) []k8stypes.NamespacedName
+}
+
+// gatewayClientsAssociator returns the GatewayClientsAssociator if the GatewaySharding is enabled and the clients
+// provider supports associating clients with Gateways.
+func (c *KongClient) gatewayClientsAssociator() (GatewayClientsAssociator, bool) {
+	if !c.kongConfig.GatewaySharding {
+		return nil, false
+	}
+	associator, ok := c.clientsProvider.(GatewayClientsAssociator)
+	return associator, ok
+}
+
+// maybeAssociateGatewayClients updates the association of gateway clients with Gateways when GatewaySharding
+// is enabled.
+func (c *KongClient) maybeAssociateGatewayClients(gatewaysPods map[k8stypes.NamespacedName][]k8stypes.NamespacedName) {
+	associator, ok := c.gatewayClientsAssociator()
+	if !ok {
+		return
+	}
+	associator.AssociateGatewaysPods(gatewaysPods)
+}
+
+// stateForGatewayClient returns the part of the configuration that should be sent to the gateway client. When
+// GatewaySharding is enabled, it includes routes and certificates of only those Gateways that the client's pod backs.
+// Otherwise, the whole configuration is returned.
+func (c *KongClient) stateForGatewayClient(client *adminapi.Client, s *kongstate.KongState) *kongstate.KongState {
+	associator, ok := c.gatewayClientsAssociator()
+	if !ok {
+		return s
+	}
+	gateways := associator.GatewaysForClient(client)
+	c.logger.V(util.DebugLevel).Info("Sending configuration of Gateways to gateway client",
+		"url", client.BaseRootURL(), "gateways", gateways)
+	return s.ForGateways(gateways)
+}
@@ -46,8 +46,9 @@ var (
 			ReportConfiguredKubernetesObjects: false,
 
 			// Feature flags that are directly propagated from the feature gates get their defaults.
-			FillIDs:           defaults.Enabled(featuregates.FillIDsFeature),
-			KongServiceFacade: defaults.Enabled(featuregates.KongServiceFacade),
+			FillIDs:            defaults.Enabled(featuregates.FillIDsFeature),
+			KongServiceFacade:  defaults.Enabled(featuregates.KongServiceFacade),
+			KongUpstreamTarget: defaults.Enabled(featuregates.KongUpstreamTarget),
 		}
 	}
 )
@@ -0,0 +1,80 @@
+package dataplane
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/fallback"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/diagnostics"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+)
+
+// syncEventRecorder collects the outcome of a single synchronisation to be sent as a diagnostics.SyncEvent.
+// Its methods are safe to call on a nil recorder, which is used when sync events are disabled.
+type syncEventRecorder struct {
+	// lock protects the event as clients are configured concurrently.
+	lock  sync.Mutex
+	event diagnostics.SyncEvent
+}
+
+// startSyncEvent starts recording a sync event if sync events are enabled (--sync-events).
+func (c *KongClient) startSyncEvent() {
+	if c.diagnostic.SyncEvents == nil {
+		c.syncEvent = nil
+		return
+	}
+	c.syncEvent = &syncEventRecorder{
+		event: diagnostics.SyncEvent{Timestamp: time.Now()},
+	}
+}
+
+// maybeSendSyncEvent sends the recorded sync event to the diagnostics server. It's a noop if sync events are disabled.
+func (c *KongClient) maybeSendSyncEvent() {
+	if c.syncEvent == nil {
+		return
+	}
+	event := c.syncEvent.finish()
+	c.syncEvent = nil
+
+	select {
+	case c.diagnostic.SyncEvents <- event:
+		c.logger.V(util.DebugLevel).Info("Shipping sync event to diagnostic server")
+	default:
+		c.logger.Error(nil, "Sync event diagnostic buffer full, dropping sync event")
+	}
+}
+
+func (r *syncEventRecorder) recordClientResult(result diagnostics.ClientSyncResult) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.event.Results = append(r.event.Results, result)
+}
+
+func (r *syncEventRecorder) recordTranslationFailures(translationFailures []failures.ResourceFailure) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.event.TranslationFailures = translationFailures
+}
+
+func (r *syncEventRecorder) recordFallbackCacheMetadata(metadata fallback.GeneratedCacheMetadata) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.event.FallbackCacheMetadata = &metadata
+}
+
+func (r *syncEventRecorder) finish() diagnostics.SyncEvent {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.event.Duration = time.Since(r.event.Timestamp)
+	return r.event
+}
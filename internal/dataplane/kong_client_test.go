@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"slices"
 	"strings"
 	"sync"
@@ -17,6 +18,7 @@ import (
 	"github.com/kong/go-database-reconciler/pkg/file"
 	"github.com/kong/go-database-reconciler/pkg/utils"
 	"github.com/kong/go-kong/kong"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -43,6 +46,8 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/diagnostics"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/metrics"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	k8sobj "github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object/status"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/versions"
 	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
 	"github.com/kong/kubernetes-ingress-controller/v3/test/helpers"
@@ -176,6 +181,8 @@ type mockUpdateStrategyResolver struct {
 	updateCalledForURLs       []string
 	lastUpdatedContentForURLs map[string]sendconfig.ContentWithHash
 	errorsToReturnOnUpdate    map[string][]error
+	rolloutStrategy           sendconfig.RolloutStrategy
+	pushSchedulerStatuses     []sendconfig.GatewayPushStatus
 	t                         *testing.T
 	lock                      sync.RWMutex
 }
@@ -196,6 +203,21 @@ func (f *mockUpdateStrategyResolver) ResolveUpdateStrategy(c sendconfig.UpdateCl
 	return &mockUpdateStrategy{onUpdate: f.updateCalledForURLCallback(url)}
 }
 
+func (f *mockUpdateStrategyResolver) ResolveRolloutStrategy() sendconfig.RolloutStrategy {
+	return f.rolloutStrategy
+}
+
+// updateCalledForURLsSnapshot returns a copy of URLs the mockUpdateStrategy was called for so far.
+func (f *mockUpdateStrategyResolver) PushSchedulerStatuses() []sendconfig.GatewayPushStatus {
+	return f.pushSchedulerStatuses
+}
+
+func (f *mockUpdateStrategyResolver) updateCalledForURLsSnapshot() []string {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return slices.Clone(f.updateCalledForURLs)
+}
+
 // returnErrorOnUpdate will cause the mockUpdateStrategy with a given Admin API URL to return an error on Update().
 // Errors will be returned following FIFO order. Each call to this function adds a new error to the queue.
 func (f *mockUpdateStrategyResolver) returnErrorOnUpdate(url string) {
@@ -440,6 +462,188 @@ func TestKongClientUpdate_WhenNoChangeInConfigNoClientGetsCalled(t *testing.T) {
 	updateStrategyResolver.assertNoUpdateCalled()
 }
 
+func TestKongClientUpdate_DeferredPush(t *testing.T) {
+	gatewayClients := []*adminapi.Client{
+		mustSampleGatewayClient(t),
+		mustSampleGatewayClient(t),
+	}
+	deferredURL := gatewayClients[0].BaseRootURL()
+
+	updateStrategyResolver := newMockUpdateStrategyResolver(t)
+	updateStrategyResolver.returnSpecificErrorOnUpdate(deferredURL, sendconfig.PushDeferredError{
+		Decision: metrics.PushDecisionDeferredMinInterval,
+	})
+	lastValidConfigFetcher := &mockKongLastValidConfigFetcher{}
+	configBuilder := newMockKongConfigBuilder()
+	configBuilder.returnTranslationFailures(true)
+	failedObject := configBuilder.translationFailuresToReturn[0].CausingObjects()[0]
+	kongClient := setupTestKongClient(
+		t,
+		updateStrategyResolver,
+		mockGatewayClientsProvider{gatewayClients: gatewayClients},
+		mockConfigurationChangeDetector{hasConfigurationChanged: true},
+		configBuilder,
+		nil,
+		lastValidConfigFetcher,
+	)
+
+	kongClient.EnableKubernetesObjectReports(status.NewQueue())
+
+	require.NoError(t, kongClient.Update(context.Background()), "deferred push should not fail the update")
+	_, found := lastValidConfigFetcher.LastValidConfig()
+	require.False(t, found, "configuration should not be stored as the last valid one when a push was deferred")
+	require.Equal(t, k8sobj.ConfigurationStatusUnknown, kongClient.KubernetesObjectConfigurationStatus(failedObject),
+		"objects should not be reported when a push was deferred")
+
+	require.NoError(t, kongClient.Update(context.Background()))
+	_, found = lastValidConfigFetcher.LastValidConfig()
+	require.True(t, found, "configuration should be stored as the last valid one when all pushes succeeded")
+	require.Equal(t, k8sobj.ConfigurationStatusFailed, kongClient.KubernetesObjectConfigurationStatus(failedObject),
+		"objects should be reported when all pushes succeeded")
+}
+
+// mockRolloutStrategy is a mock implementation of sendconfig.RolloutStrategy.
+type mockRolloutStrategy struct {
+	canariesCount  int
+	waitErr        error
+	onWaitCalled   func(canaries []string)
+	waitCalledWith []string
+}
+
+func (m *mockRolloutStrategy) CanariesCount(int) int {
+	return m.canariesCount
+}
+
+func (m *mockRolloutStrategy) WaitForCanaries(_ context.Context, canaries []sendconfig.ReadinessClient) error {
+	m.waitCalledWith = lo.Map(canaries, func(c sendconfig.ReadinessClient, _ int) string { return c.BaseRootURL() })
+	if m.onWaitCalled != nil {
+		m.onWaitCalled(m.waitCalledWith)
+	}
+	return m.waitErr
+}
+
+func TestKongClientUpdate_CanaryRollout(t *testing.T) {
+	gatewayClients := []*adminapi.Client{
+		mustSampleGatewayClient(t),
+		mustSampleGatewayClient(t),
+		mustSampleGatewayClient(t),
+	}
+	urls := lo.Map(gatewayClients, func(c *adminapi.Client, _ int) string { return c.BaseRootURL() })
+	slices.Sort(urls)
+	canaryURL := urls[0]
+
+	testCases := []struct {
+		name                 string
+		errorOnUpdateForURLs []string
+		waitErr              error
+		expectWaitCalled     bool
+		expectedUpdatedURLs  []string
+		expectError          bool
+	}{
+		{
+			name:                "canary succeeds and configuration is pushed to the remaining gateways",
+			expectWaitCalled:    true,
+			expectedUpdatedURLs: urls,
+		},
+		{
+			name:             "canary does not become ready and is rolled back",
+			waitErr:          errors.New("canary not ready"),
+			expectWaitCalled: true,
+			// The canary is configured with the new configuration and then all gateways with the last valid one.
+			expectedUpdatedURLs: append([]string{canaryURL}, urls...),
+			expectError:         true,
+		},
+		{
+			name:                 "canary rejects configuration and is rolled back",
+			errorOnUpdateForURLs: []string{canaryURL},
+			expectedUpdatedURLs:  append([]string{canaryURL}, urls...),
+			expectError:          true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Clients are shared by test cases, so their configuration is forgotten not to skip waiting for the canary.
+			for _, c := range gatewayClients {
+				c.SetLastConfigSHA(nil)
+			}
+			updateStrategyResolver := newMockUpdateStrategyResolver(t)
+			for _, url := range tc.errorOnUpdateForURLs {
+				updateStrategyResolver.returnErrorOnUpdate(url)
+			}
+			rolloutStrategy := &mockRolloutStrategy{
+				canariesCount: 1,
+				waitErr:       tc.waitErr,
+				onWaitCalled: func([]string) {
+					require.Equal(t, []string{canaryURL}, updateStrategyResolver.updateCalledForURLsSnapshot(),
+						"only the canary should be configured before waiting for it")
+				},
+			}
+			updateStrategyResolver.rolloutStrategy = rolloutStrategy
+
+			configChangeDetector := mockConfigurationChangeDetector{hasConfigurationChanged: true}
+			configBuilder := newMockKongConfigBuilder()
+			lastValidConfigFetcher := &mockKongLastValidConfigFetcher{
+				lastKongState: &kongstate.KongState{
+					Services: []kongstate.Service{{Service: kong.Service{Name: kong.String("last-valid")}}},
+				},
+			}
+			kongClient := setupTestKongClient(
+				t,
+				updateStrategyResolver,
+				mockGatewayClientsProvider{gatewayClients: gatewayClients},
+				configChangeDetector,
+				configBuilder,
+				nil,
+				lastValidConfigFetcher,
+			)
+
+			err := kongClient.Update(context.Background())
+			if tc.expectError {
+				require.ErrorContains(t, err, "canary configuration rollout failed")
+				content, ok := updateStrategyResolver.lastUpdatedContentForURL(canaryURL)
+				require.True(t, ok)
+				require.Len(t, content.Content.Services, 1)
+				require.Equal(t, "last-valid", *content.Content.Services[0].Name, "canary should be rolled back to the last valid config")
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.expectWaitCalled {
+				require.Equal(t, []string{canaryURL}, rolloutStrategy.waitCalledWith)
+			} else {
+				require.Nil(t, rolloutStrategy.waitCalledWith)
+			}
+			updateStrategyResolver.assertUpdateCalledForURLs(tc.expectedUpdatedURLs)
+		})
+	}
+
+	t.Run("canary is not waited for when its configuration did not change", func(t *testing.T) {
+		gatewayClients := []*adminapi.Client{
+			mustSampleGatewayClient(t),
+			mustSampleGatewayClient(t),
+		}
+		updateStrategyResolver := newMockUpdateStrategyResolver(t)
+		rolloutStrategy := &mockRolloutStrategy{canariesCount: 1}
+		updateStrategyResolver.rolloutStrategy = rolloutStrategy
+		kongClient := setupTestKongClient(
+			t,
+			updateStrategyResolver,
+			mockGatewayClientsProvider{gatewayClients: gatewayClients},
+			mockConfigurationChangeDetector{hasConfigurationChanged: true},
+			newMockKongConfigBuilder(),
+			nil,
+			&mockKongLastValidConfigFetcher{},
+		)
+
+		require.NoError(t, kongClient.Update(context.Background()))
+		require.Len(t, rolloutStrategy.waitCalledWith, 1)
+
+		rolloutStrategy.waitCalledWith = nil
+		require.NoError(t, kongClient.Update(context.Background()))
+		require.Nil(t, rolloutStrategy.waitCalledWith, "canary should not be waited for when its configuration is the same")
+	})
+}
+
 type mockConfigStatusQueue struct {
 	notifications []clients.ConfigStatus
 	lock          sync.RWMutex
@@ -466,6 +670,8 @@ func (m *mockConfigStatusQueue) Notifications() []clients.ConfigStatus {
 type mockKongConfigBuilder struct {
 	translationFailuresToReturn []failures.ResourceFailure
 	kongState                   *kongstate.KongState
+	gatewaysPods                map[k8stypes.NamespacedName][]k8stypes.NamespacedName
+	certificateInventory        []translator.CertificateInventoryEntry
 	updateCacheCalls            []store.CacheStores
 
 	// onlyFirstCallWithNoTranslationFailures is used to simulate a scenario where the first call to the
@@ -490,8 +696,10 @@ func (p *mockKongConfigBuilder) BuildKongConfig() translator.KongConfigBuildingR
 		}
 	}
 	return translator.KongConfigBuildingResult{
-		KongState:           p.kongState,
-		TranslationFailures: p.translationFailuresToReturn,
+		KongState:            p.kongState,
+		TranslationFailures:  p.translationFailuresToReturn,
+		GatewaysPods:         p.gatewaysPods,
+		CertificateInventory: p.certificateInventory,
 	}
 }
 
@@ -1818,3 +2026,292 @@ func someConsumer(t *testing.T, name string) *kongv1.KongConsumer {
 		Username: name,
 	})
 }
+
+func TestWorkspacesSyncFailures(t *testing.T) {
+	newService := func(namespace string) client.Object {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "svc",
+				Namespace: namespace,
+			},
+		}
+		svc.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Service"))
+		return svc
+	}
+	configuredObjects := []client.Object{newService("default"), newService("tenant-a"), newService("tenant-b")}
+	namespacesWorkspaces := map[string]string{
+		"tenant-a": "workspace-a",
+		"tenant-b": "workspace-b",
+	}
+
+	t.Run("no workspace failed", func(t *testing.T) {
+		succeeded, resourceFailures := workspacesSyncFailures(configuredObjects, namespacesWorkspaces, nil)
+		require.Equal(t, configuredObjects, succeeded)
+		require.Empty(t, resourceFailures)
+	})
+
+	t.Run("objects of namespaces assigned to a failed workspace are reported as failed", func(t *testing.T) {
+		succeeded, resourceFailures := workspacesSyncFailures(configuredObjects, namespacesWorkspaces, map[string]error{
+			"workspace-a": errors.New("boom"),
+		})
+		require.Equal(t, []client.Object{configuredObjects[0], configuredObjects[2]}, succeeded)
+		require.Len(t, resourceFailures, 1)
+		require.Equal(t, []client.Object{configuredObjects[1]}, resourceFailures[0].CausingObjects())
+		require.Equal(t, "failed to configure workspace workspace-a: boom", resourceFailures[0].Message())
+	})
+}
+
+func TestDiscoverConfiguredWorkspaces(t *testing.T) {
+	taggedWorkspaces := map[string]bool{"workspace-a": true, "kic": true}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/workspaces", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[{"name":"default"},{"name":"kic"},{"name":"workspace-a"},{"name":"workspace-b"}],"next":null}`))
+	})
+	mux.HandleFunc("/{workspace}/tags/managed-by-ingress-controller", func(w http.ResponseWriter, r *http.Request) {
+		if taggedWorkspaces[r.PathValue("workspace")] {
+			_, _ = w.Write([]byte(`{"data":[{"entity_name":"services","entity_id":"id","tag":"managed-by-ingress-controller"}],"next":null}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[],"next":null}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	kongClient, err := kong.NewClient(kong.String(server.URL), server.Client())
+	require.NoError(t, err)
+	kongClient.SetWorkspace("kic")
+
+	t.Run("workspaces with tagged entities are discovered", func(t *testing.T) {
+		discovered, err := discoverConfiguredWorkspaces(context.Background(), kongClient, []string{"managed-by-ingress-controller"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"workspace-a"}, discovered, "default and client's own workspaces should be skipped")
+	})
+
+	t.Run("no workspaces are discovered without filter tags", func(t *testing.T) {
+		discovered, err := discoverConfiguredWorkspaces(context.Background(), kongClient, nil)
+		require.NoError(t, err)
+		require.Empty(t, discovered)
+	})
+}
+
+func TestKongClientUpdate_GatewaySharding(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		podA     = k8stypes.NamespacedName{Namespace: "kong", Name: "pod-a"}
+		podB     = k8stypes.NamespacedName{Namespace: "kong", Name: "pod-b"}
+		gatewayA = k8stypes.NamespacedName{Namespace: "default", Name: "gateway-a"}
+		gatewayB = k8stypes.NamespacedName{Namespace: "default", Name: "gateway-b"}
+
+		clientA          = mustSampleGatewayClient(t)
+		clientB          = mustSampleGatewayClient(t)
+		clientWithoutPod = mustSampleGatewayClient(t)
+	)
+	clientA.AttachPodReference(podA)
+	clientB.AttachPodReference(podB)
+
+	clientsManager, err := clients.NewAdminAPIClientsManager(
+		ctx,
+		zapr.NewLogger(zap.NewNop()),
+		[]*adminapi.Client{clientA, clientB, clientWithoutPod},
+		nil,
+	)
+	require.NoError(t, err)
+
+	updateStrategyResolver := newMockUpdateStrategyResolver(t)
+	configBuilder := newMockKongConfigBuilder()
+	configBuilder.kongState = &kongstate.KongState{
+		Services: []kongstate.Service{
+			{
+				Service: kong.Service{Name: kong.String("ingress-svc"), Host: kong.String("ingress.svc"), Protocol: kong.String("http")},
+				Routes: []kongstate.Route{
+					{Route: kong.Route{Name: kong.String("ingress-route"), Paths: kong.StringSlice("/ingress")}},
+				},
+			},
+			{
+				Service: kong.Service{Name: kong.String("httproute-svc"), Host: kong.String("httproute.svc"), Protocol: kong.String("http")},
+				Routes: []kongstate.Route{
+					{
+						Route:    kong.Route{Name: kong.String("route-a"), Paths: kong.StringSlice("/a")},
+						Gateways: []k8stypes.NamespacedName{gatewayA},
+					},
+					{
+						Route:    kong.Route{Name: kong.String("route-b"), Paths: kong.StringSlice("/b")},
+						Gateways: []k8stypes.NamespacedName{gatewayB},
+					},
+				},
+			},
+		},
+	}
+	configBuilder.gatewaysPods = map[k8stypes.NamespacedName][]k8stypes.NamespacedName{
+		gatewayA: {podA},
+		gatewayB: {podB},
+	}
+
+	kongClient, err := NewKongClient(
+		zapr.NewLogger(zap.NewNop()),
+		time.Second,
+		diagnostics.ConfigDumpDiagnostic{},
+		sendconfig.Config{InMemory: true, GatewaySharding: true},
+		mocks.NewEventRecorder(),
+		dpconf.DBModeOff,
+		clientsManager,
+		updateStrategyResolver,
+		mockConfigurationChangeDetector{hasConfigurationChanged: true},
+		&mockKongLastValidConfigFetcher{},
+		configBuilder,
+		store.NewCacheStores(),
+		newMockFallbackConfigGenerator(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, kongClient.Update(ctx))
+
+	routesSentTo := func(client *adminapi.Client) []string {
+		content, ok := updateStrategyResolver.lastUpdatedContentForURL(client.BaseRootURL())
+		require.True(t, ok)
+		var routes []string
+		for _, svc := range content.Content.Services {
+			for _, r := range svc.Routes {
+				routes = append(routes, *r.Name)
+			}
+		}
+		return routes
+	}
+	require.ElementsMatch(t, []string{"ingress-route", "route-a"}, routesSentTo(clientA))
+	require.ElementsMatch(t, []string{"ingress-route", "route-b"}, routesSentTo(clientB))
+	require.ElementsMatch(t, []string{"ingress-route"}, routesSentTo(clientWithoutPod))
+	require.NotEqual(t, clientA.LastConfigSHA(), clientB.LastConfigSHA(), "each gateway should track its own configuration hash")
+}
+
+func TestKongClientUpdate_SyncEvents(t *testing.T) {
+	ctx := context.Background()
+	gwClient := mustSampleGatewayClient(t)
+	konnectClient := mustSampleKonnectClient(t)
+	clientsProvider := mockGatewayClientsProvider{
+		gatewayClients: []*adminapi.Client{gwClient},
+		konnectClient:  konnectClient,
+	}
+	updateStrategyResolver := newMockUpdateStrategyResolver(t)
+	configBuilder := newMockKongConfigBuilder()
+	syncEventsCh := make(chan diagnostics.SyncEvent, 10) // make it buffered to avoid blocking
+
+	brokenConsumer := someConsumer(t, "broken")
+	translationFailure := lo.Must(failures.NewResourceFailure("translation failure", someConsumer(t, "invalid")))
+	configBuilder.translationFailuresToReturn = []failures.ResourceFailure{translationFailure}
+	fallbackConfigGenerator := newMockFallbackConfigGenerator()
+	fallbackConfigGenerator.GenerateResult = cacheStoresFromObjs(t)
+
+	kongClient, err := NewKongClient(
+		zapr.NewLogger(zap.NewNop()),
+		time.Second,
+		diagnostics.ConfigDumpDiagnostic{
+			SyncEvents: syncEventsCh,
+		},
+		sendconfig.Config{
+			FallbackConfiguration: true,
+		},
+		mocks.NewEventRecorder(),
+		dpconf.DBModeOff,
+		clientsProvider,
+		updateStrategyResolver,
+		mockConfigurationChangeDetector{hasConfigurationChanged: true},
+		&mockKongLastValidConfigFetcher{},
+		configBuilder,
+		cacheStoresFromObjs(t, brokenConsumer),
+		fallbackConfigGenerator,
+	)
+	require.NoError(t, err)
+
+	t.Log("Setting update strategy to return an error on the first call to trigger fallback configuration generation")
+	updateStrategyResolver.returnSpecificErrorOnUpdate(gwClient.BaseRootURL(), sendconfig.NewUpdateError(
+		[]failures.ResourceFailure{
+			lo.Must(failures.NewResourceFailure("violated constraint", brokenConsumer)),
+		},
+		errors.New("error on update"),
+	))
+
+	t.Log("Calling KongClient.Update")
+	require.Error(t, kongClient.Update(ctx))
+
+	t.Log("Verifying that a single sync event describing the sync was sent")
+	require.Len(t, syncEventsCh, 1)
+	event := <-syncEventsCh
+	require.NotZero(t, event.Timestamp)
+	require.Equal(t, []failures.ResourceFailure{translationFailure}, event.TranslationFailures)
+	require.NotNil(t, event.FallbackCacheMetadata, "expected fallback cache metadata to be recorded")
+
+	type result struct {
+		url      string
+		konnect  bool
+		fallback bool
+		failed   bool
+	}
+	results := lo.Map(event.Results, func(r diagnostics.ClientSyncResult, _ int) result {
+		return result{url: r.URL, konnect: r.Konnect, fallback: r.Fallback, failed: r.Err != nil}
+	})
+	require.ElementsMatch(t, []result{
+		{url: gwClient.BaseRootURL(), failed: true},
+		{url: konnectClient.BaseRootURL(), konnect: true},
+		{url: gwClient.BaseRootURL(), fallback: true},
+		{url: konnectClient.BaseRootURL(), konnect: true, fallback: true},
+	}, results)
+
+	t.Log("Verifying that no sync event is sent when the configuration has not changed")
+	require.NoError(t, kongClient.Update(ctx))
+	require.Empty(t, syncEventsCh)
+}
+
+func TestKongClientUpdate_CertificateExpiryWarnings(t *testing.T) {
+	ingress := func(name string) *netv1.Ingress {
+		return &netv1.Ingress{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "networking.k8s.io/v1", Kind: "Ingress"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		}
+	}
+	now := time.Now()
+	configBuilder := newMockKongConfigBuilder()
+	configBuilder.certificateInventory = []translator.CertificateInventoryEntry{
+		{
+			Secret:             k8stypes.NamespacedName{Namespace: "default", Name: "expiring"},
+			Subject:            "CN=expiring.com",
+			NotAfter:           now.Add(72 * time.Hour),
+			ReferencingObjects: []client.Object{ingress("expiring-1"), ingress("expiring-2")},
+		},
+		{
+			Secret:             k8stypes.NamespacedName{Namespace: "default", Name: "valid"},
+			Subject:            "CN=valid.com",
+			NotAfter:           now.Add(90 * 24 * time.Hour),
+			ReferencingObjects: []client.Object{ingress("valid")},
+		},
+		{
+			Secret:             k8stypes.NamespacedName{Namespace: "default", Name: "expired"},
+			Subject:            "CN=expired.com",
+			NotAfter:           now.Add(-time.Hour),
+			ReferencingObjects: []client.Object{ingress("expired")},
+		},
+	}
+	eventRecorder := mocks.NewEventRecorder()
+	kongClient := setupTestKongClient(
+		t,
+		newMockUpdateStrategyResolver(t),
+		mockGatewayClientsProvider{gatewayClients: []*adminapi.Client{mustSampleGatewayClient(t)}},
+		mockConfigurationChangeDetector{hasConfigurationChanged: true},
+		configBuilder,
+		eventRecorder,
+		&mockKongLastValidConfigFetcher{},
+	)
+	kongClient.EnableCertificateExpiryWarnings(14 * 24 * time.Hour)
+
+	require.NoError(t, kongClient.Update(context.Background()))
+
+	t.Log("Verifying that only objects referring to the expiring certificate got Warning events")
+	expiringEvents := lo.Filter(eventRecorder.Events(), func(e string, _ int) bool {
+		return strings.Contains(e, KongCertificateExpiringEventReason)
+	})
+	require.Len(t, expiringEvents, 2)
+	for _, e := range expiringEvents {
+		require.Contains(t, e, `certificate "CN=expiring.com" in secret default/expiring expires at`)
+	}
+
+	t.Log("Verifying that expiry times of all certificates are exported as metrics")
+	require.Equal(t, 3, testutil.CollectAndCount(kongClient.prometheusMetrics.CertificateExpiryTimestamp))
+}
@@ -0,0 +1,244 @@
+package dataplane
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/adminapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/sendconfig"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+)
+
+// defaultWorkspace is the name of Kong's default workspace.
+const defaultWorkspace = "default"
+
+// WorkspaceClientFactory creates Admin API clients scoped to Kong workspaces.
+type WorkspaceClientFactory interface {
+	CreateAdminAPIClientForWorkspace(
+		ctx context.Context, workspace string, discoveredAdminAPI adminapi.DiscoveredAdminAPI,
+	) (*adminapi.Client, error)
+}
+
+// EnableWorkspaces turns on pushing configuration of Kong workspaces assigned to namespaces (see
+// translator.KongConfigBuildingResult.WorkspacesKongStates) through workspace-scoped clients created
+// with the provided factory. It's supported in DB mode only.
+func (c *KongClient) EnableWorkspaces(factory WorkspaceClientFactory) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.workspaceClientFactory = factory
+	c.workspaceClients = make(map[string]*adminapi.Client)
+	c.workspacesSHAs = make(map[string]string)
+	c.workspacesDiscovered = false
+	c.discoveredWorkspaces = nil
+}
+
+// maybeSendOutToWorkspaces pushes configuration of Kong workspaces assigned to namespaces through workspace-scoped
+// clients. As all gateways share the database in DB mode, it's enough to push it through one of them. Workspaces that
+// were configured before but are not assigned to any namespace anymore get their configuration removed. These
+// include workspaces configured before the controller started, discovered by the filter tags in the first sync.
+// It returns errors that occurred by workspaces' names. It's a noop when workspaces are not enabled.
+func (c *KongClient) maybeSendOutToWorkspaces(
+	ctx context.Context,
+	states map[string]*kongstate.KongState,
+	config sendconfig.Config,
+) map[string]error {
+	if c.workspaceClientFactory == nil {
+		return nil
+	}
+	gatewayClients := c.clientsProvider.GatewayClientsToConfigure()
+	if len(gatewayClients) == 0 {
+		return nil
+	}
+	gatewayClient := gatewayClients[0]
+
+	if !c.workspacesDiscovered {
+		discovered, err := discoverConfiguredWorkspaces(ctx, gatewayClient.AdminAPIClient(), config.FilterTags)
+		if err != nil {
+			// Discovery will be retried in the next sync, configuring the assigned workspaces doesn't depend on it.
+			c.logger.Error(err, "Failed to discover configured workspaces")
+		} else {
+			c.workspacesDiscovered = true
+			c.discoveredWorkspaces = discovered
+		}
+	}
+
+	// Workspaces that are not assigned to any namespace anymore are configured with an empty state to clean them up.
+	workspaces := lo.Union(lo.Keys(states), lo.Keys(c.workspaceClients), c.discoveredWorkspaces)
+	slices.Sort(workspaces)
+
+	workspacesErrs := make(map[string]error)
+	for _, workspace := range workspaces {
+		state, ok := states[workspace]
+		if !ok {
+			state = &kongstate.KongState{}
+		}
+		err := c.sendOutToWorkspace(ctx, gatewayClient, workspace, state, config)
+		c.prometheusMetrics.RecordWorkspacePush(workspace, err)
+		if err != nil {
+			c.logger.Error(err, "Failed to configure workspace", "workspace", workspace)
+			workspacesErrs[workspace] = err
+			continue
+		}
+		if !ok {
+			c.logger.Info("Removed configuration of workspace no longer assigned to any namespace", "workspace", workspace)
+			delete(c.workspaceClients, workspace)
+			delete(c.workspacesSHAs, workspace)
+		}
+		c.discoveredWorkspaces = lo.Without(c.discoveredWorkspaces, workspace)
+	}
+
+	// Make sure the next sync retries configuring failed workspaces even if the cache doesn't change in the meantime.
+	if len(workspacesErrs) > 0 {
+		c.lastProcessedSnapshotHash = store.SnapshotHashEmpty
+	}
+	return workspacesErrs
+}
+
+// discoverConfiguredWorkspaces returns workspaces other than the default one and the one the client is scoped to that
+// have entities tagged with the filter tags, i.e. were configured by the controller. As the filter tags are required
+// to tell the controller's entities apart, no workspaces are discovered without them.
+func discoverConfiguredWorkspaces(ctx context.Context, client *kong.Client, filterTags []string) ([]string, error) {
+	if len(filterTags) == 0 {
+		return nil, nil
+	}
+	workspaces, err := listAllWorkspaces(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+
+	var discovered []string
+	for _, workspace := range workspaces {
+		name := lo.FromPtr(workspace.Name)
+		if name == "" || name == defaultWorkspace || name == client.Workspace() {
+			continue
+		}
+		// Entities configured by the controller carry all the filter tags, it's enough to look for the first one.
+		req, err := client.NewRequestRaw(
+			http.MethodGet, client.BaseRootURL()+"/"+name, "/tags/"+filterTags[0], &struct {
+				Size int `url:"size"`
+			}{Size: 1}, nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		var tagged struct {
+			Data []json.RawMessage `json:"data"`
+		}
+		if _, err := client.Do(ctx, req, &tagged); err != nil {
+			return nil, fmt.Errorf("failed to list entities tagged with %s in workspace %s: %w", filterTags[0], name, err)
+		}
+		if len(tagged.Data) > 0 {
+			discovered = append(discovered, name)
+		}
+	}
+	slices.Sort(discovered)
+	return discovered, nil
+}
+
+// listAllWorkspaces lists all workspaces through the root Admin API endpoint, regardless of the workspace
+// the client is scoped to.
+func listAllWorkspaces(ctx context.Context, client *kong.Client) ([]*kong.Workspace, error) {
+	var (
+		workspaces []*kong.Workspace
+		endpoint   = "/workspaces?size=1000"
+	)
+	for endpoint != "" {
+		req, err := client.NewRequestRaw(http.MethodGet, client.BaseRootURL(), endpoint, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Data []*kong.Workspace `json:"data"`
+			Next *string           `json:"next"`
+		}
+		if _, err := client.Do(ctx, req, &page); err != nil {
+			return nil, err
+		}
+		workspaces = append(workspaces, page.Data...)
+		endpoint = lo.FromPtr(page.Next)
+	}
+	return workspaces, nil
+}
+
+// sendOutToWorkspace pushes the configuration to the workspace through a workspace-scoped client targeting the same
+// Admin API as the given gateway client.
+func (c *KongClient) sendOutToWorkspace(
+	ctx context.Context,
+	gatewayClient *adminapi.Client,
+	workspace string,
+	s *kongstate.KongState,
+	config sendconfig.Config,
+) error {
+	workspaceClient, ok := c.workspaceClients[workspace]
+	if !ok || workspaceClient.BaseRootURL() != gatewayClient.BaseRootURL() {
+		podRef, _ := gatewayClient.PodReference()
+		var err error
+		workspaceClient, err = c.workspaceClientFactory.CreateAdminAPIClientForWorkspace(ctx, workspace, adminapi.DiscoveredAdminAPI{
+			Address: gatewayClient.BaseRootURL(),
+			PodRef:  podRef,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create client for workspace %s: %w", workspace, err)
+		}
+		c.workspaceClients[workspace] = workspaceClient
+	}
+
+	const isFallback = false
+	sha, err := c.sendToClientInWorkspace(ctx, workspaceClient, workspace, s, config, isFallback)
+	if err != nil {
+		return err
+	}
+	c.workspacesSHAs[workspace] = sha
+	return nil
+}
+
+// workspacesSyncFailures returns resource failures for configured objects from namespaces assigned to workspaces
+// that failed to be configured, so that their status reflects it. The objects are removed from the returned list
+// of configured objects.
+func workspacesSyncFailures(
+	configuredObjects []client.Object,
+	namespacesWorkspaces map[string]string,
+	workspacesErrs map[string]error,
+) ([]client.Object, []failures.ResourceFailure) {
+	if len(workspacesErrs) == 0 {
+		return configuredObjects, nil
+	}
+
+	var (
+		succeeded          []client.Object
+		failedByWorkspaces = make(map[string][]client.Object)
+	)
+	for _, obj := range configuredObjects {
+		workspace, ok := namespacesWorkspaces[obj.GetNamespace()]
+		if _, failed := workspacesErrs[workspace]; ok && failed {
+			failedByWorkspaces[workspace] = append(failedByWorkspaces[workspace], obj)
+			continue
+		}
+		succeeded = append(succeeded, obj)
+	}
+
+	failedWorkspaces := lo.Keys(failedByWorkspaces)
+	slices.Sort(failedWorkspaces)
+	var resourceFailures []failures.ResourceFailure
+	for _, workspace := range failedWorkspaces {
+		objs := failedByWorkspaces[workspace]
+		resourceFailure, err := failures.NewResourceFailure(
+			fmt.Sprintf("failed to configure workspace %s: %s", workspace, workspacesErrs[workspace]), objs...,
+		)
+		if err != nil {
+			continue
+		}
+		resourceFailures = append(resourceFailures, resourceFailure)
+	}
+	return succeeded, resourceFailures
+}
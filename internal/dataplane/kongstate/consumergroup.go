@@ -12,3 +12,56 @@ type ConsumerGroup struct {
 
 	K8sKongConsumerGroup kongv1beta1.KongConsumerGroup
 }
+
+// ConsumerGroupKongName returns the name of the ConsumerGroup in Kong for the given KongConsumerGroup.
+// It's the spec.name if set, or the name of the KongConsumerGroup object otherwise.
+func ConsumerGroupKongName(cg *kongv1beta1.KongConsumerGroup) string {
+	if cg.Spec.Name != "" {
+		return cg.Spec.Name
+	}
+	return cg.Name
+}
+
+// consumerGroupsByKongName indexes KongConsumerGroups by their names in Kong.
+// When 2 or more KongConsumerGroups resolve to the same name, only the one with the highest priority
+// (see compareKongConsumerGroup) is indexed, the others are returned as duplicates.
+func consumerGroupsByKongName(
+	consumerGroups []*kongv1beta1.KongConsumerGroup,
+) (map[string]*kongv1beta1.KongConsumerGroup, []*kongv1beta1.KongConsumerGroup) {
+	nameToConsumerGroup := make(map[string]*kongv1beta1.KongConsumerGroup, len(consumerGroups))
+	var duplicates []*kongv1beta1.KongConsumerGroup
+	for _, cg := range consumerGroups {
+		name := ConsumerGroupKongName(cg)
+		existing, ok := nameToConsumerGroup[name]
+		if !ok {
+			nameToConsumerGroup[name] = cg
+			continue
+		}
+		if compareKongConsumerGroup(existing, cg) {
+			duplicates = append(duplicates, cg)
+		} else {
+			nameToConsumerGroup[name] = cg
+			duplicates = append(duplicates, existing)
+		}
+	}
+	return nameToConsumerGroup, duplicates
+}
+
+// compareKongConsumerGroup compares two KongConsumerGroups resolving to the same name in Kong.
+// It returns true when cg1 has higher priority than cg2, by the following order:
+// - The one created earlier (earlier `creationTimestamp`) takes precedence.
+// - If the creationTimestamp equals, the one with smaller lexical order of namespace/name takes precedence.
+func compareKongConsumerGroup(cg1, cg2 *kongv1beta1.KongConsumerGroup) bool {
+	if cg1.CreationTimestamp.Before(&cg2.CreationTimestamp) {
+		return true
+	}
+	if cg2.CreationTimestamp.Before(&cg1.CreationTimestamp) {
+		return false
+	}
+	return cg1.Namespace+"/"+cg1.Name < cg2.Namespace+"/"+cg2.Name
+}
+
+// isSameKongConsumerGroup tells whether both KongConsumerGroups are the same Kubernetes object.
+func isSameKongConsumerGroup(cg1, cg2 *kongv1beta1.KongConsumerGroup) bool {
+	return cg1.Namespace == cg2.Namespace && cg1.Name == cg2.Name
+}
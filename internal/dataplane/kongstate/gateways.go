@@ -0,0 +1,75 @@
+package kongstate
+
+import (
+	"slices"
+
+	"github.com/samber/lo"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+)
+
+// ForGateways returns a shallow copy of the KongState that includes only entities that should be configured in Kong
+// nodes backing the given Gateways. Routes and certificates attributed to Gateways (see Route.Gateways and
+// Certificate.Gateways) are included only if they're attributed to any of the given Gateways, entities that are not
+// attributed to any Gateway are always included. Services left with no routes are excluded together with their
+// upstreams (unless shared with other services) and plugins.
+func (ks *KongState) ForGateways(gateways []k8stypes.NamespacedName) *KongState {
+	attributedToGateways := func(attributedTo []k8stypes.NamespacedName) bool {
+		return len(attributedTo) == 0 || lo.Some(attributedTo, gateways)
+	}
+
+	var (
+		excludedServices = make(map[string]struct{})
+		excludedRoutes   = make(map[string]struct{})
+		includedHosts    = make(map[string]struct{})
+		allHosts         = make(map[string]struct{})
+	)
+	services := make([]Service, 0, len(ks.Services))
+	for _, service := range ks.Services {
+		allHosts[lo.FromPtr(service.Host)] = struct{}{}
+		if !slices.ContainsFunc(service.Routes, func(r Route) bool { return len(r.Gateways) > 0 }) {
+			services = append(services, service)
+			includedHosts[lo.FromPtr(service.Host)] = struct{}{}
+			continue
+		}
+
+		routes := lo.Filter(service.Routes, func(r Route, _ int) bool {
+			if attributedToGateways(r.Gateways) {
+				return true
+			}
+			excludedRoutes[lo.FromPtr(r.Name)] = struct{}{}
+			return false
+		})
+		if len(routes) == 0 {
+			excludedServices[lo.FromPtr(service.Name)] = struct{}{}
+			continue
+		}
+		service.Routes = routes
+		services = append(services, service)
+		includedHosts[lo.FromPtr(service.Host)] = struct{}{}
+	}
+
+	filtered := *ks
+	filtered.Services = services
+	filtered.Upstreams = lo.Filter(ks.Upstreams, func(u Upstream, _ int) bool {
+		_, included := includedHosts[lo.FromPtr(u.Name)]
+		_, referred := allHosts[lo.FromPtr(u.Name)]
+		return included || !referred
+	})
+	filtered.Plugins = lo.Filter(ks.Plugins, func(p Plugin, _ int) bool {
+		if p.Service != nil {
+			if _, excluded := excludedServices[lo.FromPtr(p.Service.ID)]; excluded {
+				return false
+			}
+		}
+		if p.Route != nil {
+			if _, excluded := excludedRoutes[lo.FromPtr(p.Route.ID)]; excluded {
+				return false
+			}
+		}
+		return true
+	})
+	filtered.Certificates = lo.Filter(ks.Certificates, func(c Certificate, _ int) bool {
+		return attributedToGateways(c.Gateways)
+	})
+	return &filtered
+}
@@ -0,0 +1,104 @@
+package kongstate
+
+import (
+	"testing"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+)
+
+func TestKongState_ForGateways(t *testing.T) {
+	var (
+		gatewayA = k8stypes.NamespacedName{Namespace: "ns", Name: "gateway-a"}
+		gatewayB = k8stypes.NamespacedName{Namespace: "ns", Name: "gateway-b"}
+	)
+	state := &KongState{
+		Services: []Service{
+			{
+				Service: kong.Service{Name: kong.String("ingress-svc"), Host: kong.String("ingress-upstream")},
+				Routes:  []Route{{Route: kong.Route{Name: kong.String("ingress-route")}}},
+			},
+			{
+				Service: kong.Service{Name: kong.String("httproute-svc"), Host: kong.String("httproute-upstream")},
+				Routes: []Route{
+					{Route: kong.Route{Name: kong.String("route-a")}, Gateways: []k8stypes.NamespacedName{gatewayA}},
+					{Route: kong.Route{Name: kong.String("route-ab")}, Gateways: []k8stypes.NamespacedName{gatewayA, gatewayB}},
+				},
+			},
+			{
+				Service: kong.Service{Name: kong.String("httproute-b-svc"), Host: kong.String("httproute-b-upstream")},
+				Routes: []Route{
+					{Route: kong.Route{Name: kong.String("route-b")}, Gateways: []k8stypes.NamespacedName{gatewayB}},
+				},
+			},
+		},
+		Upstreams: []Upstream{
+			{Upstream: kong.Upstream{Name: kong.String("ingress-upstream")}},
+			{Upstream: kong.Upstream{Name: kong.String("httproute-upstream")}},
+			{Upstream: kong.Upstream{Name: kong.String("httproute-b-upstream")}},
+		},
+		Plugins: []Plugin{
+			{Plugin: kong.Plugin{Name: kong.String("global")}},
+			{Plugin: kong.Plugin{Name: kong.String("on-route-a"), Route: &kong.Route{ID: kong.String("route-a")}}},
+			{Plugin: kong.Plugin{Name: kong.String("on-route-b"), Route: &kong.Route{ID: kong.String("route-b")}}},
+			{Plugin: kong.Plugin{Name: kong.String("on-svc-b"), Service: &kong.Service{ID: kong.String("httproute-b-svc")}}},
+		},
+		Certificates: []Certificate{
+			{Certificate: kong.Certificate{ID: kong.String("ingress-cert")}},
+			{Certificate: kong.Certificate{ID: kong.String("cert-a")}, Gateways: []k8stypes.NamespacedName{gatewayA}},
+			{Certificate: kong.Certificate{ID: kong.String("cert-b")}, Gateways: []k8stypes.NamespacedName{gatewayB}},
+		},
+		Consumers: []Consumer{{Consumer: kong.Consumer{Username: kong.String("consumer")}}},
+	}
+
+	routeNames := func(s *KongState) []string {
+		var names []string
+		for _, svc := range s.Services {
+			for _, r := range svc.Routes {
+				names = append(names, *r.Name)
+			}
+		}
+		return names
+	}
+	upstreamNames := func(s *KongState) []string {
+		return lo.Map(s.Upstreams, func(u Upstream, _ int) string { return *u.Name })
+	}
+	pluginNames := func(s *KongState) []string {
+		return lo.Map(s.Plugins, func(p Plugin, _ int) string { return *p.Name })
+	}
+	certificateIDs := func(s *KongState) []string {
+		return lo.Map(s.Certificates, func(c Certificate, _ int) string { return *c.ID })
+	}
+
+	t.Run("no gateways", func(t *testing.T) {
+		filtered := state.ForGateways(nil)
+		assert.Equal(t, []string{"ingress-route"}, routeNames(filtered))
+		assert.Equal(t, []string{"ingress-upstream"}, upstreamNames(filtered))
+		assert.Equal(t, []string{"global"}, pluginNames(filtered))
+		assert.Equal(t, []string{"ingress-cert"}, certificateIDs(filtered))
+		assert.Len(t, filtered.Consumers, 1)
+	})
+
+	t.Run("single gateway", func(t *testing.T) {
+		filtered := state.ForGateways([]k8stypes.NamespacedName{gatewayA})
+		assert.Equal(t, []string{"ingress-route", "route-a", "route-ab"}, routeNames(filtered))
+		assert.Equal(t, []string{"ingress-upstream", "httproute-upstream"}, upstreamNames(filtered))
+		assert.Equal(t, []string{"global", "on-route-a"}, pluginNames(filtered))
+		assert.Equal(t, []string{"ingress-cert", "cert-a"}, certificateIDs(filtered))
+	})
+
+	t.Run("multiple gateways", func(t *testing.T) {
+		filtered := state.ForGateways([]k8stypes.NamespacedName{gatewayA, gatewayB})
+		assert.Equal(t, []string{"ingress-route", "route-a", "route-ab", "route-b"}, routeNames(filtered))
+		assert.Equal(t, []string{"ingress-upstream", "httproute-upstream", "httproute-b-upstream"}, upstreamNames(filtered))
+		assert.Equal(t, pluginNames(state), pluginNames(filtered))
+		assert.Equal(t, certificateIDs(state), certificateIDs(filtered))
+	})
+
+	t.Run("original state is not modified", func(t *testing.T) {
+		_ = state.ForGateways([]k8stypes.NamespacedName{gatewayB})
+		assert.Equal(t, []string{"ingress-route", "route-a", "route-ab", "route-b"}, routeNames(state))
+	})
+}
@@ -81,6 +81,18 @@ func (ks *KongState) FillConsumersAndCredentials(
 ) {
 	consumerIndex := make(map[string]Consumer)
 
+	// Credentials can reference values stored in vaults configured by KongVaults.
+	vaultPrefixes := make(map[string]struct{})
+	for _, vault := range s.ListKongVaults() {
+		vaultPrefixes[vault.Spec.Prefix] = struct{}{}
+	}
+	vaultExists := func(prefix string) bool {
+		_, ok := vaultPrefixes[prefix]
+		return ok
+	}
+
+	consumerGroups, _ := consumerGroupsByKongName(s.ListKongConsumerGroups())
+
 	// build consumer index
 	for _, consumer := range s.ListKongConsumers() {
 		var c Consumer
@@ -106,8 +118,16 @@ func (ks *KongState) FillConsumersAndCredentials(
 				failuresCollector.PushResourceFailure(fmt.Sprintf("nonexistent consumer group: %q", err), consumer)
 				continue
 			}
+			name := ConsumerGroupKongName(cg)
+			if translated := consumerGroups[name]; translated != nil && !isSameKongConsumerGroup(translated, cg) {
+				failuresCollector.PushResourceFailure(fmt.Sprintf(
+					"consumer group %q is not translated: its name %q is already used by KongConsumerGroup %s/%s",
+					cgName, name, translated.Namespace, translated.Name,
+				), consumer)
+				continue
+			}
 			c.ConsumerGroups = append(c.ConsumerGroups, kong.ConsumerGroup{
-				Name: &cg.Name,
+				Name: kong.String(name),
 			})
 		}
 
@@ -132,6 +152,10 @@ func (ks *KongState) FillConsumersAndCredentials(
 				)
 				continue
 			}
+			if err := credentials.ValidateVaultReferences(secret, vaultExists); err != nil {
+				pushCredentialResourceFailures(fmt.Sprintf("failed to provision credential: %v", err))
+				continue
+			}
 			for k, v := range secret.Data {
 				// TODO populate these based on schema from Kong
 				// and remove this workaround
@@ -189,12 +213,35 @@ func (ks *KongState) FillConsumersAndCredentials(
 	}
 }
 
-func (ks *KongState) FillConsumerGroups(_ logr.Logger, s store.Storer) {
+func (ks *KongState) FillConsumerGroups(
+	_ logr.Logger,
+	s store.Storer,
+	failuresCollector *failures.ResourceFailuresCollector,
+) {
+	// Reject the KongConsumerGroups resolving to a name already used by another one, as Kong requires unique names.
+	consumerGroups, duplicates := consumerGroupsByKongName(s.ListKongConsumerGroups())
+	for _, cg := range duplicates {
+		name := ConsumerGroupKongName(cg)
+		translated := consumerGroups[name]
+		failuresCollector.PushResourceFailure(fmt.Sprintf(
+			"consumer group name %q is already used by KongConsumerGroup %s/%s", name, translated.Namespace, translated.Name,
+		), cg)
+	}
+
 	for _, cg := range s.ListKongConsumerGroups() {
+		if !isSameKongConsumerGroup(consumerGroups[ConsumerGroupKongName(cg)], cg) {
+			continue
+		}
+		tags := util.GenerateTagsForObject(cg)
+		for _, tag := range cg.Spec.Tags {
+			if !lo.ContainsBy(tags, func(t *string) bool { return *t == tag }) {
+				tags = append(tags, kong.String(tag))
+			}
+		}
 		ks.ConsumerGroups = append(ks.ConsumerGroups, ConsumerGroup{
 			ConsumerGroup: kong.ConsumerGroup{
-				Name: kong.String(cg.Name),
-				Tags: util.GenerateTagsForObject(cg),
+				Name: kong.String(ConsumerGroupKongName(cg)),
+				Tags: tags,
 			},
 			K8sKongConsumerGroup: *cg,
 		})
@@ -262,6 +309,7 @@ func (ks *KongState) FillUpstreamOverrides(
 		} else if kongUpstreamPolicy != nil {
 			ks.Upstreams[i].overrideByKongUpstreamPolicy(kongUpstreamPolicy)
 		}
+		ks.Upstreams[i].overrideBySessionPersistence(kongUpstreamPolicy, failuresCollector)
 	}
 }
 
@@ -597,6 +645,55 @@ func (ks *KongState) FillPlugins(
 	failuresCollector *failures.ResourceFailuresCollector,
 ) {
 	ks.Plugins = buildPlugins(log, s, failuresCollector, ks.getPluginRelations(s, log))
+	ks.Plugins = append(ks.Plugins, ks.consumerGroupScopedPlugins(s, failuresCollector)...)
+}
+
+// consumerGroupScopedPlugins builds the plugins defined inline in spec.plugins of KongConsumerGroups.
+// A plugin that is already bound to the same consumer group with the konghq.com/plugins annotation
+// takes precedence over the inline one, as Kong allows only a single instance of a plugin per group.
+func (ks *KongState) consumerGroupScopedPlugins(
+	s store.Storer,
+	failuresCollector *failures.ResourceFailuresCollector,
+) []Plugin {
+	boundPlugins := sets.New[string]()
+	for _, p := range ks.Plugins {
+		if p.ConsumerGroup != nil && p.Name != nil {
+			boundPlugins.Insert(*p.ConsumerGroup.ID + ":" + *p.Name)
+		}
+	}
+
+	var plugins []Plugin
+	for i := range ks.ConsumerGroups {
+		k8sCG := &ks.ConsumerGroups[i].K8sKongConsumerGroup
+		groupName := *ks.ConsumerGroups[i].Name
+		for _, p := range k8sCG.Spec.Plugins {
+			if boundPlugins.Has(groupName + ":" + p.Name) {
+				failuresCollector.PushResourceFailure(
+					fmt.Sprintf("plugin %q from spec.plugins is already bound to the consumer group with %s annotation, ignoring it",
+						p.Name, annotations.AnnotationPrefix+annotations.PluginsKey),
+					k8sCG,
+				)
+				continue
+			}
+			config, err := RawConfigurationWithPatchesToConfiguration(s, k8sCG.Namespace, p.Config, nil)
+			if err != nil {
+				failuresCollector.PushResourceFailure(
+					fmt.Sprintf("could not parse config of plugin %q: %s", p.Name, err), k8sCG,
+				)
+				continue
+			}
+			plugins = append(plugins, Plugin{
+				Plugin: kong.Plugin{
+					Name:          kong.String(p.Name),
+					Config:        config,
+					ConsumerGroup: &kong.ConsumerGroup{ID: kong.String(groupName)},
+					Tags:          util.GenerateTagsForObject(k8sCG),
+				},
+				K8sParent: k8sCG,
+			})
+		}
+	}
+	return plugins
 }
 
 // FillIDs iterates over the KongState and fills in the ID field for each entity
@@ -569,6 +569,30 @@ func TestFillConsumersAndCredentials(t *testing.T) {
 				"key": []byte("little-rabbits-be-good"),
 			},
 		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "vaultReferenceSecret",
+				Namespace: "default",
+				Labels: map[string]string{
+					labels.CredentialTypeLabel: "key-auth",
+				},
+			},
+			Data: map[string][]byte{
+				"key": []byte("{vault://env/little-rabbits-key}"),
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "unknownVaultReferenceSecret",
+				Namespace: "default",
+				Labels: map[string]string{
+					labels.CredentialTypeLabel: "key-auth",
+				},
+			},
+			Data: map[string][]byte{
+				"key": []byte("{vault://aws/little-rabbits-key}"),
+			},
+		},
 		{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "badTypeLabeledSecret",
@@ -782,6 +806,67 @@ func TestFillConsumersAndCredentials(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "KongConsumer with key-auth referencing configured vault",
+			k8sConsumers: []*kongv1.KongConsumer{
+				{
+					TypeMeta: kongConsumerTypeMeta,
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo",
+						Namespace: "default",
+						Annotations: map[string]string{
+							"kubernetes.io/ingress.class": annotations.DefaultIngressClass,
+						},
+					},
+					Username: "foo",
+					Credentials: []string{
+						"vaultReferenceSecret",
+					},
+				},
+			},
+			expectedKongStateConsumers: []Consumer{
+				{
+					Consumer: kong.Consumer{
+						Username: kong.String("foo"),
+					},
+					KeyAuths: []*KeyAuth{{kong.KeyAuth{
+						Key: kong.String("{vault://env/little-rabbits-key}"),
+						Tags: util.GenerateTagsForObject(&corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "vaultReferenceSecret"},
+						}),
+					}}},
+				},
+			},
+		},
+		{
+			name: "KongConsumer with key-auth referencing not configured vault",
+			k8sConsumers: []*kongv1.KongConsumer{
+				{
+					TypeMeta: kongConsumerTypeMeta,
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo",
+						Namespace: "default",
+						Annotations: map[string]string{
+							"kubernetes.io/ingress.class": annotations.DefaultIngressClass,
+						},
+					},
+					Username: "foo",
+					Credentials: []string{
+						"unknownVaultReferenceSecret",
+					},
+				},
+			},
+			expectedKongStateConsumers: []Consumer{
+				{
+					Consumer: kong.Consumer{
+						Username: kong.String("foo"),
+					},
+				},
+			},
+			expectedTranslationFailureMessages: map[k8stypes.NamespacedName]string{
+				{Namespace: "default", Name: "foo"}: `failed to provision credential: field key references vault with prefix "aws" that is not configured by any KongVault`,
+			},
+		},
 	}
 
 	for i, tc := range testCases {
@@ -791,6 +876,20 @@ func TestFillConsumersAndCredentials(t *testing.T) {
 			store, _ := store.NewFakeStore(store.FakeObjects{
 				Secrets:       secrets,
 				KongConsumers: tc.k8sConsumers,
+				KongVaults: []*kongv1alpha1.KongVault{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "env-vault",
+							Annotations: map[string]string{
+								annotations.IngressClassKey: annotations.DefaultIngressClass,
+							},
+						},
+						Spec: kongv1alpha1.KongVaultSpec{
+							Backend: "env",
+							Prefix:  "env",
+						},
+					},
+				},
 			})
 			logger := zapr.NewLogger(zap.NewNop())
 			failuresCollector := failures.NewResourceFailuresCollector(logger)
@@ -1202,6 +1301,187 @@ func TestKongState_FillUpstreamOverrides(t *testing.T) {
 	}
 }
 
+func TestKongState_FillConsumerGroups(t *testing.T) {
+	cgAnnotations := map[string]string{
+		annotations.IngressClassKey: annotations.DefaultIngressClass,
+	}
+	s, err := store.NewFakeStore(store.FakeObjects{
+		KongConsumerGroups: []*kongv1beta1.KongConsumerGroup{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "no-spec",
+					Namespace:   "default",
+					Annotations: cgAnnotations,
+				},
+			},
+			{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: kongv1beta1.GroupVersion.String(),
+					Kind:       "KongConsumerGroup",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "gold",
+					Namespace:   "default",
+					Annotations: cgAnnotations,
+				},
+				Spec: kongv1beta1.KongConsumerGroupSpec{
+					Name: "gold-tier",
+					Tags: []string{"tier:gold", "k8s-name:gold"},
+					Plugins: []kongv1beta1.KongConsumerGroupPlugin{
+						{
+							Name:   "rate-limiting-advanced",
+							Config: apiextensionsv1.JSON{Raw: []byte(`{"limit":[1000],"window_size":[60]}`)},
+						},
+						{
+							Name:   "invalid-config",
+							Config: apiextensionsv1.JSON{Raw: []byte(`[1]`)},
+						},
+					},
+				},
+			},
+		},
+		KongConsumers: []*kongv1.KongConsumer{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "consumer",
+					Namespace:   "default",
+					Annotations: cgAnnotations,
+				},
+				Username:       "consumer",
+				ConsumerGroups: []string{"gold", "no-spec"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	logger := testr.New(t)
+	f := failures.NewResourceFailuresCollector(logger)
+	ks := &KongState{}
+	ks.FillConsumersAndCredentials(logger, s, f)
+	ks.FillConsumerGroups(logger, s, f)
+	ks.FillPlugins(logger, s, f)
+
+	t.Log("Verifying that consumer groups use spec.name and spec.tags when set")
+	require.Len(t, ks.ConsumerGroups, 2)
+	groups := lo.SliceToMap(ks.ConsumerGroups, func(cg ConsumerGroup) (string, ConsumerGroup) {
+		return cg.K8sKongConsumerGroup.Name, cg
+	})
+	require.Equal(t, "no-spec", *groups["no-spec"].Name)
+	require.Equal(t, "gold-tier", *groups["gold"].Name)
+	goldTags := lo.Map(groups["gold"].Tags, func(tag *string, _ int) string { return *tag })
+	require.Contains(t, goldTags, "tier:gold")
+	require.Equal(t, 1, lo.Count(goldTags, "k8s-name:gold"), "spec tags duplicating generated ones should be skipped")
+
+	t.Log("Verifying that consumers refer to consumer groups by their Kong names")
+	require.Len(t, ks.Consumers, 1)
+	require.ElementsMatch(t, []string{"gold-tier", "no-spec"}, lo.Map(ks.Consumers[0].ConsumerGroups,
+		func(cg kong.ConsumerGroup, _ int) string { return *cg.Name },
+	))
+
+	t.Log("Verifying that plugins from spec.plugins are scoped to the consumer group")
+	require.Len(t, ks.Plugins, 1)
+	plugin := ks.Plugins[0]
+	require.Equal(t, "rate-limiting-advanced", *plugin.Name)
+	require.Equal(t, "gold-tier", *plugin.ConsumerGroup.ID)
+	require.Equal(t, kong.Configuration{"limit": []any{float64(1000)}, "window_size": []any{float64(60)}}, plugin.Config)
+	require.Equal(t, "gold", plugin.K8sParent.GetName())
+
+	t.Log("Verifying that a plugin with invalid config is reported as a translation failure")
+	translationFailures := f.PopResourceFailures()
+	require.Len(t, translationFailures, 1)
+	require.Contains(t, translationFailures[0].Message(), `could not parse config of plugin "invalid-config"`)
+}
+
+func TestKongState_FillConsumerGroups_DuplicateKongNames(t *testing.T) {
+	cgAnnotations := map[string]string{
+		annotations.IngressClassKey: annotations.DefaultIngressClass,
+	}
+	cgTypeMeta := metav1.TypeMeta{
+		APIVersion: kongv1beta1.GroupVersion.String(),
+		Kind:       "KongConsumerGroup",
+	}
+	now := time.Now()
+	s, err := store.NewFakeStore(store.FakeObjects{
+		KongConsumerGroups: []*kongv1beta1.KongConsumerGroup{
+			{
+				TypeMeta: cgTypeMeta,
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "gold",
+					Namespace:         "default",
+					Annotations:       cgAnnotations,
+					CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+				},
+			},
+			{
+				TypeMeta: cgTypeMeta,
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "gold-tier",
+					Namespace:         "default",
+					Annotations:       cgAnnotations,
+					CreationTimestamp: metav1.NewTime(now),
+				},
+				Spec: kongv1beta1.KongConsumerGroupSpec{
+					Name: "gold",
+				},
+			},
+			{
+				TypeMeta: cgTypeMeta,
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "gold",
+					Namespace:         "other",
+					Annotations:       cgAnnotations,
+					CreationTimestamp: metav1.NewTime(now),
+				},
+			},
+		},
+		KongConsumers: []*kongv1.KongConsumer{
+			{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: kongv1.GroupVersion.String(),
+					Kind:       "KongConsumer",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "consumer",
+					Namespace:   "default",
+					Annotations: cgAnnotations,
+				},
+				Username:       "consumer",
+				ConsumerGroups: []string{"gold", "gold-tier"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	logger := testr.New(t)
+	f := failures.NewResourceFailuresCollector(logger)
+	ks := &KongState{}
+	ks.FillConsumersAndCredentials(logger, s, f)
+	ks.FillConsumerGroups(logger, s, f)
+
+	t.Log("Verifying that only the oldest KongConsumerGroup is translated")
+	require.Len(t, ks.ConsumerGroups, 1)
+	require.Equal(t, "gold", *ks.ConsumerGroups[0].Name)
+	require.Equal(t, "default", ks.ConsumerGroups[0].K8sKongConsumerGroup.Namespace)
+	require.Equal(t, "gold", ks.ConsumerGroups[0].K8sKongConsumerGroup.Name)
+
+	t.Log("Verifying that the consumer is added only to the translated consumer group")
+	require.Len(t, ks.Consumers, 1)
+	require.Len(t, ks.Consumers[0].ConsumerGroups, 1)
+	require.Equal(t, "gold", *ks.Consumers[0].ConsumerGroups[0].Name)
+
+	t.Log("Verifying that duplicates and the consumer referring to them are reported as translation failures")
+	translationFailures := f.PopResourceFailures()
+	require.Len(t, translationFailures, 3)
+	failedObjects := lo.Map(translationFailures, func(f failures.ResourceFailure, _ int) string {
+		obj := f.CausingObjects()[0]
+		return obj.GetNamespace() + "/" + obj.GetName()
+	})
+	require.ElementsMatch(t, []string{"default/consumer", "default/gold-tier", "other/gold"}, failedObjects)
+	for _, failure := range translationFailures {
+		require.Contains(t, failure.Message(), `"gold" is already used by KongConsumerGroup default/gold`)
+	}
+}
+
 func TestFillVaults(t *testing.T) {
 	kongVaultTypeMeta := metav1.TypeMeta{
 		APIVersion: kongv1alpha1.GroupVersion.String(),
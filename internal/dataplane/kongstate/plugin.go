@@ -67,9 +67,9 @@ func kongPluginFromK8SClusterPlugin(
 	}
 	if k8sPlugin.ConfigFrom != nil {
 		var err error
-		config, err = NamespacedSecretToConfiguration(
+		config, err = NamespacedConfigSourceToConfiguration(
 			s,
-			k8sPlugin.ConfigFrom.SecretValue)
+			*k8sPlugin.ConfigFrom)
 		if err != nil {
 			return Plugin{},
 				fmt.Errorf("error parsing config for KongClusterPlugin %s: %w",
@@ -122,8 +122,8 @@ func kongPluginFromK8SPlugin(
 	}
 	if k8sPlugin.ConfigFrom != nil {
 		var err error
-		config, err = SecretToConfiguration(s,
-			k8sPlugin.ConfigFrom.SecretValue, k8sPlugin.Namespace)
+		config, err = ConfigSourceToConfiguration(s,
+			*k8sPlugin.ConfigFrom, k8sPlugin.Namespace)
 		if err != nil {
 			return Plugin{},
 				fmt.Errorf("error parsing config for KongPlugin '%s/%s': %w",
@@ -156,17 +156,11 @@ var (
 	JSONPatchOpReplace JSONPatchOp = "replace"
 )
 
-func applyJSONPatchFromNamespacedSecretRef(s SecretGetter, raw []byte, path string, namespace string, secretName string, key string) ([]byte, error) {
-	secret, err := s.GetSecret(namespace, secretName)
+func applyJSONPatchFromConfigSource(s ConfigSourceGetter, raw []byte, path string, source kongv1.NamespacedConfigSource) ([]byte, error) {
+	value, err := configSourceValue(s, source)
 	if err != nil {
 		return nil, err
 	}
-	secretVal, ok := secret.Data[key]
-	if !ok {
-		return nil,
-			fmt.Errorf("no key '%v' in secret '%v/%v'",
-				key, namespace, secretName)
-	}
 
 	// JSON patch (RFC6902) specifies the behavior of applying "add" on root,
 	// but because the jsonpatch package could not do "add" on root path (path=""),
@@ -177,7 +171,7 @@ func applyJSONPatchFromNamespacedSecretRef(s SecretGetter, raw []byte, path stri
 		op = JSONPatchOpReplace
 	}
 
-	rawPatch := fmt.Sprintf(rawPatchPattern, op, path, string(secretVal))
+	rawPatch := fmt.Sprintf(rawPatchPattern, op, path, string(value))
 	p, err := jsonpatch.DecodePatch([]byte(rawPatch))
 	if err != nil {
 		return nil, err
@@ -195,9 +189,62 @@ func applyJSONPatchFromNamespacedSecretRef(s SecretGetter, raw []byte, path stri
 	return raw, nil
 }
 
+// configSourceValue returns the value of the key of the Secret or the ConfigMap referenced by the source.
+func configSourceValue(s ConfigSourceGetter, source kongv1.NamespacedConfigSource) ([]byte, error) {
+	if ref := source.ConfigMapValue; ref != nil {
+		configMap, err := s.GetConfigMap(ref.Namespace, ref.ConfigMap)
+		if err != nil {
+			return nil, err
+		}
+		value, ok := configMapKeyValue(configMap, ref.Key)
+		if !ok {
+			return nil, fmt.Errorf("no key '%v' in ConfigMap '%v/%v'", ref.Key, ref.Namespace, ref.ConfigMap)
+		}
+		return value, nil
+	}
+	ref := source.SecretValue
+	secret, err := s.GetSecret(ref.Namespace, ref.Secret)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return nil, fmt.Errorf("no key '%v' in secret '%v/%v'", ref.Key, ref.Namespace, ref.Secret)
+	}
+	return value, nil
+}
+
+// configMapKeyValue returns the value of the key from either data or binaryData of the ConfigMap.
+func configMapKeyValue(configMap *corev1.ConfigMap, key string) ([]byte, bool) {
+	if value, ok := configMap.Data[key]; ok {
+		return []byte(value), true
+	}
+	value, ok := configMap.BinaryData[key]
+	return value, ok
+}
+
+// namespacedConfigSource returns the NamespacedConfigSource equivalent of a ConfigSource of an object in the namespace.
+func namespacedConfigSource(source kongv1.ConfigSource, namespace string) kongv1.NamespacedConfigSource {
+	namespaced := kongv1.NamespacedConfigSource{
+		SecretValue: kongv1.NamespacedSecretValueFromSource{
+			Namespace: namespace,
+			Secret:    source.SecretValue.Secret,
+			Key:       source.SecretValue.Key,
+		},
+	}
+	if source.ConfigMapValue != nil {
+		namespaced.ConfigMapValue = &kongv1.NamespacedConfigMapValueFromSource{
+			Namespace: namespace,
+			ConfigMap: source.ConfigMapValue.ConfigMap,
+			Key:       source.ConfigMapValue.Key,
+		}
+	}
+	return namespaced
+}
+
 // RawConfigurationWithPatchesToConfiguration converts config and add patches from configPatches of KongPlugin.
 func RawConfigurationWithPatchesToConfiguration(
-	s SecretGetter, namespace string,
+	s ConfigSourceGetter, namespace string,
 	rawConfig apiextensionsv1.JSON,
 	patches []kongv1.ConfigPatch,
 ) (kong.Configuration, error) {
@@ -211,13 +258,11 @@ func RawConfigurationWithPatchesToConfiguration(
 	// apply patches
 	for _, patch := range patches {
 		var err error
-		raw, err = applyJSONPatchFromNamespacedSecretRef(
+		raw, err = applyJSONPatchFromConfigSource(
 			s,
 			raw,
 			patch.Path,
-			namespace,
-			patch.ValueFrom.SecretValue.Secret,
-			patch.ValueFrom.SecretValue.Key,
+			namespacedConfigSource(patch.ValueFrom, namespace),
 		)
 		if err != nil {
 			return kong.Configuration{}, err
@@ -228,7 +273,7 @@ func RawConfigurationWithPatchesToConfiguration(
 
 // RawConfigurationWithNamespacedPatchesToConfiguration converts config and add patches from configPatches of KongClusterPlugin.
 func RawConfigurationWithNamespacedPatchesToConfiguration(
-	s SecretGetter,
+	s ConfigSourceGetter,
 	rawConfig apiextensionsv1.JSON,
 	patches []kongv1.NamespacedConfigPatch,
 ) (kong.Configuration, error) {
@@ -241,13 +286,11 @@ func RawConfigurationWithNamespacedPatchesToConfiguration(
 	}
 	for _, patch := range patches {
 		var err error
-		raw, err = applyJSONPatchFromNamespacedSecretRef(
+		raw, err = applyJSONPatchFromConfigSource(
 			s,
 			raw,
 			patch.Path,
-			patch.ValueFrom.SecretValue.Namespace,
-			patch.ValueFrom.SecretValue.Secret,
-			patch.ValueFrom.SecretValue.Key,
+			patch.ValueFrom,
 		)
 		if err != nil {
 			return kong.Configuration{}, err
@@ -256,6 +299,31 @@ func RawConfigurationWithNamespacedPatchesToConfiguration(
 	return RawConfigToConfiguration(raw)
 }
 
+// ConfigSourceToConfiguration fetches specified value from the Secret or the ConfigMap in the namespace
+// referenced by the source, then parse the value to Kong plugin configurations.
+// Exported primarily to be used in admission validators.
+func ConfigSourceToConfiguration(
+	s ConfigSourceGetter,
+	source kongv1.ConfigSource, namespace string) (
+	kong.Configuration, error,
+) {
+	return NamespacedConfigSourceToConfiguration(s, namespacedConfigSource(source, namespace))
+}
+
+// NamespacedConfigSourceToConfiguration fetches specified value from the Secret or the ConfigMap referenced
+// by the source, then parse the value to Kong plugin configurations.
+// Exported primarily to be used in admission validators.
+func NamespacedConfigSourceToConfiguration(
+	s ConfigSourceGetter,
+	source kongv1.NamespacedConfigSource) (
+	kong.Configuration, error,
+) {
+	if source.ConfigMapValue != nil {
+		return namespacedConfigMapToConfiguration(s, *source.ConfigMapValue)
+	}
+	return NamespacedSecretToConfiguration(s, source.SecretValue)
+}
+
 // NamespacedSecretToConfiguration fetches specified value from given namespace, secret and key,
 // then parse the value to Kong plugin configurations.
 // Exported primarily to be used in admission validators.
@@ -275,6 +343,17 @@ type SecretGetter interface {
 	GetSecret(namespace, name string) (*corev1.Secret, error)
 }
 
+// ConfigMapGetter fetches ConfigMaps plugin configuration can be sourced from.
+type ConfigMapGetter interface {
+	GetConfigMap(namespace, name string) (*corev1.ConfigMap, error)
+}
+
+// ConfigSourceGetter fetches Secrets and ConfigMaps plugin configuration can be sourced from.
+type ConfigSourceGetter interface {
+	SecretGetter
+	ConfigMapGetter
+}
+
 // SecretToConfiguration fetches specified value from secret and key in the namespace,
 // then parse the value to Kong plugin configurations.
 // Exported primarily to be used in admission validators.
@@ -295,13 +374,41 @@ func SecretToConfiguration(
 			fmt.Errorf("no key '%v' in secret '%v/%v'",
 				reference.Key, namespace, reference.Secret)
 	}
+	return valueToConfiguration(secretVal, reference.Key, fmt.Sprintf("secret '%v/%v'", namespace, reference.Secret))
+}
+
+// namespacedConfigMapToConfiguration fetches specified value from given namespace, ConfigMap and key,
+// then parse the value to Kong plugin configurations.
+func namespacedConfigMapToConfiguration(
+	s ConfigMapGetter,
+	reference kongv1.NamespacedConfigMapValueFromSource) (
+	kong.Configuration, error,
+) {
+	configMap, err := s.GetConfigMap(reference.Namespace, reference.ConfigMap)
+	if err != nil {
+		return kong.Configuration{}, fmt.Errorf(
+			"error fetching plugin configuration ConfigMap '%v/%v': %w",
+			reference.Namespace, reference.ConfigMap, err)
+	}
+	value, ok := configMapKeyValue(configMap, reference.Key)
+	if !ok {
+		return kong.Configuration{},
+			fmt.Errorf("no key '%v' in ConfigMap '%v/%v'",
+				reference.Key, reference.Namespace, reference.ConfigMap)
+	}
+	return valueToConfiguration(value, reference.Key,
+		fmt.Sprintf("ConfigMap '%v/%v'", reference.Namespace, reference.ConfigMap))
+}
+
+// valueToConfiguration parses the value of the key in the given source (used in error messages only)
+// as JSON or YAML Kong plugin configuration.
+func valueToConfiguration(value []byte, key string, source string) (kong.Configuration, error) {
 	var config kong.Configuration
-	if err := json.Unmarshal(secretVal, &config); err != nil {
-		if err := yaml.Unmarshal(secretVal, &config); err != nil {
+	if err := json.Unmarshal(value, &config); err != nil {
+		if err := yaml.Unmarshal(value, &config); err != nil {
 			return kong.Configuration{},
-				fmt.Errorf("key '%v' in secret '%v/%v' contains neither "+
-					"valid JSON nor valid YAML)",
-					reference.Key, namespace, reference.Secret)
+				fmt.Errorf("key '%v' in %s contains neither "+
+					"valid JSON nor valid YAML)", key, source)
 		}
 	}
 	return config, nil
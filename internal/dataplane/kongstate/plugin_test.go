@@ -31,6 +31,21 @@ func TestKongPluginFromK8SClusterPlugin(t *testing.T) {
 				},
 			},
 		},
+		ConfigMaps: []*corev1.ConfigMap{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "conf-configmap",
+					Namespace: "default",
+				},
+				Data: map[string]string{
+					"correlation-id-config":    "header_name: bar",
+					"correlation-id-generator": `"counter"`,
+				},
+				BinaryData: map[string][]byte{
+					"response-transformer-add-headers": []byte(`["h3:v3"]`),
+				},
+			},
+		},
 	})
 	type args struct {
 		plugin kongv1.KongClusterPlugin
@@ -105,6 +120,47 @@ func TestKongPluginFromK8SClusterPlugin(t *testing.T) {
 			want:    kong.Plugin{},
 			wantErr: true,
 		},
+		{
+			name: "ConfigMap configuration",
+			args: args{
+				plugin: kongv1.KongClusterPlugin{
+					Protocols:  []kongv1.KongProtocol{"http"},
+					PluginName: "correlation-id",
+					ConfigFrom: &kongv1.NamespacedConfigSource{
+						ConfigMapValue: &kongv1.NamespacedConfigMapValueFromSource{
+							Key:       "correlation-id-config",
+							ConfigMap: "conf-configmap",
+							Namespace: "default",
+						},
+					},
+				},
+			},
+			want: kong.Plugin{
+				Name: kong.String("correlation-id"),
+				Config: kong.Configuration{
+					"header_name": "bar",
+				},
+				Protocols: kong.StringSlice("http"),
+			},
+		},
+		{
+			name: "missing ConfigMap configuration",
+			args: args{
+				plugin: kongv1.KongClusterPlugin{
+					Protocols:  []kongv1.KongProtocol{"http"},
+					PluginName: "correlation-id",
+					ConfigFrom: &kongv1.NamespacedConfigSource{
+						ConfigMapValue: &kongv1.NamespacedConfigMapValueFromSource{
+							Key:       "correlation-id-config",
+							ConfigMap: "missing",
+							Namespace: "default",
+						},
+					},
+				},
+			},
+			want:    kong.Plugin{},
+			wantErr: true,
+		},
 		{
 			name: "non-JSON configuration",
 			args: args{
@@ -282,6 +338,74 @@ func TestKongPluginFromK8SClusterPlugin(t *testing.T) {
 				Protocols: kong.StringSlice("http"),
 			},
 		},
+		{
+			name: "configPatches from ConfigMap and secret",
+			args: args{
+				plugin: kongv1.KongClusterPlugin{
+					Protocols:  []kongv1.KongProtocol{"http"},
+					PluginName: "response-transformer",
+					ConfigPatches: []kongv1.NamespacedConfigPatch{
+						{
+							Path: "/add/headers",
+							ValueFrom: kongv1.NamespacedConfigSource{
+								ConfigMapValue: &kongv1.NamespacedConfigMapValueFromSource{
+									Namespace: "default",
+									Key:       "response-transformer-add-headers",
+									ConfigMap: "conf-configmap",
+								},
+							},
+						},
+						{
+							Path: "/remove/headers",
+							ValueFrom: kongv1.NamespacedConfigSource{
+								SecretValue: kongv1.NamespacedSecretValueFromSource{
+									Namespace: "default",
+									Key:       "response-transformer-add-headers",
+									Secret:    "conf-secret",
+								},
+							},
+						},
+					},
+				},
+			},
+			want: kong.Plugin{
+				Name: kong.String("response-transformer"),
+				Config: kong.Configuration{
+					"add": map[string]interface{}{
+						"headers": []interface{}{"h3:v3"},
+					},
+					"remove": map[string]interface{}{
+						"headers": []interface{}{"h1:v1", "h2:v2"},
+					},
+				},
+				Protocols: kong.StringSlice("http"),
+			},
+		},
+		{
+			name: "missing key of ConfigMap in configPatches",
+			args: args{
+				plugin: kongv1.KongClusterPlugin{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test",
+					},
+					Protocols:  []kongv1.KongProtocol{"http"},
+					PluginName: "correlation-id",
+					ConfigPatches: []kongv1.NamespacedConfigPatch{
+						{
+							Path: "/generator",
+							ValueFrom: kongv1.NamespacedConfigSource{
+								ConfigMapValue: &kongv1.NamespacedConfigMapValueFromSource{
+									Namespace: "default",
+									Key:       "correlation-id-missing",
+									ConfigMap: "conf-configmap",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "missing secret in configPatches",
 			args: args{
@@ -398,6 +522,21 @@ func TestKongPluginFromK8SPlugin(t *testing.T) {
 				},
 			},
 		},
+		ConfigMaps: []*corev1.ConfigMap{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "conf-configmap",
+					Namespace: "default",
+				},
+				Data: map[string]string{
+					"correlation-id-config":    "header_name: bar",
+					"correlation-id-generator": `"counter"`,
+				},
+				BinaryData: map[string][]byte{
+					"response-transformer-add-headers": []byte(`["h3:v3"]`),
+				},
+			},
+		},
 	})
 	type args struct {
 		plugin kongv1.KongPlugin
@@ -478,6 +617,88 @@ func TestKongPluginFromK8SPlugin(t *testing.T) {
 			want:    kong.Plugin{},
 			wantErr: true,
 		},
+		{
+			name: "ConfigMap configuration",
+			args: args{
+				plugin: kongv1.KongPlugin{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo",
+						Namespace: "default",
+					},
+					Protocols:  []kongv1.KongProtocol{"http"},
+					PluginName: "correlation-id",
+					ConfigFrom: &kongv1.ConfigSource{
+						ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+							Key:       "correlation-id-config",
+							ConfigMap: "conf-configmap",
+						},
+					},
+				},
+			},
+			want: kong.Plugin{
+				Name: kong.String("correlation-id"),
+				Config: kong.Configuration{
+					"header_name": "bar",
+				},
+				Protocols: kong.StringSlice("http"),
+			},
+		},
+		{
+			name: "missing key of ConfigMap configuration",
+			args: args{
+				plugin: kongv1.KongPlugin{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo",
+						Namespace: "default",
+					},
+					Protocols:  []kongv1.KongProtocol{"http"},
+					PluginName: "correlation-id",
+					ConfigFrom: &kongv1.ConfigSource{
+						ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+							Key:       "correlation-id-missing",
+							ConfigMap: "conf-configmap",
+						},
+					},
+				},
+			},
+			want:    kong.Plugin{},
+			wantErr: true,
+		},
+		{
+			name: "config and configPatches from ConfigMap set",
+			args: args{
+				plugin: kongv1.KongPlugin{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo",
+						Namespace: "default",
+					},
+					Protocols:  []kongv1.KongProtocol{"http"},
+					PluginName: "correlation-id",
+					Config: apiextensionsv1.JSON{
+						Raw: []byte(`{"header_name": "foo"}`),
+					},
+					ConfigPatches: []kongv1.ConfigPatch{
+						{
+							Path: "/generator",
+							ValueFrom: kongv1.ConfigSource{
+								ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+									Key:       "correlation-id-generator",
+									ConfigMap: "conf-configmap",
+								},
+							},
+						},
+					},
+				},
+			},
+			want: kong.Plugin{
+				Name: kong.String("correlation-id"),
+				Config: kong.Configuration{
+					"header_name": "foo",
+					"generator":   "counter",
+				},
+				Protocols: kong.StringSlice("http"),
+			},
+		},
 		{
 			name: "non-JSON configuration",
 			args: args{
@@ -2,11 +2,13 @@ package kongstate
 
 import (
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/kong/go-kong/kong"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
@@ -20,6 +22,10 @@ type Route struct {
 	Ingress          util.K8sObjectInfo
 	Plugins          []kong.Plugin
 	ExpressionRoutes bool
+
+	// Gateways are the Gateways the route's source object is attached to. It's populated only when the
+	// GatewaySharding feature is enabled. Routes with no Gateways are configured in all Kong nodes.
+	Gateways []k8stypes.NamespacedName
 }
 
 var (
@@ -32,6 +38,26 @@ var (
 	validHosts = regexp.MustCompile(`^(\*\.)?([a-zA-Z0-9]+(-[a-zA-Z0-9]+)*)+(\.([a-zA-Z0-9]+(-[a-zA-Z0-9]+)*))*?(\.\*)?$`)
 )
 
+// DeepCopy returns a deep copy of the Route.
+func (r Route) DeepCopy() Route {
+	c := r
+	c.Route = *r.Route.DeepCopy()
+	if r.Ingress.Annotations != nil {
+		c.Ingress.Annotations = make(map[string]string, len(r.Ingress.Annotations))
+		for k, v := range r.Ingress.Annotations {
+			c.Ingress.Annotations[k] = v
+		}
+	}
+	c.Gateways = slices.Clone(r.Gateways)
+	if r.Plugins != nil {
+		c.Plugins = make([]kong.Plugin, 0, len(r.Plugins))
+		for _, p := range r.Plugins {
+			c.Plugins = append(c.Plugins, *p.DeepCopy())
+		}
+	}
+	return c
+}
+
 // normalizeProtocols prevents users from mismatching grpc/http.
 func (r *Route) normalizeProtocols() {
 	// skip updating protocols if expression routes enabled.
@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 )
 
@@ -58,6 +59,45 @@ type Service struct {
 	// For example, if this Service was created as a result of translating a Kubernetes Ingress, then
 	// Parent is expected to be the Ingress object itself.
 	Parent client.Object
+
+	// SessionPersistence is the Gateway API session persistence configured for the routes of this Service.
+	// It's translated into the hashing configuration of the Service's upstream.
+	SessionPersistence *gatewayapi.SessionPersistence
+}
+
+// DeepCopy returns a deep copy of the Service. Kubernetes objects it refers to (parent and Kubernetes Services)
+// are not copied.
+func (s Service) DeepCopy() Service {
+	c := s
+	c.Service = *s.Service.DeepCopy()
+	if s.Routes != nil {
+		c.Routes = make([]Route, 0, len(s.Routes))
+		for _, r := range s.Routes {
+			c.Routes = append(c.Routes, r.DeepCopy())
+		}
+	}
+	if s.Plugins != nil {
+		c.Plugins = make([]kong.Plugin, 0, len(s.Plugins))
+		for _, p := range s.Plugins {
+			c.Plugins = append(c.Plugins, *p.DeepCopy())
+		}
+	}
+	if s.Backends != nil {
+		c.Backends = make([]ServiceBackend, 0, len(s.Backends))
+		for _, b := range s.Backends {
+			c.Backends = append(c.Backends, b.DeepCopy())
+		}
+	}
+	if s.K8sServices != nil {
+		c.K8sServices = make(map[string]*corev1.Service, len(s.K8sServices))
+		for k, v := range s.K8sServices {
+			c.K8sServices[k] = v
+		}
+	}
+	if s.SessionPersistence != nil {
+		c.SessionPersistence = s.SessionPersistence.DeepCopy()
+	}
+	return c
 }
 
 func (s *Service) overridePath(anns map[string]string) {
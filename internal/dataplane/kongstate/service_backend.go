@@ -17,11 +17,15 @@ const (
 
 	// ServiceBackendTypeKubernetesService means that the backend is a Kubernetes Service.
 	ServiceBackendTypeKubernetesService ServiceBackendType = "KubernetesService"
+
+	// ServiceBackendTypeKongUpstreamTarget means that the backend is an incubatorv1alpha1.KongUpstreamTarget.
+	ServiceBackendTypeKongUpstreamTarget ServiceBackendType = "KongUpstreamTarget"
 )
 
 type ServiceBackends []ServiceBackend
 
-// ServiceBackend represents a backend for a Kong Service. It can be a Kubernetes Service or a KongServiceFacade.
+// ServiceBackend represents a backend for a Kong Service. It can be a Kubernetes Service, a KongServiceFacade
+// or a KongUpstreamTarget.
 type ServiceBackend struct {
 	backendType    ServiceBackendType
 	namespacedName k8stypes.NamespacedName
@@ -69,17 +73,35 @@ func NewServiceBackendForServiceFacade(nn k8stypes.NamespacedName, portDef PortD
 	)
 }
 
+// NewServiceBackendForKongUpstreamTarget creates a new ServiceBackend for a KongUpstreamTarget.
+func NewServiceBackendForKongUpstreamTarget(nn k8stypes.NamespacedName, portDef PortDef) (ServiceBackend, error) {
+	return NewServiceBackend(
+		ServiceBackendTypeKongUpstreamTarget,
+		nn,
+		portDef,
+	)
+}
+
+// DeepCopy returns a deep copy of the ServiceBackend.
+func (s ServiceBackend) DeepCopy() ServiceBackend {
+	c := s
+	if s.weight != nil {
+		c.weight = lo.ToPtr(*s.weight)
+	}
+	return c
+}
+
 // SetWeight sets the weight of the backend used for load-balancing.
 func (s *ServiceBackend) SetWeight(weight int32) {
 	s.weight = lo.ToPtr(int(weight))
 }
 
-// Name returns the name of the backend resource (Service or KongServiceFacade).
+// Name returns the name of the backend resource (Service, KongServiceFacade or KongUpstreamTarget).
 func (s *ServiceBackend) Name() string {
 	return s.namespacedName.Name
 }
 
-// Namespace returns the namespace of the backend resource (Service or KongServiceFacade).
+// Namespace returns the namespace of the backend resource (Service, KongServiceFacade or KongUpstreamTarget).
 func (s *ServiceBackend) Namespace() string {
 	return s.namespacedName.Namespace
 }
@@ -97,8 +119,12 @@ func (s *ServiceBackend) Weight() mo.Option[int] {
 	return mo.None[int]()
 }
 
-// IsServiceFacade returns true if the backend is a KongServiceFacade. Otherwise, returns false
-// what means that the backend is a Kubernetes Service.
+// IsServiceFacade returns true if the backend is a KongServiceFacade. Otherwise, returns false.
 func (s *ServiceBackend) IsServiceFacade() bool {
 	return s.backendType == ServiceBackendTypeKongServiceFacade
 }
+
+// IsKongUpstreamTarget returns true if the backend is a KongUpstreamTarget. Otherwise, returns false.
+func (s *ServiceBackend) IsKongUpstreamTarget() bool {
+	return s.backendType == ServiceBackendTypeKongUpstreamTarget
+}
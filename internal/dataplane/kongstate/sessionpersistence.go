@@ -0,0 +1,105 @@
+package kongstate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
+)
+
+const (
+	// DefaultSessionPersistenceCookieName is the name of the cookie used for cookie-based session persistence
+	// when no session name is specified.
+	DefaultSessionPersistenceCookieName = "kong-session"
+
+	// DefaultSessionPersistenceHeaderName is the name of the header used for header-based session persistence
+	// when no session name is specified.
+	DefaultSessionPersistenceHeaderName = "x-kong-session"
+
+	// kongUpstreamAlgorithmConsistentHashing is the only Kong upstream algorithm taking hashing settings into account.
+	kongUpstreamAlgorithmConsistentHashing = "consistent-hashing"
+)
+
+// TranslateSessionPersistence translates Gateway API SessionPersistence to the hashing settings of a Kong upstream.
+// Kong sets session cookies without an expiry and can't expire sessions, so the second return value lists
+// the settings that could not be translated.
+func TranslateSessionPersistence(sessionPersistence gatewayapi.SessionPersistence) (*kong.Upstream, []string) {
+	upstream := &kong.Upstream{
+		Algorithm: lo.ToPtr(kongUpstreamAlgorithmConsistentHashing),
+	}
+
+	sessionPersistenceType := gatewayapi.CookieBasedSessionPersistence
+	if sessionPersistence.Type != nil {
+		sessionPersistenceType = *sessionPersistence.Type
+	}
+	switch sessionPersistenceType {
+	case gatewayapi.HeaderBasedSessionPersistence:
+		upstream.HashOn = lo.ToPtr(KongHashOnTypeHeader)
+		upstream.HashOnHeader = lo.ToPtr(lo.FromPtrOr(sessionPersistence.SessionName, DefaultSessionPersistenceHeaderName))
+	default:
+		upstream.HashOn = lo.ToPtr(KongHashOnTypeCookie)
+		upstream.HashOnCookie = lo.ToPtr(lo.FromPtrOr(sessionPersistence.SessionName, DefaultSessionPersistenceCookieName))
+		upstream.HashOnCookiePath = lo.ToPtr("/")
+	}
+
+	var unsupported []string
+	if sessionPersistence.AbsoluteTimeout != nil {
+		unsupported = append(unsupported, "absoluteTimeout")
+	}
+	if sessionPersistence.IdleTimeout != nil {
+		unsupported = append(unsupported, "idleTimeout")
+	}
+	if sessionPersistence.CookieConfig != nil && sessionPersistence.CookieConfig.LifetimeType != nil &&
+		*sessionPersistence.CookieConfig.LifetimeType == gatewayapi.PermanentCookieLifetimeType {
+		unsupported = append(unsupported, "cookieConfig.lifetimeType=Permanent")
+	}
+	return upstream, unsupported
+}
+
+// overrideBySessionPersistence modifies the Kong upstream based on the session persistence configured
+// for the routes of its service. Hashing configured by a KongUpstreamPolicy takes precedence over the
+// session persistence, and the conflict is reported as a translation failure of the service's parent and the policy.
+func (u *Upstream) overrideBySessionPersistence(
+	policy *kongv1beta1.KongUpstreamPolicy,
+	failuresCollector *failures.ResourceFailuresCollector,
+) {
+	if u == nil || u.Service.SessionPersistence == nil {
+		return
+	}
+
+	if policy != nil && KongUpstreamPolicyConfiguresHashing(policy.Spec) {
+		failuresCollector.PushResourceFailure(
+			fmt.Sprintf("sessionPersistence conflicts with hashing configured by KongUpstreamPolicy %s/%s "+
+				"which takes precedence", policy.Namespace, policy.Name),
+			u.Service.Parent, policy,
+		)
+		return
+	}
+
+	overrides, unsupported := TranslateSessionPersistence(*u.Service.SessionPersistence)
+	u.Algorithm = overrides.Algorithm
+	u.HashOn = overrides.HashOn
+	u.HashOnHeader = overrides.HashOnHeader
+	u.HashOnCookie = overrides.HashOnCookie
+	u.HashOnCookiePath = overrides.HashOnCookiePath
+
+	if len(unsupported) > 0 {
+		failuresCollector.PushResourceFailure(
+			fmt.Sprintf("sessionPersistence settings %s are not supported and were ignored",
+				strings.Join(unsupported, ", ")),
+			u.Service.Parent,
+		)
+	}
+}
+
+// KongUpstreamPolicyConfiguresHashing returns true if the KongUpstreamPolicy configures hashing or an algorithm
+// that doesn't use hashing, i.e. it takes precedence over HTTPRoute's sessionPersistence.
+func KongUpstreamPolicyConfiguresHashing(policy kongv1beta1.KongUpstreamPolicySpec) bool {
+	return policy.HashOn != nil || policy.HashOnFallback != nil ||
+		(policy.Algorithm != nil && *policy.Algorithm != kongUpstreamAlgorithmConsistentHashing)
+}
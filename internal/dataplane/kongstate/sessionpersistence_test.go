@@ -0,0 +1,227 @@
+package kongstate
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	kongv1beta1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1beta1"
+)
+
+func TestTranslateSessionPersistence(t *testing.T) {
+	testCases := []struct {
+		name                string
+		sessionPersistence  gatewayapi.SessionPersistence
+		expected            *kong.Upstream
+		expectedUnsupported []string
+	}{
+		{
+			name:               "cookie type by default with the default cookie name",
+			sessionPersistence: gatewayapi.SessionPersistence{},
+			expected: &kong.Upstream{
+				Algorithm:        kong.String("consistent-hashing"),
+				HashOn:           kong.String("cookie"),
+				HashOnCookie:     kong.String(DefaultSessionPersistenceCookieName),
+				HashOnCookiePath: kong.String("/"),
+			},
+		},
+		{
+			name: "cookie type with a session name",
+			sessionPersistence: gatewayapi.SessionPersistence{
+				SessionName: lo.ToPtr("my-session"),
+				Type:        lo.ToPtr(gatewayapi.CookieBasedSessionPersistence),
+			},
+			expected: &kong.Upstream{
+				Algorithm:        kong.String("consistent-hashing"),
+				HashOn:           kong.String("cookie"),
+				HashOnCookie:     kong.String("my-session"),
+				HashOnCookiePath: kong.String("/"),
+			},
+		},
+		{
+			name: "header type with a session name",
+			sessionPersistence: gatewayapi.SessionPersistence{
+				SessionName: lo.ToPtr("x-session-id"),
+				Type:        lo.ToPtr(gatewayapi.HeaderBasedSessionPersistence),
+			},
+			expected: &kong.Upstream{
+				Algorithm:    kong.String("consistent-hashing"),
+				HashOn:       kong.String("header"),
+				HashOnHeader: kong.String("x-session-id"),
+			},
+		},
+		{
+			name: "header type with the default header name",
+			sessionPersistence: gatewayapi.SessionPersistence{
+				Type: lo.ToPtr(gatewayapi.HeaderBasedSessionPersistence),
+			},
+			expected: &kong.Upstream{
+				Algorithm:    kong.String("consistent-hashing"),
+				HashOn:       kong.String("header"),
+				HashOnHeader: kong.String(DefaultSessionPersistenceHeaderName),
+			},
+		},
+		{
+			name: "timeouts and permanent cookies are not supported",
+			sessionPersistence: gatewayapi.SessionPersistence{
+				AbsoluteTimeout: lo.ToPtr(gatewayapi.Duration("1h")),
+				IdleTimeout:     lo.ToPtr(gatewayapi.Duration("10m")),
+				CookieConfig: &gatewayapi.CookieConfig{
+					LifetimeType: lo.ToPtr(gatewayapi.PermanentCookieLifetimeType),
+				},
+			},
+			expected: &kong.Upstream{
+				Algorithm:        kong.String("consistent-hashing"),
+				HashOn:           kong.String("cookie"),
+				HashOnCookie:     kong.String(DefaultSessionPersistenceCookieName),
+				HashOnCookiePath: kong.String("/"),
+			},
+			expectedUnsupported: []string{"absoluteTimeout", "idleTimeout", "cookieConfig.lifetimeType=Permanent"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			upstream, unsupported := TranslateSessionPersistence(tc.sessionPersistence)
+			assert.Equal(t, tc.expected, upstream)
+			assert.Equal(t, tc.expectedUnsupported, unsupported)
+		})
+	}
+}
+
+func TestUpstreamOverrideBySessionPersistence(t *testing.T) {
+	httpRoute := &gatewayapi.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayapi.GroupVersion.String(),
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "httproute",
+			Namespace: "default",
+		},
+	}
+	sessionPersistence := &gatewayapi.SessionPersistence{
+		SessionName: lo.ToPtr("my-session"),
+	}
+	newPolicy := func(spec kongv1beta1.KongUpstreamPolicySpec) *kongv1beta1.KongUpstreamPolicy {
+		return &kongv1beta1.KongUpstreamPolicy{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: kongv1beta1.GroupVersion.String(),
+				Kind:       "KongUpstreamPolicy",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "policy",
+				Namespace: "default",
+			},
+			Spec: spec,
+		}
+	}
+
+	testCases := []struct {
+		name                string
+		sessionPersistence  *gatewayapi.SessionPersistence
+		policy              *kongv1beta1.KongUpstreamPolicy
+		expected            kong.Upstream
+		expectedFailureMsgs []string
+	}{
+		{
+			name:     "no session persistence",
+			policy:   newPolicy(kongv1beta1.KongUpstreamPolicySpec{Algorithm: lo.ToPtr("least-connections")}),
+			expected: kong.Upstream{Algorithm: kong.String("least-connections")},
+		},
+		{
+			name:               "session persistence without policy",
+			sessionPersistence: sessionPersistence,
+			expected: kong.Upstream{
+				Algorithm:        kong.String("consistent-hashing"),
+				HashOn:           kong.String("cookie"),
+				HashOnCookie:     kong.String("my-session"),
+				HashOnCookiePath: kong.String("/"),
+			},
+		},
+		{
+			name:               "session persistence with policy not configuring hashing",
+			sessionPersistence: sessionPersistence,
+			policy:             newPolicy(kongv1beta1.KongUpstreamPolicySpec{Slots: lo.ToPtr(100)}),
+			expected: kong.Upstream{
+				Algorithm:        kong.String("consistent-hashing"),
+				HashOn:           kong.String("cookie"),
+				HashOnCookie:     kong.String("my-session"),
+				HashOnCookiePath: kong.String("/"),
+				Slots:            kong.Int(100),
+			},
+		},
+		{
+			name:               "session persistence conflicting with policy hashing",
+			sessionPersistence: sessionPersistence,
+			policy: newPolicy(kongv1beta1.KongUpstreamPolicySpec{
+				Algorithm: lo.ToPtr("consistent-hashing"),
+				HashOn: &kongv1beta1.KongUpstreamHash{
+					Header: lo.ToPtr("x-user"),
+				},
+			}),
+			expected: kong.Upstream{
+				Algorithm:    kong.String("consistent-hashing"),
+				HashOn:       kong.String("header"),
+				HashOnHeader: kong.String("x-user"),
+			},
+			expectedFailureMsgs: []string{
+				"sessionPersistence conflicts with hashing configured by KongUpstreamPolicy default/policy which takes precedence",
+			},
+		},
+		{
+			name:               "session persistence conflicting with policy algorithm",
+			sessionPersistence: sessionPersistence,
+			policy:             newPolicy(kongv1beta1.KongUpstreamPolicySpec{Algorithm: lo.ToPtr("round-robin")}),
+			expected:           kong.Upstream{Algorithm: kong.String("round-robin")},
+			expectedFailureMsgs: []string{
+				"sessionPersistence conflicts with hashing configured by KongUpstreamPolicy default/policy which takes precedence",
+			},
+		},
+		{
+			name: "session persistence with unsupported settings",
+			sessionPersistence: &gatewayapi.SessionPersistence{
+				IdleTimeout: lo.ToPtr(gatewayapi.Duration("10m")),
+			},
+			expected: kong.Upstream{
+				Algorithm:        kong.String("consistent-hashing"),
+				HashOn:           kong.String("cookie"),
+				HashOnCookie:     kong.String(DefaultSessionPersistenceCookieName),
+				HashOnCookiePath: kong.String("/"),
+			},
+			expectedFailureMsgs: []string{
+				"sessionPersistence settings idleTimeout are not supported and were ignored",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			upstream := &Upstream{
+				Service: Service{
+					Parent:             httpRoute,
+					SessionPersistence: tc.sessionPersistence,
+				},
+			}
+			if tc.policy != nil {
+				upstream.overrideByKongUpstreamPolicy(tc.policy)
+			}
+			failuresCollector := failures.NewResourceFailuresCollector(logr.Discard())
+			upstream.overrideBySessionPersistence(tc.policy, failuresCollector)
+
+			require.Equal(t, tc.expected, upstream.Upstream)
+			failureMsgs := make([]string, 0)
+			for _, f := range failuresCollector.PopResourceFailures() {
+				failureMsgs = append(failureMsgs, f.Message())
+			}
+			require.ElementsMatch(t, tc.expectedFailureMsgs, failureMsgs)
+		})
+	}
+}
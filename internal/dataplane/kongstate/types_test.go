@@ -5,6 +5,7 @@ import (
 
 	"github.com/kong/go-kong/kong"
 	"github.com/stretchr/testify/assert"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 )
 
 func TestCertificate_SanitizedCopy(t *testing.T) {
@@ -15,22 +16,22 @@ func TestCertificate_SanitizedCopy(t *testing.T) {
 	}{
 		{
 			name: "fills all fields but Consumer and sanitizes key",
-			in: Certificate{kong.Certificate{
+			in: Certificate{Certificate: kong.Certificate{
 				ID:        kong.String("1"),
 				Cert:      kong.String("2"),
 				Key:       kong.String("3"),
 				CreatedAt: int64Ptr(4),
 				SNIs:      []*string{kong.String("5.1"), kong.String("5.2")},
 				Tags:      []*string{kong.String("6.1"), kong.String("6.2")},
-			}},
-			want: Certificate{kong.Certificate{
+			}, Gateways: []k8stypes.NamespacedName{{Namespace: "ns", Name: "gateway"}}},
+			want: Certificate{Certificate: kong.Certificate{
 				ID:        kong.String("1"),
 				Cert:      kong.String("2"),
 				Key:       redactedString,
 				CreatedAt: int64Ptr(4),
 				SNIs:      []*string{kong.String("5.1"), kong.String("5.2")},
 				Tags:      []*string{kong.String("6.1"), kong.String("6.2")},
-			}},
+			}, Gateways: []k8stypes.NamespacedName{{Namespace: "ns", Name: "gateway"}}},
 		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
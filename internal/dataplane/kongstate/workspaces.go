@@ -0,0 +1,160 @@
+package kongstate
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+)
+
+// PartitionByWorkspace moves entities translated from objects in namespaces assigned to Kong workspaces out of the
+// KongState and returns them grouped by workspace. namespacesWorkspaces maps namespaces to the workspaces they're
+// assigned to. Entities of namespaces not present in the map stay in the KongState (the default workspace), so do
+// entities that can't be attributed to a namespace (e.g. global plugins and licenses) and CA certificates which are
+// shared by all workspaces in Kong Gateway. Plugins follow the entities they're attached to. Vaults may be referred
+// to by entities in any workspace, hence they're copied to every partition.
+//
+// Certificates stay in the KongState as SNIs are matched across all workspaces and their names have to be unique
+// among them. Certificates used as client certificates by services of a partition are copied to it with IDs derived
+// from the workspace (entity IDs are unique across all workspaces) and without SNIs.
+func (ks *KongState) PartitionByWorkspace(namespacesWorkspaces map[string]string) map[string]*KongState {
+	if len(namespacesWorkspaces) == 0 {
+		return nil
+	}
+
+	partitions := make(map[string]*KongState)
+	partitionFor := func(namespace string) (*KongState, bool) {
+		workspace, ok := namespacesWorkspaces[namespace]
+		if !ok {
+			return nil, false
+		}
+		partition, ok := partitions[workspace]
+		if !ok {
+			partition = &KongState{
+				Vaults: ks.Vaults,
+			}
+			partitions[workspace] = partition
+		}
+		return partition, true
+	}
+
+	// Plugins refer to entities by their names, so these have to be tracked to move plugins to the right partition.
+	var (
+		servicesPartitions       = make(map[string]*KongState)
+		routesPartitions         = make(map[string]*KongState)
+		consumersPartitions      = make(map[string]*KongState)
+		consumerGroupsPartitions = make(map[string]*KongState)
+	)
+
+	ks.Services = lo.Filter(ks.Services, func(service Service, _ int) bool {
+		partition, ok := partitionFor(service.Namespace)
+		if !ok {
+			return true
+		}
+		partition.Services = append(partition.Services, service)
+		servicesPartitions[lo.FromPtr(service.Name)] = partition
+		for _, route := range service.Routes {
+			routesPartitions[lo.FromPtr(route.Name)] = partition
+		}
+		return false
+	})
+	ks.Upstreams = lo.Filter(ks.Upstreams, func(upstream Upstream, _ int) bool {
+		partition, ok := partitionFor(upstream.Service.Namespace)
+		if !ok {
+			return true
+		}
+		partition.Upstreams = append(partition.Upstreams, upstream)
+		return false
+	})
+	ks.Consumers = lo.Filter(ks.Consumers, func(consumer Consumer, _ int) bool {
+		partition, ok := partitionFor(consumer.K8sKongConsumer.Namespace)
+		if !ok {
+			return true
+		}
+		partition.Consumers = append(partition.Consumers, consumer)
+		consumersPartitions[lo.FromPtr(consumer.Username)] = partition
+		return false
+	})
+	ks.ConsumerGroups = lo.Filter(ks.ConsumerGroups, func(group ConsumerGroup, _ int) bool {
+		partition, ok := partitionFor(group.K8sKongConsumerGroup.Namespace)
+		if !ok {
+			return true
+		}
+		partition.ConsumerGroups = append(partition.ConsumerGroups, group)
+		consumerGroupsPartitions[lo.FromPtr(group.Name)] = partition
+		return false
+	})
+	ks.Plugins = lo.Filter(ks.Plugins, func(plugin Plugin, _ int) bool {
+		var partition *KongState
+		switch {
+		case plugin.Service != nil:
+			partition = servicesPartitions[lo.FromPtr(plugin.Service.ID)]
+		case plugin.Route != nil:
+			partition = routesPartitions[lo.FromPtr(plugin.Route.ID)]
+		case plugin.Consumer != nil:
+			partition = consumersPartitions[lo.FromPtr(plugin.Consumer.ID)]
+		case plugin.ConsumerGroup != nil:
+			partition = consumerGroupsPartitions[lo.FromPtr(plugin.ConsumerGroup.ID)]
+		}
+		if partition == nil {
+			return true
+		}
+		partition.Plugins = append(partition.Plugins, plugin)
+		return false
+	})
+	for entityType, collection := range ks.CustomEntities {
+		collection.Entities = lo.Filter(collection.Entities, func(entity CustomEntity, _ int) bool {
+			partition, ok := partitionFor(entity.K8sKongCustomEntity.Namespace)
+			if !ok {
+				return true
+			}
+			if partition.CustomEntities == nil {
+				partition.CustomEntities = make(map[string]*KongCustomEntityCollection)
+			}
+			partitionCollection, ok := partition.CustomEntities[entityType]
+			if !ok {
+				partitionCollection = &KongCustomEntityCollection{Schema: collection.Schema}
+				partition.CustomEntities[entityType] = partitionCollection
+			}
+			partitionCollection.Entities = append(partitionCollection.Entities, entity)
+			return false
+		})
+	}
+
+	for workspace, partition := range partitions {
+		partition.Certificates = ks.workspaceClientCertificates(workspace, partition.Services)
+	}
+
+	return partitions
+}
+
+// workspaceClientCertificates returns copies of certificates used as client certificates by the given services of
+// the workspace, and updates the services to refer to them.
+func (ks *KongState) workspaceClientCertificates(workspace string, services []Service) []Certificate {
+	var certificates []Certificate
+	workspaceIDs := make(map[string]string)
+	for i, service := range services {
+		if service.ClientCertificate == nil || service.ClientCertificate.ID == nil {
+			continue
+		}
+		id := *service.ClientCertificate.ID
+		workspaceID, ok := workspaceIDs[id]
+		if !ok {
+			certificate, found := lo.Find(ks.Certificates, func(c Certificate) bool {
+				return lo.FromPtr(c.ID) == id
+			})
+			if !found {
+				continue
+			}
+			workspaceID = uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("%s/%s", workspace, id))).String()
+			workspaceIDs[id] = workspaceID
+			certificate.Certificate = *certificate.Certificate.DeepCopy()
+			certificate.ID = kong.String(workspaceID)
+			certificate.SNIs = nil
+			certificates = append(certificates, certificate)
+		}
+		services[i].ClientCertificate = &kong.Certificate{ID: kong.String(workspaceID)}
+	}
+	return certificates
+}
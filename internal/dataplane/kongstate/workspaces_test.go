@@ -0,0 +1,112 @@
+package kongstate
+
+import (
+	"testing"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+)
+
+func TestKongState_PartitionByWorkspace(t *testing.T) {
+	newState := func() *KongState {
+		return &KongState{
+			Services: []Service{
+				{
+					Service:   kong.Service{Name: kong.String("default.svc")},
+					Namespace: "default",
+					Routes:    []Route{{Route: kong.Route{Name: kong.String("default.route")}}},
+				},
+				{
+					Service: kong.Service{
+						Name:              kong.String("tenant-a.svc"),
+						ClientCertificate: &kong.Certificate{ID: kong.String("client-cert")},
+					},
+					Namespace: "tenant-a",
+					Routes:    []Route{{Route: kong.Route{Name: kong.String("tenant-a.route")}}},
+				},
+			},
+			Upstreams: []Upstream{
+				{Upstream: kong.Upstream{Name: kong.String("default.upstream")}, Service: Service{Namespace: "default"}},
+				{Upstream: kong.Upstream{Name: kong.String("tenant-a.upstream")}, Service: Service{Namespace: "tenant-a"}},
+			},
+			Consumers: []Consumer{
+				{
+					Consumer:        kong.Consumer{Username: kong.String("tenant-a-consumer")},
+					K8sKongConsumer: kongv1.KongConsumer{ObjectMeta: metav1.ObjectMeta{Namespace: "tenant-a"}},
+				},
+			},
+			Plugins: []Plugin{
+				{Plugin: kong.Plugin{Name: kong.String("global")}},
+				{Plugin: kong.Plugin{Name: kong.String("default-route"), Route: &kong.Route{ID: kong.String("default.route")}}},
+				{Plugin: kong.Plugin{Name: kong.String("tenant-a-route"), Route: &kong.Route{ID: kong.String("tenant-a.route")}}},
+				{Plugin: kong.Plugin{Name: kong.String("tenant-a-consumer"), Consumer: &kong.Consumer{ID: kong.String("tenant-a-consumer")}}},
+			},
+			Certificates: []Certificate{
+				{Certificate: kong.Certificate{ID: kong.String("cert"), SNIs: kong.StringSlice("example.com")}},
+				{Certificate: kong.Certificate{ID: kong.String("client-cert"), Cert: kong.String("client-cert-pem")}},
+			},
+			CACertificates: []kong.CACertificate{{ID: kong.String("ca-cert")}},
+			Vaults: []Vault{
+				{Vault: kong.Vault{Prefix: kong.String("env")}, K8sKongVault: &kongv1alpha1.KongVault{}},
+			},
+			Licenses: []License{{License: kong.License{ID: kong.String("license")}}},
+		}
+	}
+
+	t.Run("no namespaces assigned to workspaces", func(t *testing.T) {
+		state := newState()
+		partitions := state.PartitionByWorkspace(nil)
+		assert.Empty(t, partitions)
+		assert.Equal(t, newState(), state)
+	})
+
+	t.Run("entities of namespaces assigned to workspaces are moved to their partitions", func(t *testing.T) {
+		state := newState()
+		partitions := state.PartitionByWorkspace(map[string]string{"tenant-a": "workspace-a"})
+		require.Len(t, partitions, 1)
+		partition, ok := partitions["workspace-a"]
+		require.True(t, ok)
+
+		serviceNames := func(s *KongState) []string {
+			var names []string
+			for _, svc := range s.Services {
+				names = append(names, *svc.Name)
+			}
+			return names
+		}
+		pluginNames := func(s *KongState) []string {
+			var names []string
+			for _, p := range s.Plugins {
+				names = append(names, *p.Name)
+			}
+			return names
+		}
+
+		assert.Equal(t, []string{"default.svc"}, serviceNames(state))
+		assert.Len(t, state.Upstreams, 1)
+		assert.Empty(t, state.Consumers)
+		assert.Equal(t, []string{"global", "default-route"}, pluginNames(state))
+		assert.Len(t, state.Licenses, 1)
+		assert.Len(t, state.CACertificates, 1)
+
+		assert.Equal(t, []string{"tenant-a.svc"}, serviceNames(partition))
+		require.Len(t, partition.Upstreams, 1)
+		assert.Equal(t, "tenant-a.upstream", *partition.Upstreams[0].Name)
+		require.Len(t, partition.Consumers, 1)
+		assert.Equal(t, []string{"tenant-a-route", "tenant-a-consumer"}, pluginNames(partition))
+		assert.Empty(t, partition.Licenses, "licenses are global and should be kept in the default workspace only")
+		assert.Empty(t, partition.CACertificates, "CA certificates are global and should be kept in the default workspace only")
+		assert.Equal(t, newState().Certificates, state.Certificates, "certificates should be kept in the default workspace")
+		require.Len(t, partition.Certificates, 1, "only client certificates of the workspace's services should be copied")
+		clientCert := partition.Certificates[0]
+		assert.NotEqual(t, "client-cert", *clientCert.ID, "copied certificate should get an ID unique across workspaces")
+		assert.Equal(t, "client-cert-pem", *clientCert.Cert)
+		assert.Equal(t, clientCert.ID, partition.Services[0].ClientCertificate.ID)
+		assert.Equal(t, state.Vaults, partition.Vaults, "vaults should be copied to every workspace")
+	})
+}
@@ -41,4 +41,15 @@ type Config struct {
 	// UseLastValidConfigForFallback indicates whether to use the last valid config cache to backfill broken objects
 	// when recovering from a config push failure.
 	UseLastValidConfigForFallback bool
+
+	// CanaryRollout configures a canary rollout of configuration to gateways. It's only relevant in DB-less mode.
+	CanaryRollout CanaryRolloutConfig
+
+	// AdaptivePush configures adaptive scheduling of configuration pushes to gateways. It's only relevant in DB-less mode.
+	AdaptivePush AdaptivePushConfig
+
+	// GatewaySharding indicates whether gateways should be configured only with routes and certificates of Gateways
+	// their pods back. Every gateway tracks the hash of its own part of the configuration. It's only relevant in
+	// DB-less mode.
+	GatewaySharding bool
 }
@@ -0,0 +1,362 @@
+package sendconfig
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/kong/go-kong/kong"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/adminapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/metrics"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+)
+
+// MaxPushDeferral is the maximum time a push to a gateway can be deferred due to the gateway's load. After that,
+// configuration is pushed regardless of the load to not starve the gateway of configuration updates.
+const MaxPushDeferral = 5 * time.Minute
+
+// AdaptivePushConfig configures the adaptive scheduling of configuration pushes to gateways. When enabled,
+// pushes to a gateway are deferred (and coalesced with the following updates) when they're too frequent or
+// the gateway reports being under load. It's only relevant in DB-less mode.
+type AdaptivePushConfig struct {
+	// MinInterval is the minimum interval between two consecutive pushes to a single gateway.
+	MinInterval time.Duration
+
+	// MaxWorkersMemoryMiB is the total memory allocated by a gateway's Lua VMs (as reported by its /status endpoint)
+	// above which pushes to the gateway are deferred.
+	MaxWorkersMemoryMiB int
+
+	// SlowReloadThreshold is the duration of a configuration push above which the gateway's reload is considered
+	// slow. After a slow reload, the following push to the gateway is deferred for as long as the reload took.
+	SlowReloadThreshold time.Duration
+}
+
+// Enabled returns true if any of the adaptive push scheduling rules is configured.
+func (c AdaptivePushConfig) Enabled() bool {
+	return c.MinInterval > 0 || c.MaxWorkersMemoryMiB > 0 || c.SlowReloadThreshold > 0
+}
+
+// PushDeferredError is returned when a configuration push to a gateway was deferred by the PushScheduler.
+type PushDeferredError struct {
+	// Decision is the reason the push was deferred for.
+	Decision metrics.PushDecision
+
+	// Coalesced indicates that a previously deferred configuration update was superseded by the current one.
+	Coalesced bool
+}
+
+func (e PushDeferredError) Error() string {
+	return fmt.Sprintf("configuration push deferred by the push scheduler: %s", e.Decision)
+}
+
+// GatewayPushStatus describes the state of the PushScheduler for a single gateway.
+type GatewayPushStatus struct {
+	// URL is the gateway's Admin API URL.
+	URL string
+
+	// LastDecision is the last decision made for the gateway.
+	LastDecision metrics.PushDecision
+
+	// LastDecisionTime is the time the last decision was made at.
+	LastDecisionTime time.Time
+
+	// LastPushTime is the time the last push to the gateway was started at.
+	LastPushTime time.Time
+
+	// LastPushDuration is how long the last push to the gateway took.
+	LastPushDuration time.Duration
+
+	// CoalescedUpdates is the number of configuration updates superseded by newer ones while the push
+	// to the gateway was deferred.
+	CoalescedUpdates int
+}
+
+// PushSchedulerStatusProvider is an optional interface of UpdateStrategyResolver implementations that schedule
+// configuration pushes adaptively.
+type PushSchedulerStatusProvider interface {
+	// PushSchedulerStatuses returns the current state of the push scheduler for every gateway it made decisions for.
+	PushSchedulerStatuses() []GatewayPushStatus
+}
+
+type gatewayPushState struct {
+	lastDecision     metrics.PushDecision
+	lastDecisionTime time.Time
+	lastPushTime     time.Time
+	lastPushDuration time.Duration
+
+	// pendingHash is the hash of the configuration whose push is currently deferred.
+	pendingHash []byte
+	// pendingSince is the time the currently deferred push was first deferred at.
+	pendingSince time.Time
+	coalesced    int
+}
+
+// PushScheduler decides whether configuration should be pushed to a gateway based on the pushes history
+// and the gateway's load. It keeps track of every gateway separately (by its Admin API URL).
+type PushScheduler struct {
+	config AdaptivePushConfig
+	clock  adminapi.Clock
+	logger logr.Logger
+
+	gateways map[string]*gatewayPushState
+	lock     sync.Mutex
+}
+
+func NewPushScheduler(config AdaptivePushConfig, clock adminapi.Clock, logger logr.Logger) *PushScheduler {
+	return &PushScheduler{
+		config:   config,
+		clock:    clock,
+		logger:   logger,
+		gateways: make(map[string]*gatewayPushState),
+	}
+}
+
+// Decide returns the decision on whether the configuration with the given hash should be pushed to the gateway
+// now. In case the push is deferred, it also returns whether a previously deferred configuration update was
+// superseded by the current one.
+func (s *PushScheduler) Decide(ctx context.Context, client *kong.Client, hash []byte) (metrics.PushDecision, bool) {
+	url := client.BaseRootURL()
+	decision := s.decideByHistory(url)
+	if decision == metrics.PushDecisionPush && s.config.MaxWorkersMemoryMiB > 0 {
+		// Errors are not considered a reason to defer the push, as the gateway might be unable to report its status
+		// exactly because it's missing configuration.
+		memoryMiB, err := fetchWorkersMemoryMiB(ctx, client)
+		if err != nil {
+			s.logger.V(util.DebugLevel).Info("Failed to fetch gateway memory usage, not deferring the push", "url", url, "error", err.Error())
+		} else if memoryMiB > float64(s.config.MaxWorkersMemoryMiB) {
+			decision = metrics.PushDecisionDeferredHighMemory
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	state := s.gatewayState(url)
+	now := s.clock.Now()
+	if decision != metrics.PushDecisionPush && !state.pendingSince.IsZero() && now.Sub(state.pendingSince) >= MaxPushDeferral {
+		s.logger.Info("Push to the gateway was deferred for too long, pushing regardless of its load", "url", url, "deferred_reason", decision)
+		decision = metrics.PushDecisionPush
+	}
+	state.lastDecision = decision
+	state.lastDecisionTime = now
+	if decision == metrics.PushDecisionPush {
+		return decision, false
+	}
+
+	coalesced := state.pendingHash != nil && string(state.pendingHash) != string(hash)
+	if coalesced {
+		state.coalesced++
+	}
+	if state.pendingSince.IsZero() {
+		state.pendingSince = now
+	}
+	state.pendingHash = hash
+	return decision, coalesced
+}
+
+// decideByHistory decides on the push based on the previous pushes to the gateway.
+func (s *PushScheduler) decideByHistory(url string) metrics.PushDecision {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	state := s.gatewayState(url)
+	if state.lastPushTime.IsZero() {
+		return metrics.PushDecisionPush
+	}
+
+	sinceLastPush := s.clock.Now().Sub(state.lastPushTime)
+	if s.config.MinInterval > 0 && sinceLastPush < s.config.MinInterval {
+		return metrics.PushDecisionDeferredMinInterval
+	}
+	// Give the gateway as much time to settle after a slow reload as the reload took.
+	if s.config.SlowReloadThreshold > 0 && state.lastPushDuration > s.config.SlowReloadThreshold &&
+		sinceLastPush < 2*state.lastPushDuration {
+		return metrics.PushDecisionDeferredSlowReload
+	}
+	return metrics.PushDecisionPush
+}
+
+// RegisterPush registers a push to the gateway that was started at the given time and took the given duration.
+func (s *PushScheduler) RegisterPush(url string, start time.Time, duration time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	state := s.gatewayState(url)
+	state.lastPushTime = start
+	state.lastPushDuration = duration
+	state.pendingHash = nil
+	state.pendingSince = time.Time{}
+	state.coalesced = 0
+}
+
+// PushSchedulerStatuses returns the current state of the scheduler for every gateway, sorted by URL.
+// It returns nil if adaptive push scheduling is not enabled.
+func (r DefaultUpdateStrategyResolver) PushSchedulerStatuses() []GatewayPushStatus {
+	if r.pushScheduler == nil {
+		return nil
+	}
+	return r.pushScheduler.PushSchedulerStatuses()
+}
+
+// PushSchedulerStatuses returns the current state of the scheduler for every gateway, sorted by URL.
+func (s *PushScheduler) PushSchedulerStatuses() []GatewayPushStatus {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	statuses := make([]GatewayPushStatus, 0, len(s.gateways))
+	for url, state := range s.gateways {
+		statuses = append(statuses, GatewayPushStatus{
+			URL:              url,
+			LastDecision:     state.lastDecision,
+			LastDecisionTime: state.lastDecisionTime,
+			LastPushTime:     state.lastPushTime,
+			LastPushDuration: state.lastPushDuration,
+			CoalescedUpdates: state.coalesced,
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].URL < statuses[j].URL })
+	return statuses
+}
+
+func (s *PushScheduler) gatewayState(url string) *gatewayPushState {
+	state, ok := s.gateways[url]
+	if !ok {
+		state = &gatewayPushState{}
+		s.gateways[url] = state
+	}
+	return state
+}
+
+// UpdateStrategyWithPushScheduler decorates any UpdateStrategy to push configuration only when allowed
+// by a PushScheduler.
+type UpdateStrategyWithPushScheduler struct {
+	decorated UpdateStrategy
+	scheduler *PushScheduler
+	client    *kong.Client
+	logger    logr.Logger
+
+	// force makes the configuration pushed regardless of the scheduler's decision. The push is still registered.
+	force bool
+}
+
+func NewUpdateStrategyWithPushScheduler(
+	decorated UpdateStrategy,
+	scheduler *PushScheduler,
+	client *kong.Client,
+	logger logr.Logger,
+) UpdateStrategyWithPushScheduler {
+	return UpdateStrategyWithPushScheduler{
+		decorated: decorated,
+		scheduler: scheduler,
+		client:    client,
+		logger:    logger,
+	}
+}
+
+// Forced returns a copy of the strategy that pushes configuration regardless of the scheduler's decision.
+func (s UpdateStrategyWithPushScheduler) Forced() UpdateStrategyWithPushScheduler {
+	s.force = true
+	return s
+}
+
+// IsForced returns true if the strategy pushes configuration regardless of the scheduler's decision.
+func (s UpdateStrategyWithPushScheduler) IsForced() bool {
+	return s.force
+}
+
+// Update calls the decorated UpdateStrategy.Update only when the PushScheduler allows it. In case it doesn't,
+// it returns a PushDeferredError. Every push (successful or not) is registered in the scheduler.
+func (s UpdateStrategyWithPushScheduler) Update(ctx context.Context, targetContent ContentWithHash) error {
+	if !s.force {
+		if decision, coalesced := s.scheduler.Decide(ctx, s.client, targetContent.Hash); decision != metrics.PushDecisionPush {
+			return PushDeferredError{Decision: decision, Coalesced: coalesced}
+		}
+	}
+
+	start := s.scheduler.clock.Now()
+	err := s.decorated.Update(ctx, targetContent)
+	duration := s.scheduler.clock.Now().Sub(start)
+	s.scheduler.RegisterPush(s.client.BaseRootURL(), start, duration)
+	if threshold := s.scheduler.config.SlowReloadThreshold; threshold > 0 && duration > threshold {
+		s.logger.Info("Gateway configuration reload was slow, the next push will be deferred", "duration", duration.String())
+	}
+	return err
+}
+
+func (s UpdateStrategyWithPushScheduler) MetricsProtocol() metrics.Protocol {
+	return s.decorated.MetricsProtocol()
+}
+
+func (s UpdateStrategyWithPushScheduler) Type() string {
+	return fmt.Sprintf("WithPushScheduler(%s)", s.decorated.Type())
+}
+
+// fetchWorkersMemoryMiB returns the total memory allocated by the gateway's Lua VMs in MiB, as reported
+// by its /status endpoint.
+func fetchWorkersMemoryMiB(ctx context.Context, client *kong.Client) (float64, error) {
+	req, err := client.NewRequest(http.MethodGet, "/status", struct {
+		Unit string `url:"unit"`
+	}{Unit: "m"}, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create status request: %w", err)
+	}
+	var status struct {
+		Memory struct {
+			WorkersLuaVMs []struct {
+				HTTPAllocatedGC json.RawMessage `json:"http_allocated_gc"`
+			} `json:"workers_lua_vms"`
+		} `json:"memory"`
+	}
+	if _, err := client.Do(ctx, req, &status); err != nil {
+		return 0, fmt.Errorf("failed to get status: %w", err)
+	}
+	if len(status.Memory.WorkersLuaVMs) == 0 {
+		return 0, errors.New("status doesn't report workers memory")
+	}
+
+	var total float64
+	for _, vm := range status.Memory.WorkersLuaVMs {
+		mib, err := parseMemoryMiB(vm.HTTPAllocatedGC)
+		if err != nil {
+			return 0, err
+		}
+		total += mib
+	}
+	return total, nil
+}
+
+// parseMemoryMiB parses a memory amount reported by Kong (either a number of bytes or a string like "12.34 MiB")
+// and returns it in MiB.
+func parseMemoryMiB(raw json.RawMessage) (float64, error) {
+	var bytes float64
+	if err := json.Unmarshal(raw, &bytes); err == nil {
+		return bytes / (1 << 20), nil
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return 0, fmt.Errorf("invalid memory amount %s: %w", raw, err)
+	}
+	value, unit, _ := strings.Cut(strings.TrimSpace(s), " ")
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid memory amount %q: %w", s, err)
+	}
+	switch unit {
+	case "", "B":
+		return amount / (1 << 20), nil
+	case "KiB":
+		return amount / (1 << 10), nil
+	case "MiB":
+		return amount, nil
+	case "GiB":
+		return amount * (1 << 10), nil
+	default:
+		return 0, fmt.Errorf("invalid memory unit in %q", s)
+	}
+}
@@ -0,0 +1,166 @@
+package sendconfig_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/kong/go-kong/kong"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/sendconfig"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/metrics"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newStatusServer(t *testing.T, workersMemory ...string) *kong.Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/status" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		vms := ""
+		for i, m := range workersMemory {
+			if i > 0 {
+				vms += ","
+			}
+			vms += `{"http_allocated_gc":` + m + `,"pid":1}`
+		}
+		_, _ = w.Write([]byte(`{"memory":{"workers_lua_vms":[` + vms + `]}}`))
+	}))
+	t.Cleanup(server.Close)
+	client, err := kong.NewClient(kong.String(server.URL), server.Client())
+	require.NoError(t, err)
+	return client
+}
+
+func TestPushScheduler_Decide(t *testing.T) {
+	hash := []byte("hash")
+
+	t.Run("min interval", func(t *testing.T) {
+		clock := &fakeClock{now: time.Now()}
+		client := newStatusServer(t)
+		scheduler := sendconfig.NewPushScheduler(sendconfig.AdaptivePushConfig{MinInterval: 10 * time.Second}, clock, logr.Discard())
+
+		decision, _ := scheduler.Decide(context.Background(), client, hash)
+		require.Equal(t, metrics.PushDecisionPush, decision, "first push should never be deferred")
+		scheduler.RegisterPush(client.BaseRootURL(), clock.now, time.Second)
+
+		clock.now = clock.now.Add(5 * time.Second)
+		decision, coalesced := scheduler.Decide(context.Background(), client, hash)
+		require.Equal(t, metrics.PushDecisionDeferredMinInterval, decision)
+		require.False(t, coalesced)
+
+		decision, coalesced = scheduler.Decide(context.Background(), client, []byte("newer-hash"))
+		require.Equal(t, metrics.PushDecisionDeferredMinInterval, decision)
+		require.True(t, coalesced, "newer configuration should supersede the deferred one")
+
+		clock.now = clock.now.Add(5 * time.Second)
+		decision, _ = scheduler.Decide(context.Background(), client, hash)
+		require.Equal(t, metrics.PushDecisionPush, decision)
+	})
+
+	t.Run("slow reload", func(t *testing.T) {
+		clock := &fakeClock{now: time.Now()}
+		client := newStatusServer(t)
+		scheduler := sendconfig.NewPushScheduler(sendconfig.AdaptivePushConfig{SlowReloadThreshold: 5 * time.Second}, clock, logr.Discard())
+
+		scheduler.RegisterPush(client.BaseRootURL(), clock.now, 10*time.Second)
+		clock.now = clock.now.Add(15 * time.Second)
+		decision, _ := scheduler.Decide(context.Background(), client, hash)
+		require.Equal(t, metrics.PushDecisionDeferredSlowReload, decision)
+
+		clock.now = clock.now.Add(5 * time.Second)
+		decision, _ = scheduler.Decide(context.Background(), client, hash)
+		require.Equal(t, metrics.PushDecisionPush, decision)
+	})
+
+	t.Run("high memory", func(t *testing.T) {
+		testCases := []struct {
+			name             string
+			workersMemory    []string
+			expectedDecision metrics.PushDecision
+		}{
+			{
+				name:             "memory reported as MiB strings below the limit",
+				workersMemory:    []string{`"100.50 MiB"`, `"200.00 MiB"`},
+				expectedDecision: metrics.PushDecisionPush,
+			},
+			{
+				name:             "memory reported as MiB strings above the limit",
+				workersMemory:    []string{`"300.00 MiB"`, `"300.00 MiB"`},
+				expectedDecision: metrics.PushDecisionDeferredHighMemory,
+			},
+			{
+				name:             "memory reported as number of bytes above the limit",
+				workersMemory:    []string{`1073741824`},
+				expectedDecision: metrics.PushDecisionDeferredHighMemory,
+			},
+			{
+				name:             "unparsable memory doesn't defer the push",
+				workersMemory:    []string{`"a lot"`},
+				expectedDecision: metrics.PushDecisionPush,
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				client := newStatusServer(t, tc.workersMemory...)
+				scheduler := sendconfig.NewPushScheduler(sendconfig.AdaptivePushConfig{MaxWorkersMemoryMiB: 512}, &fakeClock{now: time.Now()}, logr.Discard())
+				decision, _ := scheduler.Decide(context.Background(), client, hash)
+				require.Equal(t, tc.expectedDecision, decision)
+			})
+		}
+	})
+
+	t.Run("push is forced after max deferral", func(t *testing.T) {
+		clock := &fakeClock{now: time.Now()}
+		client := newStatusServer(t, `"1024.00 MiB"`)
+		scheduler := sendconfig.NewPushScheduler(sendconfig.AdaptivePushConfig{MaxWorkersMemoryMiB: 512}, clock, logr.Discard())
+
+		decision, _ := scheduler.Decide(context.Background(), client, hash)
+		require.Equal(t, metrics.PushDecisionDeferredHighMemory, decision)
+
+		clock.now = clock.now.Add(sendconfig.MaxPushDeferral)
+		decision, _ = scheduler.Decide(context.Background(), client, hash)
+		require.Equal(t, metrics.PushDecisionPush, decision)
+	})
+}
+
+func TestUpdateStrategyWithPushScheduler(t *testing.T) {
+	clock := &fakeClock{now: time.Now()}
+	client := newStatusServer(t)
+	scheduler := sendconfig.NewPushScheduler(sendconfig.AdaptivePushConfig{MinInterval: time.Minute}, clock, logr.Discard())
+	content := sendconfig.ContentWithHash{Hash: []byte("hash")}
+
+	updateStrategy := newMockUpdateStrategy(true)
+	strategy := sendconfig.NewUpdateStrategyWithPushScheduler(updateStrategy, scheduler, client, logr.Discard())
+	require.NoError(t, strategy.Update(context.Background(), content))
+	require.True(t, updateStrategy.wasUpdateCalled)
+
+	updateStrategy = newMockUpdateStrategy(true)
+	strategy = sendconfig.NewUpdateStrategyWithPushScheduler(updateStrategy, scheduler, client, logr.Discard())
+	err := strategy.Update(context.Background(), content)
+	require.ErrorAs(t, err, &sendconfig.PushDeferredError{})
+	require.False(t, updateStrategy.wasUpdateCalled)
+
+	require.NoError(t, strategy.Forced().Update(context.Background(), content))
+	require.True(t, updateStrategy.wasUpdateCalled, "forced update should not be deferred")
+
+	statuses := scheduler.PushSchedulerStatuses()
+	require.Len(t, statuses, 1)
+	assert.Equal(t, client.BaseRootURL(), statuses[0].URL)
+	assert.Equal(t, metrics.PushDecisionDeferredMinInterval, statuses[0].LastDecision)
+	assert.Equal(t, clock.now, statuses[0].LastPushTime)
+}
@@ -0,0 +1,148 @@
+package sendconfig
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+)
+
+const (
+	// DefaultCanaryReadinessTimeout is the default time canary gateways are given to report readiness
+	// after they were configured.
+	DefaultCanaryReadinessTimeout = time.Minute
+
+	// DefaultCanaryReadinessCheckInterval is the default interval between canary gateways' readiness checks.
+	DefaultCanaryReadinessCheckInterval = time.Second
+)
+
+// CanaryRolloutConfig configures a canary rollout of configuration to gateways. When enabled, configuration
+// is first pushed to a subset of gateways (canaries) and only after they report readiness and the soak period
+// elapses, it's pushed to the remaining gateways.
+type CanaryRolloutConfig struct {
+	// Count is the number of gateways configuration is pushed to first. Mutually exclusive with Percentage.
+	Count int
+
+	// Percentage is the percentage of gateways (rounded up) configuration is pushed to first. Mutually exclusive
+	// with Count.
+	Percentage int
+
+	// SoakPeriod is the time to wait after canary gateways became ready before configuring the remaining gateways.
+	SoakPeriod time.Duration
+}
+
+// Enabled returns true if canary rollout is configured.
+func (c CanaryRolloutConfig) Enabled() bool {
+	return c.Count > 0 || c.Percentage > 0
+}
+
+// ReadinessClient is a client of a gateway that can report its readiness.
+type ReadinessClient interface {
+	// IsReady returns nil if the gateway is ready to serve requests.
+	IsReady(ctx context.Context) error
+	BaseRootURL() string
+}
+
+// RolloutStrategy determines how configuration is rolled out to multiple gateways.
+type RolloutStrategy interface {
+	// CanariesCount returns the number of gateways out of total that should be configured first.
+	CanariesCount(total int) int
+
+	// WaitForCanaries blocks until all canary gateways are ready and the soak period elapses. It returns an error
+	// if any of the canaries didn't become ready or stopped being ready during the soak period.
+	WaitForCanaries(ctx context.Context, canaries []ReadinessClient) error
+}
+
+// RolloutStrategyResolver is an optional interface of UpdateStrategyResolver implementations that can roll out
+// configuration to gateways progressively.
+type RolloutStrategyResolver interface {
+	// ResolveRolloutStrategy returns the RolloutStrategy to use or nil if configuration should be pushed to all
+	// gateways at once.
+	ResolveRolloutStrategy() RolloutStrategy
+}
+
+// ResolveRolloutStrategy returns a CanaryRolloutStrategy if canary rollout is enabled. As in DB mode all gateways
+// share the same database and only one of them gets configured, it returns nil for DB mode.
+func (r DefaultUpdateStrategyResolver) ResolveRolloutStrategy() RolloutStrategy {
+	if !r.config.InMemory || !r.config.CanaryRollout.Enabled() {
+		return nil
+	}
+	return NewCanaryRolloutStrategy(r.config.CanaryRollout, r.logger)
+}
+
+// CanaryRolloutStrategy is a RolloutStrategy configuring a subset of gateways first and waiting for them to report
+// readiness via their status endpoint for the configured soak period.
+type CanaryRolloutStrategy struct {
+	config                 CanaryRolloutConfig
+	readinessTimeout       time.Duration
+	readinessCheckInterval time.Duration
+	logger                 logr.Logger
+}
+
+func NewCanaryRolloutStrategy(config CanaryRolloutConfig, logger logr.Logger) CanaryRolloutStrategy {
+	return CanaryRolloutStrategy{
+		config:                 config,
+		readinessTimeout:       DefaultCanaryReadinessTimeout,
+		readinessCheckInterval: DefaultCanaryReadinessCheckInterval,
+		logger:                 logger,
+	}
+}
+
+// CanariesCount returns the number of canary gateways. At least one gateway is a canary when there are any gateways.
+func (s CanaryRolloutStrategy) CanariesCount(total int) int {
+	if total == 0 {
+		return 0
+	}
+	count := s.config.Count
+	if count == 0 {
+		// Round up so that a non-zero percentage always results in at least one canary.
+		count = (total*s.config.Percentage + 99) / 100
+	}
+	return max(1, min(count, total))
+}
+
+// WaitForCanaries waits for the canaries to become ready, then waits for the soak period and verifies
+// that the canaries are still ready.
+func (s CanaryRolloutStrategy) WaitForCanaries(ctx context.Context, canaries []ReadinessClient) error {
+	if err := s.waitForReadiness(ctx, canaries); err != nil {
+		return err
+	}
+
+	if s.config.SoakPeriod > 0 {
+		s.logger.V(util.DebugLevel).Info("Canary gateways are ready, soaking", "soak_period", s.config.SoakPeriod)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("soaking canary gateways interrupted: %w", ctx.Err())
+		case <-time.After(s.config.SoakPeriod):
+		}
+		return s.waitForReadiness(ctx, canaries)
+	}
+	return nil
+}
+
+func (s CanaryRolloutStrategy) waitForReadiness(ctx context.Context, canaries []ReadinessClient) error {
+	ctx, cancel := context.WithTimeout(ctx, s.readinessTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(s.readinessCheckInterval)
+	defer ticker.Stop()
+
+	for _, canary := range canaries {
+		for {
+			err := canary.IsReady(ctx)
+			if err == nil {
+				break
+			}
+			s.logger.V(util.DebugLevel).Info("Canary gateway is not ready yet", "url", canary.BaseRootURL(), "reason", err.Error())
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("canary gateway %s did not become ready: %w", canary.BaseRootURL(), err)
+			case <-ticker.C:
+			}
+		}
+	}
+	return nil
+}
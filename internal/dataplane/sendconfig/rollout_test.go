@@ -0,0 +1,95 @@
+package sendconfig
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+)
+
+type readinessClientMock struct {
+	url string
+	// notReadyChecks is the number of readiness checks the client fails before it becomes ready.
+	// A negative value means the client never becomes ready.
+	notReadyChecks int32
+	checks         atomic.Int32
+}
+
+func (c *readinessClientMock) IsReady(context.Context) error {
+	checks := c.checks.Add(1)
+	if c.notReadyChecks < 0 || checks <= c.notReadyChecks {
+		return errors.New("not ready")
+	}
+	return nil
+}
+
+func (c *readinessClientMock) BaseRootURL() string {
+	return c.url
+}
+
+func TestCanaryRolloutStrategy_CanariesCount(t *testing.T) {
+	testCases := []struct {
+		name     string
+		config   CanaryRolloutConfig
+		total    int
+		expected int
+	}{
+		{name: "count", config: CanaryRolloutConfig{Count: 2}, total: 5, expected: 2},
+		{name: "count exceeding total", config: CanaryRolloutConfig{Count: 10}, total: 3, expected: 3},
+		{name: "percentage is rounded up", config: CanaryRolloutConfig{Percentage: 10}, total: 15, expected: 2},
+		{name: "percentage always results in at least one canary", config: CanaryRolloutConfig{Percentage: 1}, total: 3, expected: 1},
+		{name: "no gateways", config: CanaryRolloutConfig{Count: 1}, total: 0, expected: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewCanaryRolloutStrategy(tc.config, logr.Discard())
+			require.Equal(t, tc.expected, s.CanariesCount(tc.total))
+		})
+	}
+}
+
+func TestCanaryRolloutStrategy_WaitForCanaries(t *testing.T) {
+	newStrategy := func(soakPeriod time.Duration) CanaryRolloutStrategy {
+		s := NewCanaryRolloutStrategy(CanaryRolloutConfig{Count: 1, SoakPeriod: soakPeriod}, logr.Discard())
+		s.readinessTimeout = 100 * time.Millisecond
+		s.readinessCheckInterval = time.Millisecond
+		return s
+	}
+
+	t.Run("canaries becoming ready", func(t *testing.T) {
+		canary := &readinessClientMock{url: "https://canary:8444", notReadyChecks: 2}
+		err := newStrategy(10*time.Millisecond).WaitForCanaries(context.Background(), []ReadinessClient{canary})
+		require.NoError(t, err)
+		require.EqualValues(t, 4, canary.checks.Load(), "readiness should be checked until ready and once again after soaking")
+	})
+
+	t.Run("canary never becoming ready", func(t *testing.T) {
+		ready := &readinessClientMock{url: "https://ready:8444"}
+		notReady := &readinessClientMock{url: "https://not-ready:8444", notReadyChecks: -1}
+		err := newStrategy(0).WaitForCanaries(context.Background(), []ReadinessClient{ready, notReady})
+		require.ErrorContains(t, err, "canary gateway https://not-ready:8444 did not become ready")
+	})
+
+	t.Run("soaking interrupted", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		canary := &readinessClientMock{url: "https://canary:8444"}
+		err := newStrategy(time.Hour).WaitForCanaries(ctx, []ReadinessClient{canary})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestDefaultUpdateStrategyResolver_ResolveRolloutStrategy(t *testing.T) {
+	canaryRollout := CanaryRolloutConfig{Percentage: 10}
+
+	require.Nil(t, NewDefaultUpdateStrategyResolver(Config{InMemory: true}, logr.Discard()).ResolveRolloutStrategy(),
+		"no rollout strategy is expected when canary rollout is not configured")
+	require.Nil(t, NewDefaultUpdateStrategyResolver(Config{CanaryRollout: canaryRollout}, logr.Discard()).ResolveRolloutStrategy(),
+		"no rollout strategy is expected in DB mode")
+	require.IsType(t, CanaryRolloutStrategy{},
+		NewDefaultUpdateStrategyResolver(Config{InMemory: true, CanaryRollout: canaryRollout}, logr.Discard()).ResolveRolloutStrategy())
+}
@@ -70,6 +70,11 @@ func PerformUpdate(
 	}
 
 	updateStrategy := updateStrategyResolver.ResolveUpdateStrategy(client)
+	scheduledStrategy, isScheduled := updateStrategy.(UpdateStrategyWithPushScheduler)
+	if isScheduled && isFallback {
+		// Fallback configurations are meant to recover gateways as soon as possible, hence they're never deferred.
+		updateStrategy = scheduledStrategy.Forced()
+	}
 	logger = logger.WithValues("update_strategy", updateStrategy.Type())
 	timeStart := time.Now()
 	err = updateStrategy.Update(ctx, ContentWithHash{
@@ -79,6 +84,16 @@ func PerformUpdate(
 	})
 	duration := time.Since(timeStart)
 
+	var pushDeferredErr PushDeferredError
+	if errors.As(err, &pushDeferredErr) {
+		promMetrics.RecordPushSchedulerDecision(pushDeferredErr.Decision, client.BaseRootURL(), pushDeferredErr.Coalesced)
+		logger.V(util.DebugLevel).Info("Configuration push was deferred", "reason", pushDeferredErr.Decision)
+		return nil, pushDeferredErr
+	}
+	if isScheduled && !isFallback {
+		promMetrics.RecordPushSchedulerDecision(metrics.PushDecisionPush, client.BaseRootURL(), false)
+	}
+
 	metricsProtocol := updateStrategy.MetricsProtocol()
 	if err != nil {
 		// For UpdateError, record the failure and return the error.
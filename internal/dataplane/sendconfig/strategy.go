@@ -11,6 +11,7 @@ import (
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/adminapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/metrics"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/clock"
 )
 
 // CustomEntitiesByType stores all custom entities by types.
@@ -59,14 +60,22 @@ type ResourceError struct {
 }
 
 type DefaultUpdateStrategyResolver struct {
-	config Config
-	logger logr.Logger
+	config        Config
+	logger        logr.Logger
+	pushScheduler *PushScheduler
 }
 
 func NewDefaultUpdateStrategyResolver(config Config, logger logr.Logger) DefaultUpdateStrategyResolver {
+	var pushScheduler *PushScheduler
+	// As in DB mode all gateways share the same database, their load doesn't depend on the number of pushes to
+	// a single gateway, hence pushes are scheduled adaptively only in DB-less mode.
+	if config.InMemory && config.AdaptivePush.Enabled() {
+		pushScheduler = NewPushScheduler(config.AdaptivePush, clock.System{}, logger)
+	}
 	return DefaultUpdateStrategyResolver{
-		config: config,
-		logger: logger,
+		config:        config,
+		logger:        logger,
+		pushScheduler: pushScheduler,
 	}
 }
 
@@ -74,12 +83,17 @@ func NewDefaultUpdateStrategyResolver(config Config, logger logr.Logger) Default
 // The UpdateStrategy can be either UpdateStrategyDBMode or UpdateStrategyInMemory. Both
 // of them implement different ways to populate Kong instances with data-plane configuration.
 // If the client implements UpdateClientWithBackoff interface, its strategy will be decorated
-// with the backoff strategy it provides.
+// with the backoff strategy it provides. If adaptive push scheduling is enabled, strategies of Kong Gateway
+// clients will be decorated with the push scheduler.
 func (r DefaultUpdateStrategyResolver) ResolveUpdateStrategy(
 	client UpdateClient,
 ) UpdateStrategy {
 	updateStrategy := r.resolveUpdateStrategy(client)
 
+	if r.pushScheduler != nil && !client.IsKonnect() {
+		updateStrategy = NewUpdateStrategyWithPushScheduler(updateStrategy, r.pushScheduler, client.AdminAPIClient(), r.logger)
+	}
+
 	if clientWithBackoff, ok := client.(UpdateClientWithBackoff); ok {
 		return NewUpdateStrategyWithBackoff(updateStrategy, clientWithBackoff.BackoffStrategy(), r.logger)
 	}
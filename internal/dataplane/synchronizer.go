@@ -10,6 +10,7 @@ import (
 	"github.com/go-logr/logr"
 
 	dpconf "github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/config"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/sendconfig"
 )
 
 // -----------------------------------------------------------------------------
@@ -148,6 +149,15 @@ func (p *Synchronizer) IsReady() bool {
 	return true
 }
 
+// PushSchedulerStatuses returns the decisions of the adaptive configuration push scheduler for every gateway.
+// It returns nil if the dataplane client doesn't schedule pushes adaptively.
+func (p *Synchronizer) PushSchedulerStatuses() []sendconfig.GatewayPushStatus {
+	if provider, ok := p.dataplaneClient.(sendconfig.PushSchedulerStatusProvider); ok {
+		return provider.PushSchedulerStatuses()
+	}
+	return nil
+}
+
 // NeedLeaderElection implements the controller-runtime Runnable interface to
 // inform the controller manager whether leadership election is needed, which
 // is always true in our case.
@@ -14,6 +14,8 @@ import (
 	"go.uber.org/zap"
 
 	dpconf "github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/config"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/sendconfig"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/metrics"
 )
 
 const testSynchronizerTick = time.Millisecond * 10
@@ -135,6 +137,42 @@ func TestSynchronizer_IsReadyDoesntBlockWhenDataPlaneIsBlocked(t *testing.T) {
 	}
 }
 
+func TestSynchronizer_PushSchedulerStatuses(t *testing.T) {
+	logger := zapr.NewLogger(zap.NewNop())
+
+	t.Run("dataplane client not scheduling pushes", func(t *testing.T) {
+		sync, err := NewSynchronizer(logger, &fakeDataplaneClient{dbmode: dpconf.DBModeOff})
+		require.NoError(t, err)
+		require.Nil(t, sync.PushSchedulerStatuses())
+	})
+
+	t.Run("statuses of the KongClient's push scheduler", func(t *testing.T) {
+		statuses := []sendconfig.GatewayPushStatus{
+			{
+				URL:              "https://10.0.0.1:8444",
+				LastDecision:     metrics.PushDecisionDeferredMinInterval,
+				LastDecisionTime: time.Now(),
+				CoalescedUpdates: 2,
+			},
+		}
+		updateStrategyResolver := newMockUpdateStrategyResolver(t)
+		updateStrategyResolver.pushSchedulerStatuses = statuses
+		kongClient := setupTestKongClient(
+			t,
+			updateStrategyResolver,
+			mockGatewayClientsProvider{},
+			mockConfigurationChangeDetector{},
+			newMockKongConfigBuilder(),
+			nil,
+			&mockKongLastValidConfigFetcher{},
+		)
+
+		sync, err := NewSynchronizer(logger, kongClient)
+		require.NoError(t, err)
+		require.Equal(t, statuses, sync.PushSchedulerStatuses())
+	})
+}
+
 // fakeDataplaneClient fakes the dataplane.Client interface so that we can
 // unit test the dataplane.Synchronizer.
 type fakeDataplaneClient struct {
@@ -9,8 +9,10 @@ import (
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/featuregates"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 // backendRefsToKongStateBackends takes a list of BackendRefs and returns a list of ServiceBackends.
@@ -18,13 +20,15 @@ import (
 // not included in the returned list:
 // - If a BackendRef is not permitted by the provided ReferenceGrantTo set,
 // - If a BackendRef is not found,
-// - If a BackendRef Group & Kind pair is not supported (currently only Service is supported),
+// - If a BackendRef Group & Kind pair is not supported (Service, KongServiceFacade and KongUpstreamTarget are supported,
+// the latter two only when their respective feature flags are enabled),
 // - If a BackendRef is missing a port.
 // The provided client is used to retrieve the Backend referenced by the BackendRef
 // to check if it exists.
 func backendRefsToKongStateBackends(
 	logger logr.Logger,
 	storer store.Storer,
+	features FeatureFlags,
 	route client.Object,
 	backendRefs []gatewayapi.BackendRef,
 	allowed map[gatewayapi.Namespace][]gatewayapi.ReferenceGrantTo,
@@ -48,13 +52,7 @@ func backendRefsToKongStateBackends(
 			continue
 		}
 
-		var err error
-		switch *backendRef.Kind {
-		case "Service":
-			_, err = storer.GetService(nn.Namespace, nn.Name)
-		default:
-			err = fmt.Errorf("unsupported kind %q, only 'Service' is supported", *backendRef.Kind)
-		}
+		backend, err := newServiceBackendForBackendRef(storer, features, nn, backendRef)
 		if err != nil {
 			if errors.As(err, &store.NotFoundError{}) {
 				logger.Error(err, "Object requested backendRef to target, but it does not exist, skipping...")
@@ -74,28 +72,79 @@ func backendRefsToKongStateBackends(
 			continue
 		}
 
+		if backendRef.Weight != nil {
+			backend.SetWeight(*backendRef.Weight)
+		}
+		backends = append(backends, backend)
+	}
+
+	return backends
+}
+
+// newServiceBackendForBackendRef verifies that the object referred by the backendRef exists and creates
+// a ServiceBackend of a type matching the backendRef's Group and Kind.
+func newServiceBackendForBackendRef(
+	storer store.Storer,
+	features FeatureFlags,
+	nn client.ObjectKey,
+	backendRef gatewayapi.BackendRef,
+) (kongstate.ServiceBackend, error) {
+	switch {
+	case isBackendRefOfGroupKind(backendRef, "", "Service"):
+		if _, err := storer.GetService(nn.Namespace, nn.Name); err != nil {
+			return kongstate.ServiceBackend{}, err
+		}
 		port := int32(-1)
 		if backendRef.Port != nil {
 			port = int32(*backendRef.Port)
 		}
-		backend, err := kongstate.NewServiceBackendForService(
-			nn,
-			kongstate.PortDef{
-				Mode:   kongstate.PortModeByNumber,
-				Number: port,
-			},
-		)
+		return kongstate.NewServiceBackendForService(nn, kongstate.PortDef{
+			Mode:   kongstate.PortModeByNumber,
+			Number: port,
+		})
+
+	case isBackendRefOfGroupKind(backendRef, incubatorv1alpha1.GroupVersion.Group, incubatorv1alpha1.KongServiceFacadeKind):
+		if !features.KongServiceFacade {
+			return kongstate.ServiceBackend{}, fmt.Errorf("KongServiceFacade is not enabled, please set the %q feature gate to 'true' to enable it",
+				featuregates.KongServiceFacade)
+		}
+		facade, err := storer.GetKongServiceFacade(nn.Namespace, nn.Name)
 		if err != nil {
-			logger.Error(err, "failed to create ServiceBackend for backendRef")
-			continue
+			return kongstate.ServiceBackend{}, err
 		}
-		if backendRef.Weight != nil {
-			backend.SetWeight(*backendRef.Weight)
+		return kongstate.NewServiceBackendForServiceFacade(nn, kongstate.PortDef{
+			Mode:   kongstate.PortModeByNumber,
+			Number: facade.Spec.Backend.Port,
+		})
+
+	case isBackendRefOfGroupKind(backendRef, incubatorv1alpha1.GroupVersion.Group, incubatorv1alpha1.KongUpstreamTargetKind):
+		if !features.KongUpstreamTarget {
+			return kongstate.ServiceBackend{}, fmt.Errorf("KongUpstreamTarget is not enabled, please set the %q feature gate to 'true' to enable it",
+				featuregates.KongUpstreamTarget)
 		}
-		backends = append(backends, backend)
+		if _, err := storer.GetKongUpstreamTarget(nn.Namespace, nn.Name); err != nil {
+			return kongstate.ServiceBackend{}, err
+		}
+		// Ports are defined per target in KongUpstreamTarget's spec, backendRef's port is ignored.
+		return kongstate.NewServiceBackendForKongUpstreamTarget(nn, kongstate.PortDef{})
+
+	default:
+		return kongstate.ServiceBackend{}, fmt.Errorf("unsupported kind %q, only 'Service', %q and %q are supported",
+			*backendRef.Kind, incubatorv1alpha1.KongServiceFacadeKind, incubatorv1alpha1.KongUpstreamTargetKind)
 	}
+}
 
-	return backends
+// isBackendRefOfGroupKind returns true if the backendRef refers to an object of the given Group and Kind.
+// Empty group and "core" are considered equivalent.
+func isBackendRefOfGroupKind(backendRef gatewayapi.BackendRef, group, kind string) bool {
+	if backendRef.Kind == nil || string(*backendRef.Kind) != kind {
+		return false
+	}
+	refGroup := ""
+	if backendRef.Group != nil && *backendRef.Group != "core" {
+		refGroup = string(*backendRef.Group)
+	}
+	return refGroup == group
 }
 
 func loggerForBackendRef(logger logr.Logger, route client.Object, backendRef gatewayapi.BackendRef) logr.Logger {
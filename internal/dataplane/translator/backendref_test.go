@@ -15,6 +15,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 func TestBackendRefsToKongStateBackends(t *testing.T) {
@@ -24,6 +25,7 @@ func TestBackendRefsToKongStateBackends(t *testing.T) {
 		backendRefs []gatewayapi.BackendRef
 		allowed     map[gatewayapi.Namespace][]gatewayapi.ReferenceGrantTo
 		objects     store.FakeObjects
+		features    FeatureFlags
 		expected    kongstate.ServiceBackends
 	}{
 		{
@@ -153,6 +155,137 @@ func TestBackendRefsToKongStateBackends(t *testing.T) {
 			},
 			expected: kongstate.ServiceBackends{},
 		},
+		{
+			name:  "existing KongServiceFacade as backendRef returns a KongStateBackend with the KongServiceFacade",
+			route: basicHTTPRoute(),
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-facade").
+					WithGroup(incubatorv1alpha1.GroupVersion.Group).
+					WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+					WithWeight(10).
+					Build(),
+			},
+			objects: store.FakeObjects{
+				KongServiceFacades: []*incubatorv1alpha1.KongServiceFacade{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-facade",
+							Namespace: corev1.NamespaceDefault,
+						},
+						Spec: incubatorv1alpha1.KongServiceFacadeSpec{
+							Backend: incubatorv1alpha1.KongServiceFacadeBackend{
+								Name: "fake-service",
+								Port: 8080,
+							},
+						},
+					},
+				},
+			},
+			features: FeatureFlags{KongServiceFacade: true},
+			expected: func() kongstate.ServiceBackends {
+				backend, err := kongstate.NewServiceBackendForServiceFacade(
+					k8stypes.NamespacedName{Namespace: corev1.NamespaceDefault, Name: "fake-facade"},
+					kongstate.PortDef{
+						Mode:   kongstate.PortModeByNumber,
+						Number: 8080,
+					},
+				)
+				require.NoError(t, err)
+				backend.SetWeight(10)
+				return kongstate.ServiceBackends{backend}
+			}(),
+		},
+		{
+			name:  "KongServiceFacade as backendRef with feature flag disabled doesn't return a KongStateBackend",
+			route: basicHTTPRoute(),
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-facade").
+					WithGroup(incubatorv1alpha1.GroupVersion.Group).
+					WithKind(incubatorv1alpha1.KongServiceFacadeKind).
+					Build(),
+			},
+			objects: store.FakeObjects{
+				KongServiceFacades: []*incubatorv1alpha1.KongServiceFacade{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-facade",
+							Namespace: corev1.NamespaceDefault,
+						},
+					},
+				},
+			},
+			expected: kongstate.ServiceBackends{},
+		},
+		{
+			name:  "existing KongUpstreamTarget as backendRef returns a KongStateBackend with the KongUpstreamTarget",
+			route: basicHTTPRoute(),
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-upstream-target").
+					WithGroup(incubatorv1alpha1.GroupVersion.Group).
+					WithKind(incubatorv1alpha1.KongUpstreamTargetKind).
+					Build(),
+			},
+			objects: store.FakeObjects{
+				KongUpstreamTargets: []*incubatorv1alpha1.KongUpstreamTarget{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-upstream-target",
+							Namespace: corev1.NamespaceDefault,
+						},
+					},
+				},
+			},
+			features: FeatureFlags{KongUpstreamTarget: true},
+			expected: func() kongstate.ServiceBackends {
+				backend, err := kongstate.NewServiceBackendForKongUpstreamTarget(
+					k8stypes.NamespacedName{Namespace: corev1.NamespaceDefault, Name: "fake-upstream-target"},
+					kongstate.PortDef{},
+				)
+				require.NoError(t, err)
+				return kongstate.ServiceBackends{backend}
+			}(),
+		},
+		{
+			name:  "KongUpstreamTarget as backendRef with feature flag disabled doesn't return a KongStateBackend",
+			route: basicHTTPRoute(),
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-upstream-target").
+					WithGroup(incubatorv1alpha1.GroupVersion.Group).
+					WithKind(incubatorv1alpha1.KongUpstreamTargetKind).
+					Build(),
+			},
+			objects: store.FakeObjects{
+				KongUpstreamTargets: []*incubatorv1alpha1.KongUpstreamTarget{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-upstream-target",
+							Namespace: corev1.NamespaceDefault,
+						},
+					},
+				},
+			},
+			expected: kongstate.ServiceBackends{},
+		},
+		{
+			name:  "non existing KongUpstreamTarget as backendRef doesn't return a KongStateBackend",
+			route: basicHTTPRoute(),
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-upstream-target").
+					WithGroup(incubatorv1alpha1.GroupVersion.Group).
+					WithKind(incubatorv1alpha1.KongUpstreamTargetKind).
+					Build(),
+			},
+			features: FeatureFlags{KongUpstreamTarget: true},
+			expected: kongstate.ServiceBackends{},
+		},
+		{
+			name:  "unsupported kind as backendRef doesn't return a KongStateBackend",
+			route: basicHTTPRoute(),
+			backendRefs: []gatewayapi.BackendRef{
+				builder.NewBackendRef("fake-pod").WithKind("Pod").Build(),
+			},
+			expected: kongstate.ServiceBackends{},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -160,8 +293,20 @@ func TestBackendRefsToKongStateBackends(t *testing.T) {
 			fakestore, err := store.NewFakeStore(tc.objects)
 			require.NoError(t, err)
 			logger := logr.Discard()
-			ret := backendRefsToKongStateBackends(logger, fakestore, tc.route, tc.backendRefs, tc.allowed)
+			ret := backendRefsToKongStateBackends(logger, fakestore, tc.features, tc.route, tc.backendRefs, tc.allowed)
 			require.Equal(t, tc.expected, ret)
 		})
 	}
 }
+
+func basicHTTPRoute() *gatewayapi.HTTPRoute {
+	return &gatewayapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic-httproute",
+			Namespace: corev1.NamespaceDefault,
+		},
+		Spec: gatewayapi.HTTPRouteSpec{
+			CommonRouteSpec: commonRouteSpecMock("fake-gateway-1"),
+		},
+	}
+}
@@ -0,0 +1,108 @@
+package translator
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CertificateInventoryEntry describes a certificate from a TLS Secret that was translated into a Kong certificate.
+type CertificateInventoryEntry struct {
+	// Secret is the Secret holding the certificate.
+	Secret k8stypes.NamespacedName
+
+	// Subject is the subject of the certificate.
+	Subject string
+
+	// SANs are the DNS names and IP addresses from the certificate's Subject Alternative Names.
+	SANs []string
+
+	// NotAfter is the time the certificate expires at.
+	NotAfter time.Time
+
+	// ReferencingObjects are the objects (e.g. Ingresses, TCPIngresses or Gateways) referring to the Secret.
+	ReferencingObjects []client.Object
+}
+
+// certificateInventory collects CertificateInventoryEntries during a translation.
+type certificateInventory struct {
+	entries map[k8stypes.NamespacedName]*CertificateInventoryEntry
+}
+
+func newCertificateInventory() *certificateInventory {
+	return &certificateInventory{
+		entries: make(map[k8stypes.NamespacedName]*CertificateInventoryEntry),
+	}
+}
+
+// record parses the leaf certificate from the PEM encoded cert and records it along with the objects referring
+// to the Secret. It returns an error if the certificate cannot be parsed or has already expired.
+func (i *certificateInventory) record(secret *corev1.Secret, cert string, referencingObjects ...client.Object) error {
+	pemBlock, _ := pem.Decode([]byte(cert))
+	if pemBlock == nil {
+		return errors.New("invalid PEM block")
+	}
+	x509Cert, err := x509.ParseCertificate(pemBlock.Bytes)
+	if err != nil {
+		return fmt.Errorf("failed to parse certificate: %w", err)
+	}
+
+	nn := k8stypes.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}
+	entry, ok := i.entries[nn]
+	if !ok {
+		entry = &CertificateInventoryEntry{
+			Secret:   nn,
+			Subject:  x509Cert.Subject.String(),
+			SANs:     certificateSANs(x509Cert),
+			NotAfter: x509Cert.NotAfter,
+		}
+		i.entries[nn] = entry
+	}
+	entry.ReferencingObjects = lo.UniqBy(append(entry.ReferencingObjects, referencingObjects...), certificateReferrerKey)
+
+	if time.Now().After(x509Cert.NotAfter) {
+		return fmt.Errorf("certificate expired at %s", x509Cert.NotAfter.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// pop returns the collected entries sorted by Secrets and resets the inventory.
+func (i *certificateInventory) pop() []CertificateInventoryEntry {
+	entries := make([]CertificateInventoryEntry, 0, len(i.entries))
+	for _, entry := range i.entries {
+		slices.SortFunc(entry.ReferencingObjects, func(a, b client.Object) int {
+			return strings.Compare(certificateReferrerKey(a), certificateReferrerKey(b))
+		})
+		entries = append(entries, *entry)
+	}
+	slices.SortFunc(entries, func(a, b CertificateInventoryEntry) int {
+		return compareNamespacedNames(a.Secret, b.Secret)
+	})
+	i.entries = make(map[k8stypes.NamespacedName]*CertificateInventoryEntry)
+	return entries
+}
+
+func certificateSANs(cert *x509.Certificate) []string {
+	sans := slices.Clone(cert.DNSNames)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	return sans
+}
+
+func certificateReferrerKey(obj client.Object) string {
+	return strings.Join([]string{
+		obj.GetObjectKind().GroupVersionKind().GroupKind().String(),
+		obj.GetNamespace(),
+		obj.GetName(),
+	}, "/")
+}
@@ -134,6 +134,11 @@ func (ir *ingressRules) generateKongServiceTags(
 	service kongstate.Service,
 	logger logr.Logger,
 ) []*string {
+	// KongUpstreamTarget backends have no Kubernetes Services backing them, so we use the parent object of the Service.
+	if lo.ContainsBy(service.Backends, func(b kongstate.ServiceBackend) bool { return b.IsKongUpstreamTarget() }) {
+		return util.GenerateTagsForObject(service.Parent)
+	}
+
 	// For multi-backend Services we expect ServiceNameToParent to be populated.
 	if len(k8sServices) > 1 {
 		if parent, ok := ir.ServiceNameToParent[*service.Name]; ok {
@@ -322,6 +327,17 @@ func resolveKubernetesServiceForBackend(
 	backend kongstate.ServiceBackend,
 	translatedObjectsCollector *ObjectsCollector,
 ) (*corev1.Service, error) {
+	// In case of KongUpstreamTarget, there's no Kubernetes Service backing it. Its endpoints are resolved
+	// directly into Kong Upstream Targets later on.
+	if backend.IsKongUpstreamTarget() {
+		upstreamTarget, err := storer.GetKongUpstreamTarget(backend.Namespace(), backend.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch KongUpstreamTarget %s/%s: %w", backend.Namespace(), backend.Name(), err)
+		}
+		translatedObjectsCollector.Add(upstreamTarget)
+		return nil, nil
+	}
+
 	// In case of KongServiceFacade, we need to fetch it to determine the Kubernetes Service backing it.
 	// We also want to use its annotations as they override the annotations of the Kubernetes Service.
 	if backend.IsServiceFacade() {
@@ -0,0 +1,179 @@
+package translator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+)
+
+// ObjectRef identifies a Kubernetes object that Kong entities were translated from.
+type ObjectRef struct {
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func (r ObjectRef) String() string {
+	return fmt.Sprintf("%s/%s/%s/%s", r.Group, r.Kind, r.Namespace, r.Name)
+}
+
+// TranslatedKongEntities lists Kong entities that were translated from a Kubernetes object. Services, routes
+// and upstreams are identified by their names, certificates by their IDs and plugins by their names and the
+// entities they're attached to (e.g. "rate-limiting@route=default.echo.0.0").
+type TranslatedKongEntities struct {
+	Services     []string `json:"services,omitempty"`
+	Routes       []string `json:"routes,omitempty"`
+	Plugins      []string `json:"plugins,omitempty"`
+	Upstreams    []string `json:"upstreams,omitempty"`
+	Certificates []string `json:"certificates,omitempty"`
+}
+
+// ObjectsProvenance maps Kubernetes objects to Kong entities that were translated from them.
+type ObjectsProvenance map[ObjectRef]*TranslatedKongEntities
+
+// buildObjectsProvenance indexes entities of the KongState by the Kubernetes objects they were translated from.
+// The objects are determined based on the entities' tags (see util.GenerateTagsForObject). Plugins are additionally
+// indexed by the objects they're attached to with the konghq.com/plugins annotation.
+func buildObjectsProvenance(state *kongstate.KongState) ObjectsProvenance {
+	provenance := ObjectsProvenance{}
+	entitiesFor := func(ref ObjectRef) *TranslatedKongEntities {
+		entities, ok := provenance[ref]
+		if !ok {
+			entities = &TranslatedKongEntities{}
+			provenance[ref] = entities
+		}
+		return entities
+	}
+	record := func(tags []*string, add func(*TranslatedKongEntities)) {
+		if ref, ok := objectRefFromTags(tags); ok {
+			add(entitiesFor(ref))
+		}
+	}
+
+	for _, service := range state.Services {
+		serviceName := entityName(service.Name, service.ID)
+		record(service.Tags, func(e *TranslatedKongEntities) { e.Services = append(e.Services, serviceName) })
+		for _, plugin := range service.Plugins {
+			key := translatedPluginKey(plugin, "service="+serviceName)
+			record(plugin.Tags, func(e *TranslatedKongEntities) { e.Plugins = append(e.Plugins, key) })
+		}
+		for _, route := range service.Routes {
+			routeName := entityName(route.Name, route.ID)
+			record(route.Tags, func(e *TranslatedKongEntities) { e.Routes = append(e.Routes, routeName) })
+			for _, plugin := range route.Plugins {
+				key := translatedPluginKey(plugin, "route="+routeName)
+				record(plugin.Tags, func(e *TranslatedKongEntities) { e.Plugins = append(e.Plugins, key) })
+			}
+		}
+	}
+	for _, upstream := range state.Upstreams {
+		upstreamName := entityName(upstream.Name, upstream.ID)
+		record(upstream.Tags, func(e *TranslatedKongEntities) { e.Upstreams = append(e.Upstreams, upstreamName) })
+	}
+	for _, certificate := range state.Certificates {
+		certificateID := entityName(certificate.ID)
+		record(certificate.Tags, func(e *TranslatedKongEntities) { e.Certificates = append(e.Certificates, certificateID) })
+	}
+	for _, plugin := range state.Plugins {
+		key := translatedPluginKey(plugin.Plugin, pluginScope(plugin.Plugin))
+		record(plugin.Tags, func(e *TranslatedKongEntities) { e.Plugins = append(e.Plugins, key) })
+		if plugin.K8sParent != nil {
+			parentEntities := entitiesFor(objectRefFromObject(plugin.K8sParent))
+			parentEntities.Plugins = append(parentEntities.Plugins, key)
+		}
+	}
+
+	for _, entities := range provenance {
+		entities.Services = sortedUniq(entities.Services)
+		entities.Routes = sortedUniq(entities.Routes)
+		entities.Plugins = sortedUniq(entities.Plugins)
+		entities.Upstreams = sortedUniq(entities.Upstreams)
+		entities.Certificates = sortedUniq(entities.Certificates)
+	}
+	return provenance
+}
+
+// objectRefFromTags determines the Kubernetes object an entity was translated from based on its tags.
+func objectRefFromTags(tags []*string) (ObjectRef, bool) {
+	var ref ObjectRef
+	for _, tagPtr := range tags {
+		tag := lo.FromPtr(tagPtr)
+		switch {
+		case strings.HasPrefix(tag, util.K8sKindTagPrefix) && ref.Kind == "":
+			ref.Kind = strings.TrimPrefix(tag, util.K8sKindTagPrefix)
+		case strings.HasPrefix(tag, util.K8sGroupTagPrefix) && ref.Group == "":
+			ref.Group = strings.TrimPrefix(tag, util.K8sGroupTagPrefix)
+		case strings.HasPrefix(tag, util.K8sNamespaceTagPrefix) && ref.Namespace == "":
+			ref.Namespace = strings.TrimPrefix(tag, util.K8sNamespaceTagPrefix)
+		case strings.HasPrefix(tag, util.K8sNameTagPrefix) && ref.Name == "":
+			ref.Name = strings.TrimPrefix(tag, util.K8sNameTagPrefix)
+		}
+	}
+	return ref, ref.Kind != "" && ref.Name != ""
+}
+
+func objectRefFromObject(obj client.Object) ObjectRef {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	return ObjectRef{
+		Group:     gvk.Group,
+		Kind:      gvk.Kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+}
+
+// pluginScope describes entities a plugin is attached to.
+func pluginScope(plugin kong.Plugin) string {
+	var scopes []string
+	if plugin.Service != nil {
+		scopes = append(scopes, "service="+entityName(plugin.Service.Name, plugin.Service.ID))
+	}
+	if plugin.Route != nil {
+		scopes = append(scopes, "route="+entityName(plugin.Route.Name, plugin.Route.ID))
+	}
+	if plugin.Consumer != nil {
+		scopes = append(scopes, "consumer="+entityName(plugin.Consumer.Username, plugin.Consumer.CustomID, plugin.Consumer.ID))
+	}
+	if plugin.ConsumerGroup != nil {
+		scopes = append(scopes, "consumer_group="+entityName(plugin.ConsumerGroup.Name, plugin.ConsumerGroup.ID))
+	}
+	if len(scopes) == 0 {
+		return "global"
+	}
+	return strings.Join(scopes, ",")
+}
+
+func translatedPluginKey(plugin kong.Plugin, scope string) string {
+	key := lo.FromPtr(plugin.Name)
+	if instanceName := lo.FromPtr(plugin.InstanceName); instanceName != "" {
+		key += "/" + instanceName
+	}
+	return key + "@" + scope
+}
+
+// entityName returns the first non-empty of the given identifiers.
+func entityName(identifiers ...*string) string {
+	for _, id := range identifiers {
+		if id != nil && *id != "" {
+			return *id
+		}
+	}
+	return ""
+}
+
+func sortedUniq(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	s = lo.Uniq(s)
+	sort.Strings(s)
+	return s
+}
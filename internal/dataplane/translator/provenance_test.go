@@ -0,0 +1,92 @@
+package translator
+
+import (
+	"testing"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+)
+
+func TestBuildObjectsProvenance(t *testing.T) {
+	httpRoute := &gatewayapi.HTTPRoute{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "gateway.networking.k8s.io/v1", Kind: "HTTPRoute"},
+		ObjectMeta: metav1.ObjectMeta{Name: "httproute", Namespace: "default"},
+	}
+	service := &corev1.Service{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{Name: "backend", Namespace: "default"},
+	}
+	secretTags := kong.StringSlice(
+		util.K8sNameTagPrefix+"tls",
+		util.K8sNamespaceTagPrefix+"default",
+		util.K8sKindTagPrefix+"Secret",
+		util.K8sVersionTagPrefix+"v1",
+	)
+
+	state := &kongstate.KongState{
+		Services: []kongstate.Service{
+			{
+				Service: kong.Service{
+					Name: kong.String("httproute.default.0"),
+					Tags: util.GenerateTagsForObject(service),
+				},
+				Routes: []kongstate.Route{
+					{
+						Route: kong.Route{Name: kong.String("httproute.default.0.0"), Tags: util.GenerateTagsForObject(httpRoute)},
+					},
+					{
+						Route: kong.Route{Name: kong.String("httproute.default.0.1"), Tags: util.GenerateTagsForObject(httpRoute)},
+						Plugins: []kong.Plugin{
+							{Name: kong.String("request-transformer"), Tags: util.GenerateTagsForObject(httpRoute)},
+						},
+					},
+				},
+			},
+		},
+		Upstreams: []kongstate.Upstream{
+			{Upstream: kong.Upstream{Name: kong.String("httproute.default.0"), Tags: util.GenerateTagsForObject(service)}},
+		},
+		Certificates: []kongstate.Certificate{
+			{Certificate: kong.Certificate{ID: kong.String("cert-id"), Tags: secretTags}},
+		},
+		Plugins: []kongstate.Plugin{
+			{
+				Plugin: kong.Plugin{
+					Name:  kong.String("key-auth"),
+					Route: &kong.Route{ID: kong.String("route-id")},
+					Tags: kong.StringSlice(
+						util.K8sNameTagPrefix+"key-auth",
+						util.K8sNamespaceTagPrefix+"default",
+						util.K8sKindTagPrefix+"KongPlugin",
+						util.K8sGroupTagPrefix+"configuration.konghq.com",
+					),
+				},
+				K8sParent: httpRoute,
+			},
+		},
+	}
+
+	provenance := buildObjectsProvenance(state)
+	require.Equal(t, ObjectsProvenance{
+		{Group: "gateway.networking.k8s.io", Kind: "HTTPRoute", Namespace: "default", Name: "httproute"}: {
+			Routes:  []string{"httproute.default.0.0", "httproute.default.0.1"},
+			Plugins: []string{"key-auth@route=route-id", "request-transformer@route=httproute.default.0.1"},
+		},
+		{Kind: "Service", Namespace: "default", Name: "backend"}: {
+			Services:  []string{"httproute.default.0"},
+			Upstreams: []string{"httproute.default.0"},
+		},
+		{Kind: "Secret", Namespace: "default", Name: "tls"}: {
+			Certificates: []string{"cert-id"},
+		},
+		{Group: "configuration.konghq.com", Kind: "KongPlugin", Namespace: "default", Name: "key-auth"}: {
+			Plugins: []string{"key-auth@route=route-id"},
+		},
+	}, provenance)
+}
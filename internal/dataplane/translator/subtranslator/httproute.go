@@ -25,6 +25,12 @@ type KongServiceTranslation struct {
 	Name        string
 	BackendRefs []gatewayapi.HTTPBackendRef
 	KongRoutes  []KongRouteTranslation
+	// Timeouts are the timeouts of the rules translated into the service. Only rules with the same
+	// timeouts are combined into a single service.
+	Timeouts *gatewayapi.HTTPRouteTimeouts
+	// SessionPersistence is the session persistence of the rules translated into the service. Only rules with
+	// the same session persistence are combined into a single service.
+	SessionPersistence *gatewayapi.SessionPersistence
 }
 
 // KongRouteTranslation is a translation of a single HTTPRoute rule into metadata
@@ -37,9 +43,9 @@ type KongRouteTranslation struct {
 
 // TranslateHTTPRoute translates a list of HTTPRoutes into a list of HTTPRouteTranslationMeta
 // objects that can be used to instantiate Kong routes and services.
-// The translation is done by grouping the HTTPRoutes by their backendRefs.
+// The translation is done by grouping the HTTPRoutes by their backendRefs, timeouts and session persistence.
 // This means that all the rules of a single HTTPRoute will be grouped together
-// if they share the same backendRefs.
+// if they share the same backendRefs, timeouts and session persistence.
 func TranslateHTTPRoute(route *gatewayapi.HTTPRoute) []*KongServiceTranslation {
 	index := httpRouteTranslationIndex{}
 	index.setRoute(route)
@@ -91,6 +97,9 @@ func (i *httpRouteTranslationIndex) translateToKongService(rulesMeta []httpRoute
 		Name:        i.translateToKongServiceName(rulesMeta),
 		BackendRefs: i.translateToKongServiceBackends(rulesMeta),
 		KongRoutes:  nil,
+		Timeouts:    i.translateToKongServiceTimeouts(rulesMeta),
+
+		SessionPersistence: i.translateToKongServiceSessionPersistence(rulesMeta),
 	}
 }
 
@@ -119,6 +128,26 @@ func (i *httpRouteTranslationIndex) translateToKongServiceBackends(rulesMeta []h
 	return rulesMeta[0].Rule.BackendRefs
 }
 
+func (i *httpRouteTranslationIndex) translateToKongServiceTimeouts(rulesMeta []httpRouteRuleMeta) *gatewayapi.HTTPRouteTimeouts {
+	if len(rulesMeta) == 0 {
+		return nil
+	}
+	// get the timeouts from any rule, as they are all the same,
+	// because the rules are processed in groups with the same backendRefs and timeouts.
+	return rulesMeta[0].Rule.Timeouts
+}
+
+func (i *httpRouteTranslationIndex) translateToKongServiceSessionPersistence(
+	rulesMeta []httpRouteRuleMeta,
+) *gatewayapi.SessionPersistence {
+	if len(rulesMeta) == 0 {
+		return nil
+	}
+	// get the session persistence from any rule, as they are all the same,
+	// because the rules are processed in groups with the same backendRefs and session persistence.
+	return rulesMeta[0].Rule.SessionPersistence
+}
+
 func (i *httpRouteTranslationIndex) translateToKongServiceRoutes(s *KongServiceTranslation, rulesMeta []httpRouteRuleMeta) {
 	for _, rulesByFilter := range groupRulesByFilter(rulesMeta) {
 		// each filter group must be a separate Kong route, not eligible for consolidation
@@ -194,11 +223,14 @@ func (i *httpRouteTranslationIndex) translateToKongRouteName(matchesMeta httpRou
 	)
 }
 
-// groupRulesByBackendRefs groups the rules by their backendRefs.
+// groupRulesByBackendRefs groups the rules by their backendRefs, timeouts and session persistence, as the
+// timeouts and session persistence are configured on Kong services and upstreams.
 // The backendRefs are grouped by their key function.
 // The elements in the groups have the order of the original slice, but the groups themselves are not ordered.
 func groupRulesByBackendRefs(ruleEntries []httpRouteRuleMeta) map[string][]httpRouteRuleMeta {
-	return groupSliceByKeyFn(ruleEntries, httpRouteRuleMeta.getHTTPBackendRefsKey)
+	return groupSliceByKeyFn(ruleEntries, func(m httpRouteRuleMeta) string {
+		return m.getHTTPBackendRefsKey() + "|" + m.getTimeoutsKey() + "|" + m.getSessionPersistenceKey()
+	})
 }
 
 // groupRulesByFilter groups the rules by their filters.
@@ -241,6 +273,22 @@ func (m httpRouteRuleMeta) getHTTPBackendRefsKey() string {
 	return getSortedItemsString(m.Rule.BackendRefs)
 }
 
+// getTimeoutsKey computes a key from the rule's timeouts.
+func (m httpRouteRuleMeta) getTimeoutsKey() string {
+	if m.Rule.Timeouts == nil {
+		return ""
+	}
+	return mustMarshalJSON(m.Rule.Timeouts)
+}
+
+// getSessionPersistenceKey computes a key from the rule's session persistence.
+func (m httpRouteRuleMeta) getSessionPersistenceKey() string {
+	if m.Rule.SessionPersistence == nil {
+		return ""
+	}
+	return mustMarshalJSON(m.Rule.SessionPersistence)
+}
+
 func (m *httpRouteRuleMeta) matches() httpRouteMatchMetaList {
 	matches := make([]httpRouteMatchMeta, 0, len(m.Rule.Matches))
 
@@ -416,6 +464,7 @@ func generatePluginsFromHTTPRouteFilters(
 		kongPlugins                 []kong.Plugin
 		pluginNamesFromExtensionRef []string
 		kongRouteModifiers          []kongRouteModifier
+		requestMirrorFilters        []*gatewayapi.HTTPRequestMirrorFilter
 	)
 
 	for _, filter := range filters {
@@ -447,9 +496,18 @@ func generatePluginsFromHTTPRouteFilters(
 			kongRouteModifiers = append(kongRouteModifiers, routeModifiers...)
 
 		case gatewayapi.HTTPRouteFilterRequestMirror:
-			// not supported
-			return httpRouteFiltersOriginatedPlugins{}, fmt.Errorf("httpFilter %s unsupported", filter.Type)
+			requestMirrorFilters = append(requestMirrorFilters, filter.RequestMirror)
+		}
+	}
+
+	// All RequestMirror filters of a rule are translated into a single pre-function plugin as Kong accepts
+	// only one plugin of a kind per route.
+	if len(requestMirrorFilters) > 0 {
+		routeModifier, err := generateKongRouteModifierForRequestMirrors(requestMirrorFilters, tags)
+		if err != nil {
+			return httpRouteFiltersOriginatedPlugins{}, err
 		}
+		kongRouteModifiers = append(kongRouteModifiers, routeModifier)
 	}
 
 	// It's possible the above loop generates multiple transformerPlugins of the same type, so we need to merge them.
@@ -598,6 +656,99 @@ func generateExtensionRefKongPlugin(modifier *gatewayapi.LocalObjectReference) (
 	return string(modifier.Name), nil
 }
 
+// RequestMirrorPluginName is the name of the Kong plugin used to mirror requests. The serverless pre-function plugin
+// is used as Kong Gateway has no dedicated plugin for mirroring requests. The generated Lua code requires the
+// resty.http module, hence Kong Gateway has to allow it with untrusted_lua_sandbox_requires=resty.http.
+const RequestMirrorPluginName = "pre-function"
+
+// requestMirrorLuaTemplate is the Lua code run in the access phase by the pre-function plugin generated for
+// the RequestMirror filter. It copies the request and sends it to the mirror target in a detached timer,
+// so the response from the mirror target is ignored and doesn't affect the latency of the original request.
+// The template expects the following arguments (in order): percentage of requests to mirror, mirror target URL.
+const requestMirrorLuaTemplate = `local percentage = %d
+if percentage < 100 and math.random(100) > percentage then
+  return
+end
+local http = require("resty.http")
+local url = %q .. kong.request.get_path_with_query()
+local opts = {
+  method = kong.request.get_method(),
+  headers = kong.request.get_headers(),
+  body = kong.request.get_raw_body(),
+}
+ngx.timer.at(0, function(premature)
+  if premature then
+    return
+  end
+  local _, err = http.new():request_uri(url, opts)
+  if err then
+    kong.log.warn("failed to mirror request to ", url, ": ", err)
+  end
+end)
+`
+
+// generateKongRouteModifierForRequestMirrors generates a Kong route modifier that attaches a single pre-function
+// plugin mirroring requests to the backends referenced by all the RequestMirror filters of a rule, each of them
+// in a separate access phase chunk. A route modifier is used instead of a plain plugin because the backendRef
+// namespace defaults to the namespace of the route.
+func generateKongRouteModifierForRequestMirrors(
+	filters []*gatewayapi.HTTPRequestMirrorFilter,
+	tags []*string,
+) (kongRouteModifier, error) {
+	for _, filter := range filters {
+		if err := validateRequestMirrorFilter(filter); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(route *kongstate.Route) {
+		access := make([]string, 0, len(filters))
+		for _, filter := range filters {
+			backendRef := filter.BackendRef
+			namespace := route.Ingress.Namespace
+			if backendRef.Namespace != nil && *backendRef.Namespace != "" {
+				namespace = string(*backendRef.Namespace)
+			}
+			// Mirrored requests are sent to the Service's cluster DNS name, so that a single endpoint
+			// of the Service receives them as required by the Gateway API specification.
+			url := fmt.Sprintf("http://%s.%s.svc:%d", backendRef.Name, namespace, *backendRef.Port)
+			access = append(access, fmt.Sprintf(requestMirrorLuaTemplate, requestMirrorPercentage(filter), url))
+		}
+		route.Plugins = append(route.Plugins, kong.Plugin{
+			Name: kong.String(RequestMirrorPluginName),
+			Config: kong.Configuration{
+				"access": access,
+			},
+			Tags: tags,
+		})
+	}, nil
+}
+
+// validateRequestMirrorFilter checks whether the RequestMirror filter's backendRef can be translated.
+func validateRequestMirrorFilter(filter *gatewayapi.HTTPRequestMirrorFilter) error {
+	if filter == nil {
+		return fmt.Errorf("%s is not provided", gatewayapi.HTTPRouteFilterRequestMirror)
+	}
+	backendRef := filter.BackendRef
+	if backendRef.Group != nil && *backendRef.Group != "" && *backendRef.Group != "core" {
+		return fmt.Errorf("%s backendRef group %s unsupported", gatewayapi.HTTPRouteFilterRequestMirror, *backendRef.Group)
+	}
+	if backendRef.Kind != nil && *backendRef.Kind != "Service" {
+		return fmt.Errorf("%s backendRef kind %s unsupported", gatewayapi.HTTPRouteFilterRequestMirror, *backendRef.Kind)
+	}
+	if backendRef.Port == nil {
+		return fmt.Errorf("%s backendRef %s is missing port", gatewayapi.HTTPRouteFilterRequestMirror, backendRef.Name)
+	}
+	return nil
+}
+
+// requestMirrorPercentage returns the percentage of requests that should be mirrored for the given filter.
+// Gateway API v1.1 doesn't allow configuring it yet (percent and fraction fields were introduced in v1.2),
+// hence all requests are mirrored.
+func requestMirrorPercentage(_ *gatewayapi.HTTPRequestMirrorFilter) int {
+	return 100
+}
+
 // generateRequestHeaderModifierKongPlugin converts a gatewayapi.HTTPRequestHeaderFilter into a
 // kong.Plugin of type request-transformer.
 func generateRequestHeaderModifierKongPlugin(modifier *gatewayapi.HTTPHeaderFilter) transformerPlugin {
@@ -2,6 +2,7 @@ package subtranslator
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/kong/go-kong/kong"
@@ -215,6 +216,103 @@ func TestGeneratePluginsFromHTTPRouteFilters(t *testing.T) {
 			},
 			expectedErr: errors.New("plugin configuration.konghq.com/WrongKind unsupported"),
 		},
+		{
+			name: "request mirror filter",
+			filters: []gatewayapi.HTTPRouteFilter{
+				{
+					Type: gatewayapi.HTTPRouteFilterRequestMirror,
+					RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+						BackendRef: gatewayapi.BackendObjectReference{
+							Name:      "mirror",
+							Namespace: lo.ToPtr(gatewayapi.Namespace("other")),
+							Kind:      lo.ToPtr(gatewayapi.Kind("Service")),
+							Port:      lo.ToPtr(gatewayapi.PortNumber(8080)),
+						},
+					},
+				},
+			},
+			expectedRouteModifications: kongstate.Route{
+				Plugins: []kong.Plugin{
+					{
+						Name: kong.String("pre-function"),
+						Config: kong.Configuration{
+							"access": []string{
+								fmt.Sprintf(requestMirrorLuaTemplate, 100, "http://mirror.other.svc:8080"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "multiple request mirror filters",
+			filters: []gatewayapi.HTTPRouteFilter{
+				{
+					Type: gatewayapi.HTTPRouteFilterRequestMirror,
+					RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+						BackendRef: gatewayapi.BackendObjectReference{
+							Name:      "mirror",
+							Namespace: lo.ToPtr(gatewayapi.Namespace("other")),
+							Port:      lo.ToPtr(gatewayapi.PortNumber(8080)),
+						},
+					},
+				},
+				{
+					Type: gatewayapi.HTTPRouteFilterRequestMirror,
+					RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+						BackendRef: gatewayapi.BackendObjectReference{
+							Name:      "another-mirror",
+							Namespace: lo.ToPtr(gatewayapi.Namespace("other")),
+							Port:      lo.ToPtr(gatewayapi.PortNumber(80)),
+						},
+					},
+				},
+			},
+			expectedRouteModifications: kongstate.Route{
+				Plugins: []kong.Plugin{
+					{
+						Name: kong.String("pre-function"),
+						Config: kong.Configuration{
+							"access": []string{
+								fmt.Sprintf(requestMirrorLuaTemplate, 100, "http://mirror.other.svc:8080"),
+								fmt.Sprintf(requestMirrorLuaTemplate, 100, "http://another-mirror.other.svc:80"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "request mirror filter without port",
+			filters: []gatewayapi.HTTPRouteFilter{
+				{
+					Type: gatewayapi.HTTPRouteFilterRequestMirror,
+					RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+						BackendRef: gatewayapi.BackendObjectReference{
+							Name: "mirror",
+							Kind: lo.ToPtr(gatewayapi.Kind("Service")),
+						},
+					},
+				},
+			},
+			expectedErr: errors.New("RequestMirror backendRef mirror is missing port"),
+		},
+		{
+			name: "request mirror filter with unsupported kind",
+			filters: []gatewayapi.HTTPRouteFilter{
+				{
+					Type: gatewayapi.HTTPRouteFilterRequestMirror,
+					RequestMirror: &gatewayapi.HTTPRequestMirrorFilter{
+						BackendRef: gatewayapi.BackendObjectReference{
+							Name: "mirror",
+							Kind: lo.ToPtr(gatewayapi.Kind("Pod")),
+							Port: lo.ToPtr(gatewayapi.PortNumber(8080)),
+						},
+					},
+				},
+			},
+			expectedErr: errors.New("RequestMirror backendRef kind Pod unsupported"),
+		},
 		{
 			name: "RequestHeaderModifier and PrefixMatchHTTPPathModifier",
 			filters: []gatewayapi.HTTPRouteFilter{
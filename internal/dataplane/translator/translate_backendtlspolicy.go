@@ -0,0 +1,239 @@
+package translator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+)
+
+// BackendTLSPolicyCACertificateKey is the key of the ConfigMap or Secret data holding the PEM encoded CA certificate
+// referenced by a BackendTLSPolicy.
+const BackendTLSPolicyCACertificateKey = "ca.crt"
+
+// backendTLSProtocols maps Kong Service protocols to their TLS counterparts used when a BackendTLSPolicy applies.
+var backendTLSProtocols = map[string]string{
+	"http": "https",
+	"grpc": "grpcs",
+	"ws":   "wss",
+	"tcp":  "tls",
+}
+
+// applyBackendTLSPolicies configures Kong Services (and their Upstreams) backed by Kubernetes Services targeted by
+// a BackendTLSPolicy to connect to their backends over TLS and verify the backends' certificates. CA certificates
+// referenced by the policies are added to the KongState unless the same certificates are already there.
+// Kong derives the SNI and the name it verifies the backends' certificates against from the Upstream's host header,
+// so it's set to the policy's hostname. This also changes the Host header sent to the backends to the hostname.
+func (t *Translator) applyBackendTLSPolicies(result *kongstate.KongState) {
+	policies, err := t.storer.ListBackendTLSPolicies()
+	if err != nil {
+		t.logger.Error(err, "Failed to list BackendTLSPolicies")
+		return
+	}
+	if len(policies) == 0 {
+		return
+	}
+	sortBackendTLSPolicies(policies)
+
+	upstreamsByName := make(map[string]*kongstate.Upstream, len(result.Upstreams))
+	for i := range result.Upstreams {
+		if result.Upstreams[i].Name != nil {
+			upstreamsByName[*result.Upstreams[i].Name] = &result.Upstreams[i]
+		}
+	}
+
+	for i := range result.Services {
+		service := &result.Services[i]
+		policy, ok := t.getBackendTLSPolicyForService(policies, service)
+		if !ok {
+			continue
+		}
+
+		caCertificates, err := t.getBackendTLSPolicyCACertificates(policy)
+		if err != nil {
+			t.registerTranslationFailure(fmt.Sprintf("invalid BackendTLSPolicy: %s", err), policy)
+			continue
+		}
+
+		if service.Protocol != nil {
+			if tlsProtocol, ok := backendTLSProtocols[*service.Protocol]; ok {
+				service.Protocol = kong.String(tlsProtocol)
+			}
+		}
+		service.TLSVerify = kong.Bool(true)
+		service.CACertificates = nil
+		for _, caCert := range caCertificates {
+			service.CACertificates = append(service.CACertificates, kong.String(addCACertificate(result, caCert)))
+		}
+
+		// Kong uses the Upstream's host header as the SNI when connecting to the backend over TLS.
+		if hostname := policy.Spec.Validation.Hostname; hostname != "" && service.Host != nil {
+			if upstream, ok := upstreamsByName[*service.Host]; ok {
+				upstream.HostHeader = kong.String(string(hostname))
+			}
+		}
+
+		t.registerSuccessfullyTranslatedObject(policy)
+	}
+}
+
+// getBackendTLSPolicyForService returns the BackendTLSPolicy targeting Kubernetes Services backing the Kong Service.
+// Policies targeting a specific port (with sectionName) take precedence over policies targeting a whole Service.
+// If the Kubernetes Services are targeted by different policies, a translation failure is registered and no policy
+// is returned as the Kong Service can be configured with only one of them.
+func (t *Translator) getBackendTLSPolicyForService(
+	policies []*gatewayapi.BackendTLSPolicy,
+	service *kongstate.Service,
+) (*gatewayapi.BackendTLSPolicy, bool) {
+	var (
+		matched          = make(map[k8stypes.NamespacedName]*gatewayapi.BackendTLSPolicy)
+		notCoveredExists bool
+	)
+	for _, backend := range service.Backends {
+		if backend.IsServiceFacade() || backend.IsKongUpstreamTarget() {
+			continue
+		}
+		k8sService, ok := service.K8sServices[fmt.Sprintf("%s/%s", backend.Namespace(), backend.Name())]
+		if !ok {
+			continue
+		}
+		var portName string
+		if port, err := findPort(k8sService, backend.PortDef()); err == nil {
+			portName = port.Name
+		}
+
+		policy, ok := findBackendTLSPolicyForServicePort(policies, k8sService, portName)
+		if !ok {
+			notCoveredExists = true
+			continue
+		}
+		matched[client.ObjectKeyFromObject(policy)] = policy
+	}
+
+	switch {
+	case len(matched) == 0:
+		return nil, false
+	case len(matched) > 1 || notCoveredExists:
+		causingObjects := lo.Map(lo.Values(matched), func(p *gatewayapi.BackendTLSPolicy, _ int) client.Object { return p })
+		causingObjects = append(causingObjects, lo.Map(lo.Values(service.K8sServices), servicesAsObjects)...)
+		t.registerTranslationFailure(
+			"inconsistent BackendTLSPolicy configuration for Kubernetes Services backing the same Kong Service",
+			causingObjects...,
+		)
+		return nil, false
+	default:
+		return lo.Values(matched)[0], true
+	}
+}
+
+// findBackendTLSPolicyForServicePort returns the BackendTLSPolicy targeting the Service's port. It expects policies
+// to be sorted by their precedence (see sortBackendTLSPolicies).
+func findBackendTLSPolicyForServicePort(
+	policies []*gatewayapi.BackendTLSPolicy,
+	svc *corev1.Service,
+	portName string,
+) (*gatewayapi.BackendTLSPolicy, bool) {
+	var wholeServicePolicy *gatewayapi.BackendTLSPolicy
+	for _, policy := range policies {
+		if policy.Namespace != svc.Namespace {
+			continue
+		}
+		for _, targetRef := range policy.Spec.TargetRefs {
+			if targetRef.Group != "" && targetRef.Group != "core" ||
+				targetRef.Kind != "Service" ||
+				string(targetRef.Name) != svc.Name {
+				continue
+			}
+			if targetRef.SectionName == nil {
+				if wholeServicePolicy == nil {
+					wholeServicePolicy = policy
+				}
+				continue
+			}
+			if portName != "" && string(*targetRef.SectionName) == portName {
+				return policy, true
+			}
+		}
+	}
+	return wholeServicePolicy, wholeServicePolicy != nil
+}
+
+// getBackendTLSPolicyCACertificates returns Kong CA certificates built from ConfigMaps and Secrets referenced
+// by the BackendTLSPolicy.
+func (t *Translator) getBackendTLSPolicyCACertificates(policy *gatewayapi.BackendTLSPolicy) ([]kong.CACertificate, error) {
+	validation := policy.Spec.Validation
+	if validation.WellKnownCACertificates != nil {
+		if *validation.WellKnownCACertificates != gatewayapi.WellKnownCACertificatesSystem {
+			return nil, fmt.Errorf("unsupported wellKnownCACertificates %q", *validation.WellKnownCACertificates)
+		}
+		// System CA certificates are trusted by Kong, no CA certificates need to be configured.
+		return nil, nil
+	}
+	if len(validation.CACertificateRefs) == 0 {
+		return nil, fmt.Errorf("either caCertificateRefs or wellKnownCACertificates must be specified")
+	}
+
+	caCertificates := make([]kong.CACertificate, 0, len(validation.CACertificateRefs))
+	for _, ref := range validation.CACertificateRefs {
+		var (
+			obj  client.Object
+			data []byte
+		)
+		switch {
+		case ref.Group == "" && ref.Kind == "ConfigMap":
+			configMap, err := t.storer.GetConfigMap(policy.Namespace, string(ref.Name))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get ConfigMap %s: %w", ref.Name, err)
+			}
+			obj, data = configMap, []byte(configMap.Data[BackendTLSPolicyCACertificateKey])
+		case ref.Group == "" && ref.Kind == "Secret":
+			secret, err := t.storer.GetSecret(policy.Namespace, string(ref.Name))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get Secret %s: %w", ref.Name, err)
+			}
+			obj, data = secret, secret.Data[BackendTLSPolicyCACertificateKey]
+		default:
+			return nil, fmt.Errorf("unsupported caCertificateRef kind %s/%s", ref.Group, ref.Kind)
+		}
+
+		if len(data) == 0 {
+			return nil, fmt.Errorf("%s %s is missing the %q key", ref.Kind, ref.Name, BackendTLSPolicyCACertificateKey)
+		}
+		if err := validateCACertificate(data); err != nil {
+			return nil, fmt.Errorf("invalid CA certificate in %s %s: %w", ref.Kind, ref.Name, err)
+		}
+
+		// The ID is derived from the referenced object to remain stable across translations.
+		id := uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("%s/%s/%s", ref.Kind, policy.Namespace, ref.Name)))
+		caCertificates = append(caCertificates, kong.CACertificate{
+			ID:   kong.String(id.String()),
+			Cert: kong.String(string(data)),
+			Tags: util.GenerateTagsForObject(obj),
+		})
+	}
+	return caCertificates, nil
+}
+
+// sortBackendTLSPolicies sorts policies by their creation timestamp and then by namespace/name, following the Gateway
+// API conflict resolution rules: the oldest policy takes precedence.
+func sortBackendTLSPolicies(policies []*gatewayapi.BackendTLSPolicy) {
+	sort.SliceStable(policies, func(i, j int) bool {
+		if !policies[i].CreationTimestamp.Equal(&policies[j].CreationTimestamp) {
+			return policies[i].CreationTimestamp.Before(&policies[j].CreationTimestamp)
+		}
+		return client.ObjectKeyFromObject(policies[i]).String() < client.ObjectKeyFromObject(policies[j]).String()
+	})
+}
+
+func servicesAsObjects(svc *corev1.Service, _ int) client.Object {
+	return svc
+}
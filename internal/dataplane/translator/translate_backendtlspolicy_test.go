@@ -0,0 +1,209 @@
+package translator
+
+import (
+	"testing"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/test/helpers/certificate"
+)
+
+func TestApplyBackendTLSPolicies(t *testing.T) {
+	caCert, _ := certificate.MustGenerateSelfSignedCertPEMFormat(certificate.WithCATrue())
+
+	k8sService := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backend",
+			Namespace: "default",
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "https", Port: 443}},
+		},
+	}
+	caConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ca",
+			Namespace: "default",
+		},
+		Data: map[string]string{BackendTLSPolicyCACertificateKey: string(caCert)},
+	}
+	newPolicy := func(sectionName *gatewayapi.SectionName, validation gatewayapi.BackendTLSPolicyValidation) *gatewayapi.BackendTLSPolicy {
+		return &gatewayapi.BackendTLSPolicy{
+			TypeMeta: gatewayapi.BackendTLSPolicyTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "policy",
+				Namespace: "default",
+			},
+			Spec: gatewayapi.BackendTLSPolicySpec{
+				TargetRefs: []gatewayapi.LocalPolicyTargetReferenceWithSectionName{
+					{
+						LocalPolicyTargetReference: gatewayapi.LocalPolicyTargetReference{
+							Kind: "Service",
+							Name: "backend",
+						},
+						SectionName: sectionName,
+					},
+				},
+				Validation: validation,
+			},
+		}
+	}
+	configMapValidation := gatewayapi.BackendTLSPolicyValidation{
+		CACertificateRefs: []gatewayapi.LocalObjectReference{{Kind: "ConfigMap", Name: "ca"}},
+		Hostname:          "backend.example.com",
+	}
+	newKongState := func() kongstate.KongState {
+		backend := lo.Must(kongstate.NewServiceBackendForService(
+			k8stypes.NamespacedName{Namespace: "default", Name: "backend"},
+			kongstate.PortDef{Mode: kongstate.PortModeByNumber, Number: 443},
+		))
+		return kongstate.KongState{
+			Services: []kongstate.Service{
+				{
+					Service: kong.Service{
+						Name:     kong.String("default.backend.443"),
+						Host:     kong.String("backend.default.443.svc"),
+						Protocol: kong.String("http"),
+					},
+					Backends:    []kongstate.ServiceBackend{backend},
+					K8sServices: map[string]*corev1.Service{"default/backend": k8sService},
+				},
+			},
+			Upstreams: []kongstate.Upstream{
+				{Upstream: kong.Upstream{Name: kong.String("backend.default.443.svc")}},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		policy         *gatewayapi.BackendTLSPolicy
+		expectTLS      bool
+		expectCACerts  int
+		expectFailures int
+	}{
+		{
+			name:          "policy targeting the whole Service with a ConfigMap CA certificate",
+			policy:        newPolicy(nil, configMapValidation),
+			expectTLS:     true,
+			expectCACerts: 1,
+		},
+		{
+			name:          "policy targeting the Service's port",
+			policy:        newPolicy(lo.ToPtr(gatewayapi.SectionName("https")), configMapValidation),
+			expectTLS:     true,
+			expectCACerts: 1,
+		},
+		{
+			name:   "policy targeting another port",
+			policy: newPolicy(lo.ToPtr(gatewayapi.SectionName("other")), configMapValidation),
+		},
+		{
+			name: "policy with system CA certificates",
+			policy: newPolicy(nil, gatewayapi.BackendTLSPolicyValidation{
+				WellKnownCACertificates: lo.ToPtr(gatewayapi.WellKnownCACertificatesSystem),
+				Hostname:                "backend.example.com",
+			}),
+			expectTLS: true,
+		},
+		{
+			name: "policy referencing a missing ConfigMap",
+			policy: newPolicy(nil, gatewayapi.BackendTLSPolicyValidation{
+				CACertificateRefs: []gatewayapi.LocalObjectReference{{Kind: "ConfigMap", Name: "missing"}},
+				Hostname:          "backend.example.com",
+			}),
+			expectFailures: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := store.NewFakeStore(store.FakeObjects{
+				Services:           []*corev1.Service{k8sService},
+				ConfigMaps:         []*corev1.ConfigMap{caConfigMap},
+				BackendTLSPolicies: []*gatewayapi.BackendTLSPolicy{tc.policy},
+			})
+			require.NoError(t, err)
+			translator := mustNewTranslator(t, s)
+
+			state := newKongState()
+			translator.applyBackendTLSPolicies(&state)
+
+			require.Len(t, translator.popTranslationFailures(), tc.expectFailures)
+			require.Len(t, state.CACertificates, tc.expectCACerts)
+			service, upstream := state.Services[0], state.Upstreams[0]
+			if !tc.expectTLS {
+				assert.Equal(t, "http", *service.Protocol)
+				assert.Nil(t, service.TLSVerify)
+				assert.Nil(t, upstream.HostHeader)
+				return
+			}
+			assert.Equal(t, "https", *service.Protocol)
+			assert.Equal(t, kong.Bool(true), service.TLSVerify)
+			// Kong derives the SNI from the Upstream's host header.
+			assert.Equal(t, kong.String("backend.example.com"), upstream.HostHeader, "SNI should be the policy hostname")
+			assert.Len(t, service.CACertificates, tc.expectCACerts)
+			for i, c := range state.CACertificates {
+				assert.Equal(t, *c.ID, *service.CACertificates[i])
+				assert.Equal(t, string(caCert), *c.Cert)
+			}
+		})
+	}
+
+	t.Run("CA certificate already configured with a konghq.com/ca-cert Secret is reused", func(t *testing.T) {
+		s, err := store.NewFakeStore(store.FakeObjects{
+			Services:           []*corev1.Service{k8sService},
+			ConfigMaps:         []*corev1.ConfigMap{caConfigMap},
+			BackendTLSPolicies: []*gatewayapi.BackendTLSPolicy{newPolicy(nil, configMapValidation)},
+		})
+		require.NoError(t, err)
+		translator := mustNewTranslator(t, s)
+
+		state := newKongState()
+		state.CACertificates = []kong.CACertificate{
+			{ID: kong.String("ca-cert-secret-id"), Cert: kong.String(string(caCert))},
+		}
+		translator.applyBackendTLSPolicies(&state)
+
+		require.Empty(t, translator.popTranslationFailures())
+		require.Len(t, state.CACertificates, 1)
+		assert.Equal(t, []*string{kong.String("ca-cert-secret-id")}, state.Services[0].CACertificates)
+	})
+
+	t.Run("conflicting policies for Services backing the same Kong Service", func(t *testing.T) {
+		otherService := k8sService.DeepCopy()
+		otherService.Name = "other"
+		otherPolicy := newPolicy(nil, configMapValidation)
+		otherPolicy.Name = "other"
+		otherPolicy.Spec.TargetRefs[0].Name = "other"
+
+		s, err := store.NewFakeStore(store.FakeObjects{
+			Services:           []*corev1.Service{k8sService, otherService},
+			ConfigMaps:         []*corev1.ConfigMap{caConfigMap},
+			BackendTLSPolicies: []*gatewayapi.BackendTLSPolicy{newPolicy(nil, configMapValidation), otherPolicy},
+		})
+		require.NoError(t, err)
+		translator := mustNewTranslator(t, s)
+
+		state := newKongState()
+		state.Services[0].Backends = append(state.Services[0].Backends, lo.Must(kongstate.NewServiceBackendForService(
+			k8stypes.NamespacedName{Namespace: "default", Name: "other"},
+			kongstate.PortDef{Mode: kongstate.PortModeByNumber, Number: 443},
+		)))
+		state.Services[0].K8sServices["default/other"] = otherService
+		translator.applyBackendTLSPolicies(&state)
+
+		require.Len(t, translator.popTranslationFailures(), 1)
+		assert.Nil(t, state.Services[0].TLSVerify)
+	})
+}
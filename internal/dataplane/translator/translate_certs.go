@@ -4,13 +4,16 @@ import (
 	"bytes"
 	"crypto/tls"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
@@ -42,6 +45,9 @@ type certWrapper struct {
 	cert              kong.Certificate
 	snis              []string
 	CreationTimestamp metav1.Time
+	// gateways are the Gateways whose listeners refer to the certificate. It's empty for certificates
+	// that are not specific to Gateways (e.g. referred by Ingresses).
+	gateways []k8stypes.NamespacedName
 }
 
 func (t *Translator) getGatewayCerts() []certWrapper {
@@ -114,6 +120,11 @@ func (t *Translator) getGatewayCerts() []certWrapper {
 						t.registerTranslationFailure("failed to construct certificate from secret", secret, gateway)
 						continue
 					}
+					if err := t.certificateInventory.record(secret, cert, gateway); err != nil {
+						t.registerTranslationFailure(fmt.Sprintf("invalid certificate in secret '%s/%s': %s",
+							secret.Namespace, secret.Name, err), secret, gateway)
+						continue
+					}
 
 					// determine the SNI
 					hostname := "*"
@@ -121,6 +132,12 @@ func (t *Translator) getGatewayCerts() []certWrapper {
 						hostname = string(*listener.Hostname)
 					}
 
+					// attribute the certificate to the Gateway so that it's configured only in its Kong nodes
+					var gateways []k8stypes.NamespacedName
+					if t.featureFlags.GatewaySharding {
+						gateways = []k8stypes.NamespacedName{{Namespace: gateway.Namespace, Name: gateway.Name}}
+					}
+
 					// create a Kong certificate, wrap it in metadata, and add it to the certs slice
 					certs = append(certs, certWrapper{
 						identifier: cert + key,
@@ -132,6 +149,7 @@ func (t *Translator) getGatewayCerts() []certWrapper {
 						},
 						CreationTimestamp: secret.CreationTimestamp,
 						snis:              []string{hostname},
+						gateways:          gateways,
 					})
 				}
 			}
@@ -156,6 +174,12 @@ func (t *Translator) getCerts(secretsToSNIs SecretNameToSNIs) []certWrapper {
 			t.registerTranslationFailure("failed to construct certificate from secret", causingObjects...)
 			continue
 		}
+		if err := t.certificateInventory.record(secret, cert, SNIs.Parents()...); err != nil {
+			causingObjects := append(SNIs.Parents(), secret)
+			t.registerTranslationFailure(fmt.Sprintf("invalid certificate in secret '%s/%s': %s",
+				secret.Namespace, secret.Name, err), causingObjects...)
+			continue
+		}
 		certs = append(certs, certWrapper{
 			identifier: cert + key,
 			cert: kong.Certificate{
@@ -202,6 +226,13 @@ func mergeCerts(logger logr.Logger, certLists ...[]certWrapper) ([]kongstate.Cer
 					current.CreationTimestamp = cw.CreationTimestamp
 				}
 				current.snis = append(current.snis, cw.snis...)
+				// a certificate is specific to Gateways only if all the merged ones are
+				if len(current.gateways) == 0 || len(cw.gateways) == 0 {
+					current.gateways = nil
+				} else {
+					current.gateways = lo.Union(current.gateways, cw.gateways)
+					slices.SortFunc(current.gateways, compareNamespacedNames)
+				}
 			}
 
 			// although we use current in the end, we only warn/exclude on new ones here. SNIs already in the slice
@@ -238,6 +269,7 @@ func mergeCerts(logger logr.Logger, certLists ...[]certWrapper) ([]kongstate.Cer
 		})
 		res = append(res, kongstate.Certificate{
 			Certificate: cw.cert,
+			Gateways:    cw.gateways,
 		})
 	}
 
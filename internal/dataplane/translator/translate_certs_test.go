@@ -3,15 +3,101 @@ package translator
 import (
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/test/helpers/certificate"
 )
 
+func TestCertificateInventory(t *testing.T) {
+	validCrt, validKey := certificate.MustGenerateSelfSignedCertPEMFormat(
+		certificate.WithCommonName("foo.com"),
+		certificate.WithDNSNames("foo.com", "www.foo.com"),
+	)
+	expiredCrt, expiredKey := certificate.MustGenerateSelfSignedCertPEMFormat(
+		certificate.WithCommonName("bar.com"),
+		certificate.WithAlreadyExpired(),
+	)
+	ingress := func(name, secretName, host string) *netv1.Ingress {
+		return &netv1.Ingress{
+			TypeMeta: metav1.TypeMeta{APIVersion: "networking.k8s.io/v1", Kind: "Ingress"},
+			ObjectMeta: metav1.ObjectMeta{
+				UID:       k8stypes.UID(name + "-uid"),
+				Name:      name,
+				Namespace: "default",
+				Annotations: map[string]string{
+					annotations.IngressClassKey: annotations.DefaultIngressClass,
+				},
+			},
+			Spec: netv1.IngressSpec{
+				TLS: []netv1.IngressTLS{{SecretName: secretName, Hosts: []string{host}}},
+			},
+		}
+	}
+	secret := func(name string, crt, key []byte) *corev1.Secret {
+		return &corev1.Secret{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{
+				UID:       k8stypes.UID(name + "-uid"),
+				Name:      name,
+				Namespace: "default",
+			},
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       crt,
+				corev1.TLSPrivateKeyKey: key,
+			},
+		}
+	}
+
+	s, err := store.NewFakeStore(store.FakeObjects{
+		IngressesV1: []*netv1.Ingress{
+			ingress("foo", "valid", "foo.com"),
+			ingress("www-foo", "valid", "www.foo.com"),
+			ingress("bar", "expired", "bar.com"),
+		},
+		Secrets: []*corev1.Secret{
+			secret("valid", validCrt, validKey),
+			secret("expired", expiredCrt, expiredKey),
+		},
+	})
+	require.NoError(t, err)
+	result := mustNewTranslator(t, s).BuildKongConfig()
+
+	t.Log("Verifying that the expired certificate is excluded with a translation failure")
+	require.Len(t, result.KongState.Certificates, 1)
+	require.Equal(t, "valid-uid", *result.KongState.Certificates[0].ID)
+	require.Len(t, result.TranslationFailures, 1)
+	require.Contains(t, result.TranslationFailures[0].Message(), "invalid certificate in secret 'default/expired': certificate expired at")
+	require.ElementsMatch(t, []string{"bar", "expired"}, lo.Map(result.TranslationFailures[0].CausingObjects(),
+		func(obj client.Object, _ int) string { return obj.GetName() },
+	))
+
+	t.Log("Verifying that both certificates are recorded in the inventory")
+	require.Len(t, result.CertificateInventory, 2)
+	expired, valid := result.CertificateInventory[0], result.CertificateInventory[1]
+	require.Equal(t, k8stypes.NamespacedName{Namespace: "default", Name: "expired"}, expired.Secret)
+	require.True(t, expired.NotAfter.Before(time.Now()))
+	require.Equal(t, k8stypes.NamespacedName{Namespace: "default", Name: "valid"}, valid.Secret)
+	require.Contains(t, valid.Subject, "CN=foo.com")
+	require.Equal(t, []string{"foo.com", "www.foo.com"}, valid.SANs)
+	require.True(t, valid.NotAfter.After(time.Now()))
+	require.Equal(t, []string{"foo", "www-foo"}, lo.Map(valid.ReferencingObjects,
+		func(obj client.Object, _ int) string { return obj.GetName() },
+	))
+}
+
 func TestMergeCerts(t *testing.T) {
 	crt1, key1 := certificate.MustGenerateSelfSignedCertPEMFormat(certificate.WithCommonName("foo.com"))
 	crt2, key2 := certificate.MustGenerateSelfSignedCertPEMFormat(certificate.WithCommonName("bar.com"))
@@ -0,0 +1,290 @@
+package translator
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+)
+
+// GatewayListenerCACertificateKey is the key of the ConfigMap or Secret data holding the PEM encoded CA certificate
+// referenced by a Gateway listener's frontendValidation.
+const GatewayListenerCACertificateKey = "ca.crt"
+
+// mtlsAuthPluginName is the name of the Kong plugin verifying client certificates.
+const mtlsAuthPluginName = "mtls-auth"
+
+// listenerKey identifies a listener of a Gateway.
+type listenerKey struct {
+	gateway k8stypes.NamespacedName
+	name    gatewayapi.SectionName
+}
+
+// frontendTLSValidation holds CA certificates to validate client certificates with at a Gateway listener.
+type frontendTLSValidation struct {
+	gateway        *gatewayapi.Gateway
+	caCertificates []kong.CACertificate
+}
+
+// applyGatewayFrontendTLSValidation requires client certificates for Kong Routes translated from HTTPRoutes and
+// GRPCRoutes attached to programmed HTTPS listeners with tls.frontendValidation. CA certificates referenced by the
+// listeners are added to the KongState and an mtls-auth plugin verifying client certificates against them is added
+// to the Routes. As Kong doesn't separate Routes by listeners, client certificates are required wherever the Routes
+// are served (the route controllers report routes also attached to listeners without frontendValidation).
+// KongPlugins named mtls-auth attached to the Routes are dropped by resolveRoutePluginsConflicts.
+func (t *Translator) applyGatewayFrontendTLSValidation(result *kongstate.KongState) {
+	validations := t.getListenersFrontendTLSValidations()
+	if len(validations) == 0 {
+		return
+	}
+	if !t.featureFlags.EnterpriseEdition {
+		for _, gateway := range uniqueGateways(validations) {
+			t.registerTranslationFailure("listener frontendValidation requires Kong Enterprise", gateway)
+		}
+		return
+	}
+
+	routesValidations := t.getGatewayRoutesFrontendTLSValidations(validations)
+	if len(routesValidations) == 0 {
+		return
+	}
+
+	for i, service := range result.Services {
+		if !slices.ContainsFunc(service.Routes, func(r kongstate.Route) bool {
+			_, ok := routesValidations[routeKeyForKongRoute(r)]
+			return ok
+		}) {
+			continue
+		}
+		// Routes are copied not to modify ones that may be shared with the translation cache.
+		routes := slices.Clone(service.Routes)
+		for j := range routes {
+			routeValidations, ok := routesValidations[routeKeyForKongRoute(routes[j])]
+			if !ok {
+				continue
+			}
+			var caCertificateIDs []string
+			for _, validation := range routeValidations {
+				for _, caCert := range validation.caCertificates {
+					caCertificateIDs = append(caCertificateIDs, addCACertificate(result, caCert))
+				}
+			}
+			routes[j].Plugins = append(slices.Clone(routes[j].Plugins), kong.Plugin{
+				Name: kong.String(mtlsAuthPluginName),
+				Config: kong.Configuration{
+					"ca_certificates":      lo.Uniq(caCertificateIDs),
+					"skip_consumer_lookup": true,
+				},
+				Tags: util.GenerateTagsForObject(routeValidations[0].gateway),
+			})
+		}
+		result.Services[i].Routes = routes
+	}
+}
+
+// getListenersFrontendTLSValidations returns CA certificates to validate client certificates with for programmed
+// HTTPS listeners with tls.frontendValidation. Listeners with unresolved CA certificate references are reported
+// as not programmed by the Gateway controller, but a translation failure is registered for them in case the status
+// is outdated.
+func (t *Translator) getListenersFrontendTLSValidations() map[listenerKey]frontendTLSValidation {
+	gateways, err := t.storer.ListGateways()
+	if err != nil {
+		t.logger.Error(err, "Failed to list Gateways")
+		return nil
+	}
+
+	validations := make(map[listenerKey]frontendTLSValidation)
+	for _, gateway := range gateways {
+		statuses := make(map[gatewayapi.SectionName]gatewayapi.ListenerStatus, len(gateway.Status.Listeners))
+		for _, status := range gateway.Status.Listeners {
+			statuses[status.Name] = status
+		}
+
+		for _, listener := range gateway.Spec.Listeners {
+			if listener.Protocol != gatewayapi.HTTPSProtocolType ||
+				listener.TLS == nil || listener.TLS.FrontendValidation == nil {
+				continue
+			}
+			status, ok := statuses[listener.Name]
+			if !ok || !util.CheckCondition(
+				status.Conditions,
+				util.ConditionType(gatewayapi.ListenerConditionProgrammed),
+				util.ConditionReason(gatewayapi.ListenerReasonProgrammed),
+				metav1.ConditionTrue,
+				gateway.Generation,
+			) {
+				continue
+			}
+
+			caCertificates, err := t.getListenerFrontendTLSValidationCACertificates(gateway, listener)
+			if err != nil {
+				t.registerTranslationFailure(fmt.Sprintf("invalid frontendValidation of listener '%s': %s",
+					listener.Name, err), gateway)
+				continue
+			}
+			validations[listenerKey{
+				gateway: k8stypes.NamespacedName{Namespace: gateway.Namespace, Name: gateway.Name},
+				name:    listener.Name,
+			}] = frontendTLSValidation{
+				gateway:        gateway,
+				caCertificates: caCertificates,
+			}
+		}
+	}
+	return validations
+}
+
+// getListenerFrontendTLSValidationCACertificates returns Kong CA certificates built from ConfigMaps and Secrets
+// referenced by the listener's tls.frontendValidation.
+func (t *Translator) getListenerFrontendTLSValidationCACertificates(
+	gateway *gatewayapi.Gateway,
+	listener gatewayapi.Listener,
+) ([]kong.CACertificate, error) {
+	refs := listener.TLS.FrontendValidation.CACertificateRefs
+	if len(refs) == 0 {
+		return nil, fmt.Errorf("no caCertificateRefs specified")
+	}
+
+	caCertificates := make([]kong.CACertificate, 0, len(refs))
+	for _, ref := range refs {
+		namespace := gateway.Namespace
+		if ref.Namespace != nil {
+			namespace = string(*ref.Namespace)
+		}
+
+		var (
+			obj  client.Object
+			data []byte
+		)
+		switch {
+		case (ref.Group == "" || ref.Group == "core") && ref.Kind == "ConfigMap":
+			configMap, err := t.storer.GetConfigMap(namespace, string(ref.Name))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get ConfigMap %s/%s: %w", namespace, ref.Name, err)
+			}
+			obj, data = configMap, []byte(configMap.Data[GatewayListenerCACertificateKey])
+		case (ref.Group == "" || ref.Group == "core") && ref.Kind == "Secret":
+			secret, err := t.storer.GetSecret(namespace, string(ref.Name))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get Secret %s/%s: %w", namespace, ref.Name, err)
+			}
+			obj, data = secret, secret.Data[GatewayListenerCACertificateKey]
+		default:
+			return nil, fmt.Errorf("unsupported caCertificateRef kind %s/%s", ref.Group, ref.Kind)
+		}
+
+		if len(data) == 0 {
+			return nil, fmt.Errorf("%s %s/%s is missing the %q key", ref.Kind, namespace, ref.Name, GatewayListenerCACertificateKey)
+		}
+		if err := validateCACertificate(data); err != nil {
+			return nil, fmt.Errorf("invalid CA certificate in %s %s/%s: %w", ref.Kind, namespace, ref.Name, err)
+		}
+
+		// The ID is derived from the referenced object to remain stable across translations.
+		id := uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("%s/%s/%s", ref.Kind, namespace, ref.Name)))
+		caCertificates = append(caCertificates, kong.CACertificate{
+			ID:   kong.String(id.String()),
+			Cert: kong.String(string(data)),
+			Tags: util.GenerateTagsForObject(obj),
+		})
+	}
+	return caCertificates, nil
+}
+
+// getGatewayRoutesFrontendTLSValidations returns frontend TLS validations of listeners that HTTPRoutes and GRPCRoutes
+// in the store are attached to, either by the listener's name (sectionName), its port or by the whole Gateway.
+func (t *Translator) getGatewayRoutesFrontendTLSValidations(
+	validations map[listenerKey]frontendTLSValidation,
+) map[gatewayRouteKey][]frontendTLSValidation {
+	routesValidations := make(map[gatewayRouteKey][]frontendTLSValidation)
+	add := func(route client.Object, parentRefs []gatewayapi.ParentReference) {
+		keys := attachedListenersWithFrontendTLSValidation(route.GetNamespace(), parentRefs, validations)
+		if len(keys) == 0 {
+			return
+		}
+		routesValidations[gatewayRouteKey{
+			kind:      route.GetObjectKind().GroupVersionKind().Kind,
+			namespace: route.GetNamespace(),
+			name:      route.GetName(),
+		}] = lo.Map(keys, func(k listenerKey, _ int) frontendTLSValidation { return validations[k] })
+	}
+
+	if httpRoutes, err := t.storer.ListHTTPRoutes(); err == nil {
+		for _, r := range httpRoutes {
+			add(r, r.Spec.ParentRefs)
+		}
+	}
+	if grpcRoutes, err := t.storer.ListGRPCRoutes(); err == nil {
+		for _, r := range grpcRoutes {
+			add(r, r.Spec.ParentRefs)
+		}
+	}
+	return routesValidations
+}
+
+// attachedListenersWithFrontendTLSValidation returns sorted keys of listeners with frontend TLS validation that
+// the parentRefs of a route in the given namespace attach the route to.
+func attachedListenersWithFrontendTLSValidation(
+	routeNamespace string,
+	parentRefs []gatewayapi.ParentReference,
+	validations map[listenerKey]frontendTLSValidation,
+) []listenerKey {
+	var keys []listenerKey
+	for _, ref := range parentRefs {
+		if ref.Group != nil && *ref.Group != gatewayapi.V1Group {
+			continue
+		}
+		if ref.Kind != nil && *ref.Kind != "Gateway" {
+			continue
+		}
+		gateway := k8stypes.NamespacedName{Namespace: routeNamespace, Name: string(ref.Name)}
+		if ref.Namespace != nil {
+			gateway.Namespace = string(*ref.Namespace)
+		}
+
+		for key, validation := range validations {
+			if key.gateway != gateway {
+				continue
+			}
+			if ref.SectionName != nil && *ref.SectionName != key.name {
+				continue
+			}
+			if ref.Port != nil && !lo.ContainsBy(validation.gateway.Spec.Listeners, func(l gatewayapi.Listener) bool {
+				return l.Name == key.name && l.Port == *ref.Port
+			}) {
+				continue
+			}
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	slices.SortFunc(keys, func(a, b listenerKey) int {
+		if c := compareNamespacedNames(a.gateway, b.gateway); c != 0 {
+			return c
+		}
+		return strings.Compare(string(a.name), string(b.name))
+	})
+	return keys
+}
+
+// uniqueGateways returns the Gateways of the listeners' frontend TLS validations, sorted by namespace and name.
+func uniqueGateways(validations map[listenerKey]frontendTLSValidation) []client.Object {
+	gateways := lo.UniqBy(lo.Values(validations), func(v frontendTLSValidation) k8stypes.NamespacedName {
+		return client.ObjectKeyFromObject(v.gateway)
+	})
+	slices.SortFunc(gateways, func(a, b frontendTLSValidation) int {
+		return compareNamespacedNames(client.ObjectKeyFromObject(a.gateway), client.ObjectKeyFromObject(b.gateway))
+	})
+	return lo.Map(gateways, func(v frontendTLSValidation, _ int) client.Object { return v.gateway })
+}
@@ -0,0 +1,203 @@
+package translator
+
+import (
+	"testing"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	"github.com/kong/kubernetes-ingress-controller/v3/test/helpers/certificate"
+)
+
+func TestApplyGatewayFrontendTLSValidation(t *testing.T) {
+	caCert, _ := certificate.MustGenerateSelfSignedCertPEMFormat(certificate.WithCATrue())
+
+	caConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ca",
+			Namespace: "default",
+		},
+		Data: map[string]string{GatewayListenerCACertificateKey: string(caCert)},
+	}
+	programmed := []metav1.Condition{
+		{
+			Type:   string(gatewayapi.ListenerConditionProgrammed),
+			Status: metav1.ConditionTrue,
+			Reason: string(gatewayapi.ListenerReasonProgrammed),
+		},
+	}
+	newGateway := func(caConfigMapName string, listenerConditions []metav1.Condition) *gatewayapi.Gateway {
+		return &gatewayapi.Gateway{
+			TypeMeta: gatewayapi.V1GatewayTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gateway",
+				Namespace: "default",
+			},
+			Spec: gatewayapi.GatewaySpec{
+				Listeners: []gatewayapi.Listener{
+					{
+						Name:     "https-mtls",
+						Protocol: gatewayapi.HTTPSProtocolType,
+						Port:     443,
+						TLS: &gatewayapi.GatewayTLSConfig{
+							FrontendValidation: &gatewayapi.FrontendTLSValidation{
+								CACertificateRefs: []gatewayapi.ObjectReference{
+									{Kind: "ConfigMap", Name: gatewayapi.ObjectName(caConfigMapName)},
+								},
+							},
+						},
+					},
+					{
+						Name:     "https",
+						Protocol: gatewayapi.HTTPSProtocolType,
+						Port:     8443,
+						TLS:      &gatewayapi.GatewayTLSConfig{},
+					},
+				},
+			},
+			Status: gatewayapi.GatewayStatus{
+				Listeners: []gatewayapi.ListenerStatus{
+					{Name: "https-mtls", Conditions: listenerConditions},
+					{Name: "https", Conditions: programmed},
+				},
+			},
+		}
+	}
+	newHTTPRoute := func(name string, parentRef gatewayapi.ParentReference) *gatewayapi.HTTPRoute {
+		return &gatewayapi.HTTPRoute{
+			TypeMeta: gatewayapi.V1HTTPRouteTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: gatewayapi.HTTPRouteSpec{
+				CommonRouteSpec: gatewayapi.CommonRouteSpec{
+					ParentRefs: []gatewayapi.ParentReference{parentRef},
+				},
+			},
+		}
+	}
+	httpRoutes := []*gatewayapi.HTTPRoute{
+		newHTTPRoute("by-section-name", gatewayapi.ParentReference{
+			Name: "gateway", SectionName: lo.ToPtr(gatewayapi.SectionName("https-mtls")),
+		}),
+		newHTTPRoute("by-port", gatewayapi.ParentReference{
+			Name: "gateway", Port: lo.ToPtr(gatewayapi.PortNumber(443)),
+		}),
+		newHTTPRoute("by-gateway", gatewayapi.ParentReference{Name: "gateway"}),
+		newHTTPRoute("other-listener", gatewayapi.ParentReference{
+			Name: "gateway", SectionName: lo.ToPtr(gatewayapi.SectionName("https")),
+		}),
+	}
+	newKongState := func() kongstate.KongState {
+		return kongstate.KongState{
+			Services: []kongstate.Service{
+				{
+					Service: kong.Service{Name: kong.String("svc")},
+					Routes: lo.Map(httpRoutes, func(r *gatewayapi.HTTPRoute, _ int) kongstate.Route {
+						return kongstate.Route{
+							Route:   kong.Route{Name: kong.String(r.Name)},
+							Ingress: util.FromK8sObject(r),
+						}
+					}),
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		gateway           *gatewayapi.Gateway
+		enterprise        bool
+		caCertificates    []kong.CACertificate
+		expectMTLSRoutes  []string
+		expectCACerts     int
+		expectFailures    int
+		expectFailureText string
+	}{
+		{
+			name:             "routes attached to the listener by its name, port or by the whole Gateway",
+			gateway:          newGateway("ca", programmed),
+			enterprise:       true,
+			expectMTLSRoutes: []string{"by-section-name", "by-port", "by-gateway"},
+			expectCACerts:    1,
+		},
+		{
+			name:       "CA certificate with the same digest already in the state is reused",
+			gateway:    newGateway("ca", programmed),
+			enterprise: true,
+			caCertificates: []kong.CACertificate{
+				{ID: kong.String("ca-cert-secret"), Cert: kong.String(string(caCert))},
+			},
+			expectMTLSRoutes: []string{"by-section-name", "by-port", "by-gateway"},
+			expectCACerts:    1,
+		},
+		{
+			name:    "listener not programmed",
+			gateway: newGateway("ca", nil),
+		},
+		{
+			name:              "missing CA certificate ConfigMap",
+			gateway:           newGateway("missing", programmed),
+			enterprise:        true,
+			expectFailures:    1,
+			expectFailureText: "invalid frontendValidation of listener 'https-mtls'",
+		},
+		{
+			name:              "Kong OSS",
+			gateway:           newGateway("ca", programmed),
+			expectFailures:    1,
+			expectFailureText: "listener frontendValidation requires Kong Enterprise",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := store.NewFakeStore(store.FakeObjects{
+				Gateways:   []*gatewayapi.Gateway{tc.gateway},
+				HTTPRoutes: httpRoutes,
+				ConfigMaps: []*corev1.ConfigMap{caConfigMap},
+			})
+			require.NoError(t, err)
+			translator := mustNewTranslator(t, s)
+			translator.featureFlags.EnterpriseEdition = tc.enterprise
+
+			state := newKongState()
+			state.CACertificates = tc.caCertificates
+			originalRoutes := state.Services[0].Routes
+			translator.applyGatewayFrontendTLSValidation(&state)
+
+			failures := translator.popTranslationFailures()
+			require.Len(t, failures, tc.expectFailures)
+			if tc.expectFailureText != "" {
+				assert.Contains(t, failures[0].Message(), tc.expectFailureText)
+			}
+			require.Len(t, state.CACertificates, tc.expectCACerts)
+			for _, route := range state.Services[0].Routes {
+				if !lo.Contains(tc.expectMTLSRoutes, *route.Name) {
+					assert.Empty(t, route.Plugins, "route %s should not require client certificates", *route.Name)
+					continue
+				}
+				require.Len(t, route.Plugins, 1, "route %s should require client certificates", *route.Name)
+				plugin := route.Plugins[0]
+				assert.Equal(t, "mtls-auth", *plugin.Name)
+				assert.Equal(t, []string{*state.CACertificates[0].ID}, plugin.Config["ca_certificates"])
+				assert.Equal(t, true, plugin.Config["skip_consumer_lookup"])
+			}
+			for _, route := range originalRoutes {
+				assert.Empty(t, route.Plugins, "original routes should not be modified")
+			}
+			for _, c := range state.CACertificates {
+				assert.Equal(t, string(caCert), *c.Cert)
+			}
+		})
+	}
+}
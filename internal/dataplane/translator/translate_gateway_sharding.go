@@ -0,0 +1,170 @@
+package translator
+
+import (
+	"slices"
+	"strings"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+)
+
+// gatewayRouteKey identifies a Gateway API route a Kong Route was translated from.
+type gatewayRouteKey struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// attributeRoutesToGateways fills Gateways of Kong Routes translated from Gateway API routes with the Gateways
+// the routes are attached to with their parentRefs.
+func (t *Translator) attributeRoutesToGateways(services []kongstate.Service) {
+	routesGateways := t.getGatewayRoutesParentGateways()
+	for i, service := range services {
+		if !slices.ContainsFunc(service.Routes, func(r kongstate.Route) bool {
+			_, ok := routesGateways[routeKeyForKongRoute(r)]
+			return ok
+		}) {
+			continue
+		}
+		// Routes are copied not to modify ones that may be shared with the translation cache.
+		routes := slices.Clone(service.Routes)
+		for j := range routes {
+			if gateways, ok := routesGateways[routeKeyForKongRoute(routes[j])]; ok {
+				routes[j].Gateways = gateways
+			}
+		}
+		services[i].Routes = routes
+	}
+}
+
+func routeKeyForKongRoute(r kongstate.Route) gatewayRouteKey {
+	return gatewayRouteKey{
+		kind:      r.Ingress.GroupVersionKind.Kind,
+		namespace: r.Ingress.Namespace,
+		name:      r.Ingress.Name,
+	}
+}
+
+// getGatewayRoutesParentGateways returns Gateways that Gateway API routes in the store are attached to.
+func (t *Translator) getGatewayRoutesParentGateways() map[gatewayRouteKey][]k8stypes.NamespacedName {
+	routesGateways := make(map[gatewayRouteKey][]k8stypes.NamespacedName)
+	add := func(route client.Object, parentRefs []gatewayapi.ParentReference) {
+		gateways := parentGateways(route.GetNamespace(), parentRefs)
+		if len(gateways) == 0 {
+			return
+		}
+		routesGateways[gatewayRouteKey{
+			kind:      route.GetObjectKind().GroupVersionKind().Kind,
+			namespace: route.GetNamespace(),
+			name:      route.GetName(),
+		}] = gateways
+	}
+
+	if httpRoutes, err := t.storer.ListHTTPRoutes(); err == nil {
+		for _, r := range httpRoutes {
+			add(r, r.Spec.ParentRefs)
+		}
+	}
+	if grpcRoutes, err := t.storer.ListGRPCRoutes(); err == nil {
+		for _, r := range grpcRoutes {
+			add(r, r.Spec.ParentRefs)
+		}
+	}
+	if tcpRoutes, err := t.storer.ListTCPRoutes(); err == nil {
+		for _, r := range tcpRoutes {
+			add(r, r.Spec.ParentRefs)
+		}
+	}
+	if udpRoutes, err := t.storer.ListUDPRoutes(); err == nil {
+		for _, r := range udpRoutes {
+			add(r, r.Spec.ParentRefs)
+		}
+	}
+	if tlsRoutes, err := t.storer.ListTLSRoutes(); err == nil {
+		for _, r := range tlsRoutes {
+			add(r, r.Spec.ParentRefs)
+		}
+	}
+	return routesGateways
+}
+
+// parentGateways returns sorted Gateways referred by the parentRefs of a route in the given namespace.
+func parentGateways(routeNamespace string, parentRefs []gatewayapi.ParentReference) []k8stypes.NamespacedName {
+	var gateways []k8stypes.NamespacedName
+	for _, ref := range parentRefs {
+		if ref.Group != nil && *ref.Group != gatewayapi.V1Group {
+			continue
+		}
+		if ref.Kind != nil && *ref.Kind != "Gateway" {
+			continue
+		}
+		gateway := k8stypes.NamespacedName{Namespace: routeNamespace, Name: string(ref.Name)}
+		if ref.Namespace != nil {
+			gateway.Namespace = string(*ref.Namespace)
+		}
+		if !slices.Contains(gateways, gateway) {
+			gateways = append(gateways, gateway)
+		}
+	}
+	slices.SortFunc(gateways, compareNamespacedNames)
+	return gateways
+}
+
+// getGatewaysPods returns pods backing publish Services of Gateways (see the konghq.com/publish-service annotation)
+// as found in the Services' EndpointSlices.
+func (t *Translator) getGatewaysPods() map[k8stypes.NamespacedName][]k8stypes.NamespacedName {
+	gateways, err := t.storer.ListGateways()
+	if err != nil {
+		t.logger.Error(err, "Failed to list Gateways")
+		return nil
+	}
+
+	gatewaysPods := make(map[k8stypes.NamespacedName][]k8stypes.NamespacedName, len(gateways))
+	for _, gateway := range gateways {
+		var pods []k8stypes.NamespacedName
+		for _, publishService := range annotations.ExtractGatewayPublishService(gateway.Annotations) {
+			namespace, name, ok := strings.Cut(publishService, "/")
+			if !ok {
+				continue
+			}
+			endpointSlices, err := t.storer.GetEndpointSlicesForService(namespace, name)
+			if err != nil {
+				continue
+			}
+			for _, pod := range podsOfEndpointSlices(endpointSlices) {
+				if !slices.Contains(pods, pod) {
+					pods = append(pods, pod)
+				}
+			}
+		}
+		slices.SortFunc(pods, compareNamespacedNames)
+		gatewaysPods[k8stypes.NamespacedName{Namespace: gateway.Namespace, Name: gateway.Name}] = pods
+	}
+	return gatewaysPods
+}
+
+func podsOfEndpointSlices(endpointSlices []*discoveryv1.EndpointSlice) []k8stypes.NamespacedName {
+	var pods []k8stypes.NamespacedName
+	for _, endpointSlice := range endpointSlices {
+		for _, endpoint := range endpointSlice.Endpoints {
+			if endpoint.TargetRef == nil || endpoint.TargetRef.Kind != "Pod" {
+				continue
+			}
+			pod := k8stypes.NamespacedName{Namespace: endpoint.TargetRef.Namespace, Name: endpoint.TargetRef.Name}
+			if pod.Namespace == "" {
+				pod.Namespace = endpointSlice.Namespace
+			}
+			pods = append(pods, pod)
+		}
+	}
+	return pods
+}
+
+func compareNamespacedNames(a, b k8stypes.NamespacedName) int {
+	return strings.Compare(a.String(), b.String())
+}
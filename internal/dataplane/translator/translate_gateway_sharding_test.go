@@ -0,0 +1,169 @@
+package translator
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	"github.com/kong/kubernetes-ingress-controller/v3/test/helpers/certificate"
+)
+
+func TestTranslator_AttributeRoutesToGateways(t *testing.T) {
+	httpRoute := &gatewayapi.HTTPRoute{
+		TypeMeta: gatewayapi.V1HTTPRouteTypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "httproute",
+			Namespace: "default",
+		},
+		Spec: gatewayapi.HTTPRouteSpec{
+			CommonRouteSpec: gatewayapi.CommonRouteSpec{
+				ParentRefs: []gatewayapi.ParentReference{
+					{Name: "gateway-b"},
+					{Name: "gateway-a", Namespace: lo.ToPtr(gatewayapi.Namespace("other"))},
+					{Name: "gateway-b", SectionName: lo.ToPtr(gatewayapi.SectionName("http"))},
+					{Name: "not-a-gateway", Kind: lo.ToPtr(gatewayapi.Kind("Service")), Group: lo.ToPtr(gatewayapi.Group(""))},
+				},
+			},
+		},
+	}
+	s, err := store.NewFakeStore(store.FakeObjects{
+		HTTPRoutes: []*gatewayapi.HTTPRoute{httpRoute},
+	})
+	require.NoError(t, err)
+	translator := mustNewTranslator(t, s)
+
+	services := []kongstate.Service{
+		{
+			Service: kong.Service{Name: kong.String("svc")},
+			Routes: []kongstate.Route{
+				{
+					Route:   kong.Route{Name: kong.String("httproute")},
+					Ingress: util.FromK8sObject(httpRoute),
+				},
+				{
+					Route: kong.Route{Name: kong.String("ingress")},
+					Ingress: util.K8sObjectInfo{
+						Name:             "httproute",
+						Namespace:        "default",
+						GroupVersionKind: netv1.SchemeGroupVersion.WithKind("Ingress"),
+					},
+				},
+			},
+		},
+	}
+	originalRoutes := services[0].Routes
+	translator.attributeRoutesToGateways(services)
+
+	require.Equal(t, []k8stypes.NamespacedName{
+		{Namespace: "default", Name: "gateway-b"},
+		{Namespace: "other", Name: "gateway-a"},
+	}, services[0].Routes[0].Gateways)
+	require.Empty(t, services[0].Routes[1].Gateways, "routes not translated from Gateway API routes should not be attributed")
+	require.Empty(t, originalRoutes[0].Gateways, "original routes should not be modified")
+}
+
+func TestTranslator_GetGatewaysPods(t *testing.T) {
+	s, err := store.NewFakeStore(store.FakeObjects{
+		Gateways: []*gatewayapi.Gateway{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gateway-a",
+					Namespace: "default",
+					Annotations: map[string]string{
+						annotations.AnnotationPrefix + annotations.GatewayPublishServiceKey: "kong/proxy-a,kong/proxy-a-udp",
+					},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gateway-no-publish-service",
+					Namespace: "default",
+				},
+			},
+		},
+		EndpointSlices: []*discoveryv1.EndpointSlice{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "proxy-a-1",
+					Namespace: "kong",
+					Labels:    map[string]string{discoveryv1.LabelServiceName: "proxy-a"},
+				},
+				Endpoints: []discoveryv1.Endpoint{
+					{TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "pod-2", Namespace: "kong"}},
+					{TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "pod-1"}},
+					{TargetRef: &corev1.ObjectReference{Kind: "Node", Name: "node"}},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "proxy-a-udp-1",
+					Namespace: "kong",
+					Labels:    map[string]string{discoveryv1.LabelServiceName: "proxy-a-udp"},
+				},
+				Endpoints: []discoveryv1.Endpoint{
+					{TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "pod-1", Namespace: "kong"}},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	translator := mustNewTranslator(t, s)
+
+	require.Equal(t, map[k8stypes.NamespacedName][]k8stypes.NamespacedName{
+		{Namespace: "default", Name: "gateway-a"}: {
+			{Namespace: "kong", Name: "pod-1"},
+			{Namespace: "kong", Name: "pod-2"},
+		},
+		{Namespace: "default", Name: "gateway-no-publish-service"}: nil,
+	}, translator.getGatewaysPods())
+}
+
+func TestMergeCerts_GatewaysAttribution(t *testing.T) {
+	crt1, key1 := certificate.MustGenerateSelfSignedCertPEMFormat(certificate.WithCommonName("foo.com"))
+	crt2, key2 := certificate.MustGenerateSelfSignedCertPEMFormat(certificate.WithCommonName("bar.com"))
+	var (
+		gatewayA = k8stypes.NamespacedName{Namespace: "default", Name: "gateway-a"}
+		gatewayB = k8stypes.NamespacedName{Namespace: "default", Name: "gateway-b"}
+	)
+	newCertWrapper := func(id string, crt, key []byte, gateways ...k8stypes.NamespacedName) certWrapper {
+		return certWrapper{
+			identifier: string(crt) + string(key),
+			cert: kong.Certificate{
+				ID:   kong.String(id),
+				Cert: kong.String(string(crt)),
+				Key:  kong.String(string(key)),
+			},
+			snis:     []string{id + ".com"},
+			gateways: gateways,
+		}
+	}
+
+	mergedCerts, _ := mergeCerts(logr.Discard(),
+		[]certWrapper{newCertWrapper("ingress", crt2, key2)},
+		[]certWrapper{
+			newCertWrapper("gateway-b", crt1, key1, gatewayB),
+			newCertWrapper("gateway-a", crt1, key1, gatewayA),
+			newCertWrapper("gateway-a-shared", crt2, key2, gatewayA),
+		},
+	)
+	mergedCertsGateways := lo.SliceToMap(mergedCerts, func(c kongstate.Certificate) (string, []k8stypes.NamespacedName) {
+		return *c.Cert, c.Gateways
+	})
+	require.Equal(t, map[string][]k8stypes.NamespacedName{
+		string(crt1): {gatewayA, gatewayB},
+		string(crt2): nil,
+	}, mergedCertsGateways, "certificates shared with Ingresses should not be attributed to Gateways")
+}
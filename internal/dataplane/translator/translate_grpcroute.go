@@ -14,13 +14,17 @@ import (
 // ingressRulesFromGRPCRoutes processes a list of GRPCRoute objects and translates
 // then into Kong configuration objects.
 func (t *Translator) ingressRulesFromGRPCRoutes() ingressRules {
-	result := newIngressRules()
-
 	grpcRouteList, err := t.storer.ListGRPCRoutes()
 	if err != nil {
 		t.logger.Error(err, "Failed to list GRPCRoutes")
-		return result
+		return newIngressRules()
 	}
+	return t.ingressRulesFromGRPCRouteList(grpcRouteList)
+}
+
+// ingressRulesFromGRPCRouteList translates the given GRPCRoutes into Kong configuration objects.
+func (t *Translator) ingressRulesFromGRPCRouteList(grpcRouteList []*gatewayapi.GRPCRoute) ingressRules {
+	result := newIngressRules()
 
 	if t.featureFlags.ExpressionRoutes {
 		t.ingressRulesFromGRPCRoutesUsingExpressionRoutes(grpcRouteList, &result)
@@ -55,7 +59,7 @@ func (t *Translator) ingressRulesFromGRPCRoute(result *ingressRules, grpcroute *
 	for ruleNumber, rule := range spec.Rules {
 		// Create a service and attach the routes to it.
 		service, err := generateKongServiceFromBackendRefWithRuleNumber(
-			t.logger, t.storer, result, grpcroute, ruleNumber, t.getProtocolForKongService(grpcroute), grpcBackendRefsToBackendRefs(rule.BackendRefs)...,
+			t.logger, t.storer, t.featureFlags, result, grpcroute, ruleNumber, t.getProtocolForKongService(grpcroute), grpcBackendRefsToBackendRefs(rule.BackendRefs)...,
 		)
 		if err != nil {
 			return err
@@ -119,6 +123,7 @@ func (t *Translator) ingressRulesFromGRPCRouteWithPriority(
 	kongService, _ := generateKongServiceFromBackendRefWithName(
 		t.logger,
 		t.storer,
+		t.featureFlags,
 		rules,
 		serviceName,
 		grpcRoute,
@@ -3,6 +3,7 @@ package translator
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/samber/lo"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
@@ -23,16 +25,24 @@ import (
 // ingressRulesFromHTTPRoutes processes a list of HTTPRoute objects and translates
 // then into Kong configuration objects.
 func (t *Translator) ingressRulesFromHTTPRoutes() ingressRules {
-	result := newIngressRules()
-
 	httpRouteList, err := t.storer.ListHTTPRoutes()
 	if err != nil {
 		t.logger.Error(err, "Failed to list HTTPRoutes")
-		return result
+		return newIngressRules()
 	}
+	return t.ingressRulesFromHTTPRouteList(httpRouteList)
+}
+
+// ingressRulesFromHTTPRouteList translates the given HTTPRoutes into Kong configuration objects.
+func (t *Translator) ingressRulesFromHTTPRouteList(httpRouteList []*gatewayapi.HTTPRoute) ingressRules {
+	result := newIngressRules()
 
 	httpRoutesToTranslate := make([]*gatewayapi.HTTPRoute, 0, len(httpRouteList))
 	for _, httproute := range httpRouteList {
+		// RequestMirror filters targeting backends that can't be resolved are dropped, the rest of the route
+		// is still translated.
+		httproute = t.dropUnresolvableRequestMirrorFilters(httproute)
+
 		// Validate each HTTPRoute before translating and register translation failures if an HTTPRoute is invalid.
 		if err := validateHTTPRoute(httproute, t.featureFlags); err != nil {
 			t.registerTranslationFailure(fmt.Sprintf("HTTPRoute can't be routed: %v", err), httproute)
@@ -69,7 +79,7 @@ func (t *Translator) ingressRulesFromHTTPRoute(result *ingressRules, httproute *
 		serviceName := kongServiceTranslation.Name
 
 		// create a service and attach the routes to it
-		service, err := generateKongServiceFromBackendRefWithName(t.logger, t.storer, result, serviceName, httproute, "http", backendRefs...)
+		service, err := generateKongServiceFromBackendRefWithName(t.logger, t.storer, t.featureFlags, result, serviceName, httproute, "http", backendRefs...)
 		if err != nil {
 			return err
 		}
@@ -82,56 +92,130 @@ func (t *Translator) ingressRulesFromHTTPRoute(result *ingressRules, httproute *
 			}
 			service.Routes = append(service.Routes, routes...)
 		}
+		applyTimeoutsToService(&service, kongServiceTranslation.Timeouts)
+		applyRetriesToService(&service, httproute)
+		service.SessionPersistence = kongServiceTranslation.SessionPersistence
 
 		// cache the service to avoid duplicates in further loop iterations
 		result.ServiceNameToServices[*service.Service.Name] = service
 		result.ServiceNameToParent[serviceName] = httproute
 	}
-	applyTimeoutsToService(httproute, result)
 	return nil
 }
 
-// applyTimeoutsToService applies timeouts from HTTPRoute to the service.
-// If the HTTPRoute has multiple rules, the timeout from the last rule which has specific timeout will be applied to the service.
-// If the HTTPRoute has multiple rules and the first rule doesn't have timeout, the default timeout will be applied to the service.
-func applyTimeoutsToService(httpRoute *gatewayapi.HTTPRoute, rules *ingressRules) {
-	// If the HTTPRoute doesn't have rules, we don't need to apply timeouts to the service.
-	if httpRoute.Spec.Rules == nil {
+// applyTimeoutsToService applies timeouts of the HTTPRoute rules translated into the service to the service.
+// Due to only one field being available in the Gateway API to control this behavior, when users set
+// `spec.rules[].timeouts` in HTTPRoute, KIC sets ReadTimeout, WriteTimeout and ConnectTimeout for the service
+// to this value: https://github.com/Kong/kubernetes-ingress-controller/issues/4914#issuecomment-1813964669
+// As Kong has no timeout for the whole request, BackendRequest is used if set and Request otherwise.
+func applyTimeoutsToService(service *kongstate.Service, timeouts *gatewayapi.HTTPRouteTimeouts) {
+	if timeouts == nil {
 		return
 	}
 
-	backendRequestTimeout := DefaultServiceTimeout
-	for _, rule := range httpRoute.Spec.Rules {
-		if rule.Timeouts != nil && rule.Timeouts.BackendRequest != nil {
-			duration, err := time.ParseDuration(string(*rule.Timeouts.BackendRequest))
-			// We ignore the error here because the rule.Timeouts.BackendRequest is validated
-			// to be a strict subset of Golang time.ParseDuration so it should never happen
-			if err != nil {
-				continue
-			}
-			backendRequestTimeout = int(duration.Milliseconds())
-		}
+	timeout := timeouts.BackendRequest
+	if timeout == nil {
+		timeout = timeouts.Request
+	}
+	if timeout == nil {
+		return
+	}
+
+	duration, err := time.ParseDuration(string(*timeout))
+	// We ignore the error here because the timeouts are validated to be a strict
+	// subset of Golang time.ParseDuration so it should never happen.
+	if err != nil {
+		return
+	}
+	timeoutMs := int(duration.Milliseconds())
+	// The zero duration disables the timeout which Kong doesn't support, so the maximum value is used.
+	if timeoutMs == 0 {
+		timeoutMs = MaxServiceTimeout
+	}
+
+	service.Service.ReadTimeout = kong.Int(timeoutMs)
+	service.Service.ConnectTimeout = kong.Int(timeoutMs)
+	service.Service.WriteTimeout = kong.Int(timeoutMs)
+}
+
+// HTTPRouteRetries returns the number of retries set for the HTTPRoute's Kong services with the konghq.com/retries
+// annotation, nil if it's not set. The annotation sets only the Kong service retries, which apply to connection
+// errors and timeouts. Retrying on response status codes and backoff between retries (HTTPRouteRetry) are not
+// supported, because the field is not available in Gateway API v1.1.0 used by the controller.
+func HTTPRouteRetries(httproute *gatewayapi.HTTPRoute) (*int, error) {
+	value, ok := annotations.ExtractRetries(httproute.Annotations)
+	if !ok {
+		return nil, nil
+	}
+	retries, err := strconv.Atoi(value)
+	if err != nil || retries < 0 || retries > MaxServiceRetries {
+		return nil, fmt.Errorf("%s annotation must be an integer between 0 and %d, got %q",
+			annotations.AnnotationPrefix+annotations.RetriesKey, MaxServiceRetries, value)
 	}
+	return &retries, nil
+}
 
-	// if the backendRequestTimeout is the same as the default timeout, we don't need to apply it to the service.
-	if backendRequestTimeout == DefaultServiceTimeout {
+// applyRetriesToService applies retries set for the HTTPRoute to the service. As Kong retries only connection
+// failures and timeouts on a service level, retrying on specific response codes or with a backoff is not supported.
+// The konghq.com/retries annotation of the backend Services, applied later on, takes precedence.
+func applyRetriesToService(service *kongstate.Service, httproute *gatewayapi.HTTPRoute) {
+	// We ignore the error here because HTTPRoutes with invalid retries are not translated.
+	retries, err := HTTPRouteRetries(httproute)
+	if err != nil || retries == nil {
 		return
 	}
+	service.Service.Retries = kong.Int(*retries)
+}
+
+// dropUnresolvableRequestMirrorFilters returns an HTTPRoute without RequestMirror filters whose backendRefs
+// can't be resolved (i.e. the referenced backend doesn't exist, is of an unsupported kind or is not permitted by
+// a ReferenceGrant). If all the filters can be resolved, the HTTPRoute is returned unchanged, otherwise a copy
+// is returned.
+func (t *Translator) dropUnresolvableRequestMirrorFilters(httproute *gatewayapi.HTTPRoute) *gatewayapi.HTTPRoute {
+	hasRequestMirrorFilter := lo.ContainsBy(httproute.Spec.Rules, func(rule gatewayapi.HTTPRouteRule) bool {
+		return lo.ContainsBy(rule.Filters, isRequestMirrorFilter)
+	})
+	if !hasRequestMirrorFilter {
+		return httproute
+	}
 
-	// due rules.ServiceNameToServices is a map, we need to iterate over the map to find the service
-	// which has the same parent as the HTTPRoute.
-	for serviceName, service := range rules.ServiceNameToServices {
-		if service.Parent.GetObjectKind() == httpRoute.GetObjectKind() && service.Parent.GetName() == httpRoute.Name && service.Parent.GetNamespace() == httpRoute.Namespace {
-			// Due to only one field being available in the Gateway API to control this behavior,
-			// when users set `spec.rules[].timeouts` in HTTPRoute,
-			// KIC will also set ReadTimeout, WriteTimeout and ConnectTimeout for the service to this value
-			// https://github.com/Kong/kubernetes-ingress-controller/issues/4914#issuecomment-1813964669
-			service.Service.ReadTimeout = kong.Int(backendRequestTimeout)
-			service.Service.ConnectTimeout = kong.Int(backendRequestTimeout)
-			service.Service.WriteTimeout = kong.Int(backendRequestTimeout)
-			rules.ServiceNameToServices[serviceName] = service
+	grants, err := t.storer.ListReferenceGrants()
+	if err != nil {
+		t.logger.Error(err, "Failed to list ReferenceGrants, skipping RequestMirror filters resolution")
+		return httproute
+	}
+	allowed := GetPermittedForReferenceGrantFrom(gatewayapi.ReferenceGrantFrom{
+		Group:     gatewayapi.Group(httproute.GetObjectKind().GroupVersionKind().Group),
+		Kind:      gatewayapi.Kind(httproute.GetObjectKind().GroupVersionKind().Kind),
+		Namespace: gatewayapi.Namespace(httproute.GetNamespace()),
+	}, grants)
+
+	var resolved *gatewayapi.HTTPRoute
+	for ruleIdx, rule := range httproute.Spec.Rules {
+		filters := lo.Filter(rule.Filters, func(filter gatewayapi.HTTPRouteFilter, _ int) bool {
+			if !isRequestMirrorFilter(filter) || filter.RequestMirror == nil {
+				return true
+			}
+			backendRef := gatewayapi.BackendRef{BackendObjectReference: filter.RequestMirror.BackendRef}
+			return len(backendRefsToKongStateBackends(t.logger, t.storer, t.featureFlags, httproute, []gatewayapi.BackendRef{backendRef}, allowed)) > 0
+		})
+		if len(filters) == len(rule.Filters) {
+			continue
+		}
+		if resolved == nil {
+			resolved = httproute.DeepCopy()
 		}
+		resolved.Spec.Rules[ruleIdx].Filters = filters
 	}
+
+	if resolved == nil {
+		return httproute
+	}
+	return resolved
+}
+
+func isRequestMirrorFilter(filter gatewayapi.HTTPRouteFilter) bool {
+	return filter.Type == gatewayapi.HTTPRouteFilterRequestMirror
 }
 
 func validateHTTPRoute(httproute *gatewayapi.HTTPRoute, featureFlags FeatureFlags) error {
@@ -145,6 +229,10 @@ func validateHTTPRoute(httproute *gatewayapi.HTTPRoute, featureFlags FeatureFlag
 		return subtranslator.ErrRouteValidationNoRules
 	}
 
+	if _, err := HTTPRouteRetries(httproute); err != nil {
+		return err
+	}
+
 	// Kong supports query parameter match only with expression router,
 	// so we return error when query param match is specified and expression router is not enabled in the translator.
 	if !featureFlags.ExpressionRoutes {
@@ -193,9 +281,7 @@ func (t *Translator) ingressRulesFromHTTPRoutesUsingExpressionRoutes(httpRoutes
 			Namespace: httproute.Namespace,
 			Name:      httproute.Name,
 		}
-		if translationFailures, ok := httpRouteNameToTranslationFailure[nsName]; !ok {
-			applyTimeoutsToService(httproute, result)
-		} else {
+		if translationFailures, ok := httpRouteNameToTranslationFailure[nsName]; ok {
 			t.registerTranslationFailure(
 				fmt.Sprintf("HTTPRoute can't be routed: %v", errors.Join(translationFailures...)),
 				httproute,
@@ -488,6 +574,7 @@ func (t *Translator) ingressRulesFromSplitHTTPRouteMatchWithPriority(
 	kongService, err := generateKongServiceFromBackendRefWithName(
 		t.logger,
 		t.storer,
+		t.featureFlags,
 		rules,
 		serviceName,
 		httpRoute,
@@ -507,6 +594,9 @@ func (t *Translator) ingressRulesFromSplitHTTPRouteMatchWithPriority(
 		kongService.Routes,
 		*additionalRoutes,
 	)
+	applyTimeoutsToService(&kongService, rule.Timeouts)
+	applyRetriesToService(&kongService, httpRoute)
+	kongService.SessionPersistence = rule.SessionPersistence
 	// cache the service to avoid duplicates in further loop iterations
 	rules.ServiceNameToServices[serviceName] = kongService
 	rules.ServiceNameToParent[serviceName] = httpRoute
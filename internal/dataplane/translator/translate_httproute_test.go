@@ -1,6 +1,8 @@
 package translator
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/go-logr/zapr"
@@ -16,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
@@ -1647,6 +1650,200 @@ func TestGetHTTPRouteHostnamesAsSliceOfStringPointers(t *testing.T) {
 	}
 }
 
+func TestApplyTimeoutsToService(t *testing.T) {
+	testCases := []struct {
+		name            string
+		timeouts        *gatewayapi.HTTPRouteTimeouts
+		expectedTimeout *int
+	}{
+		{
+			name: "no timeouts",
+		},
+		{
+			name: "backend request timeout",
+			timeouts: &gatewayapi.HTTPRouteTimeouts{
+				BackendRequest: lo.ToPtr(gatewayapi.Duration("500ms")),
+			},
+			expectedTimeout: lo.ToPtr(500),
+		},
+		{
+			name: "backend request timeout takes precedence over request timeout",
+			timeouts: &gatewayapi.HTTPRouteTimeouts{
+				Request:        lo.ToPtr(gatewayapi.Duration("10s")),
+				BackendRequest: lo.ToPtr(gatewayapi.Duration("2s")),
+			},
+			expectedTimeout: lo.ToPtr(2000),
+		},
+		{
+			name: "request timeout is used when backend request timeout is not set",
+			timeouts: &gatewayapi.HTTPRouteTimeouts{
+				Request: lo.ToPtr(gatewayapi.Duration("1m")),
+			},
+			expectedTimeout: lo.ToPtr(60000),
+		},
+		{
+			name: "zero duration disables the timeout",
+			timeouts: &gatewayapi.HTTPRouteTimeouts{
+				BackendRequest: lo.ToPtr(gatewayapi.Duration("0s")),
+			},
+			expectedTimeout: lo.ToPtr(MaxServiceTimeout),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := kongstate.Service{}
+			applyTimeoutsToService(&service, tc.timeouts)
+			assert.Equal(t, tc.expectedTimeout, service.ReadTimeout)
+			assert.Equal(t, tc.expectedTimeout, service.WriteTimeout)
+			assert.Equal(t, tc.expectedTimeout, service.ConnectTimeout)
+		})
+	}
+}
+
+func TestApplyRetriesToService(t *testing.T) {
+	testCases := []struct {
+		name            string
+		annotations     map[string]string
+		expectedRetries *int
+	}{
+		{
+			name: "no retries annotation",
+		},
+		{
+			name: "retries annotation",
+			annotations: map[string]string{
+				annotations.AnnotationPrefix + annotations.RetriesKey: "3",
+			},
+			expectedRetries: lo.ToPtr(3),
+		},
+		{
+			name: "zero retries",
+			annotations: map[string]string{
+				annotations.AnnotationPrefix + annotations.RetriesKey: "0",
+			},
+			expectedRetries: lo.ToPtr(0),
+		},
+		{
+			name: "invalid retries annotation is ignored",
+			annotations: map[string]string{
+				annotations.AnnotationPrefix + annotations.RetriesKey: "-1",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := kongstate.Service{}
+			applyRetriesToService(&service, &gatewayapi.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations},
+			})
+			assert.Equal(t, tc.expectedRetries, service.Retries)
+		})
+	}
+}
+
+func TestIngressRulesFromHTTPRoutes_RulesWithDifferentTimeouts(t *testing.T) {
+	httpRoute := &gatewayapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic-httproute",
+			Namespace: corev1.NamespaceDefault,
+		},
+		Spec: gatewayapi.HTTPRouteSpec{
+			CommonRouteSpec: commonRouteSpecMock("fake-gateway-1"),
+			Rules: []gatewayapi.HTTPRouteRule{
+				{
+					Matches: []gatewayapi.HTTPRouteMatch{
+						builder.NewHTTPRouteMatch().WithPathPrefix("/fast").Build(),
+					},
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("fake-service").WithPort(80).Build(),
+					},
+					Timeouts: &gatewayapi.HTTPRouteTimeouts{
+						BackendRequest: lo.ToPtr(gatewayapi.Duration("500ms")),
+					},
+				},
+				{
+					Matches: []gatewayapi.HTTPRouteMatch{
+						builder.NewHTTPRouteMatch().WithPathPrefix("/slow").Build(),
+					},
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("fake-service").WithPort(80).Build(),
+					},
+					Timeouts: &gatewayapi.HTTPRouteTimeouts{
+						BackendRequest: lo.ToPtr(gatewayapi.Duration("5s")),
+					},
+				},
+				{
+					Matches: []gatewayapi.HTTPRouteMatch{
+						builder.NewHTTPRouteMatch().WithPathPrefix("/also-fast").Build(),
+					},
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("fake-service").WithPort(80).Build(),
+					},
+					Timeouts: &gatewayapi.HTTPRouteTimeouts{
+						BackendRequest: lo.ToPtr(gatewayapi.Duration("500ms")),
+					},
+				},
+				{
+					Matches: []gatewayapi.HTTPRouteMatch{
+						builder.NewHTTPRouteMatch().WithPathPrefix("/default").Build(),
+					},
+					BackendRefs: []gatewayapi.HTTPBackendRef{
+						builder.NewHTTPBackendRef("fake-service").WithPort(80).Build(),
+					},
+				},
+			},
+		},
+	}
+	httpRoute.SetGroupVersionKind(httprouteGVK)
+
+	fakestore, err := store.NewFakeStore(store.FakeObjects{
+		HTTPRoutes: []*gatewayapi.HTTPRoute{httpRoute},
+		Services: []*corev1.Service{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: corev1.NamespaceDefault,
+					Name:      "fake-service",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	translator := mustNewTranslator(t, fakestore)
+
+	for _, expressionRoutes := range []bool{false, true} {
+		t.Run(fmt.Sprintf("expression routes: %t", expressionRoutes), func(t *testing.T) {
+			translator.featureFlags.ExpressionRoutes = expressionRoutes
+			result := translator.ingressRulesFromHTTPRoutes()
+
+			// Maps the paths of the routes to the timeout of the service they are attached to.
+			pathToTimeout := make(map[string]int)
+			for _, service := range result.ServiceNameToServices {
+				require.NotNil(t, service.ReadTimeout, "service %s should have timeouts set", *service.Name)
+				for _, route := range service.Routes {
+					for _, path := range []string{"/fast", "/slow", "/also-fast", "/default"} {
+						if lo.ContainsBy(route.Paths, func(p *string) bool { return strings.HasPrefix(*p, path) }) ||
+							(route.Expression != nil && strings.Contains(*route.Expression, `"`+path)) {
+							pathToTimeout[path] = *service.ReadTimeout
+						}
+					}
+				}
+			}
+			assert.Equal(t, map[string]int{
+				"/fast":      500,
+				"/slow":      5000,
+				"/also-fast": 500,
+				"/default":   DefaultServiceTimeout,
+			}, pathToTimeout)
+
+			if !expressionRoutes {
+				assert.Len(t, result.ServiceNameToServices, 3, "rules with the same timeouts should be combined into a single service")
+			}
+		})
+	}
+}
+
 func TestIngressRulesFromHTTPRoutes_RegexPrefix(t *testing.T) {
 	for _, tt := range []testCaseIngressRulesFromHTTPRoutes{
 		{
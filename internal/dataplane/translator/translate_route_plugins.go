@@ -0,0 +1,73 @@
+package translator
+
+import (
+	"fmt"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+)
+
+// routePluginKey identifies a plugin of a given name configured on a Kong Route.
+type routePluginKey struct {
+	route  string
+	plugin string
+}
+
+// resolveRoutePluginsConflicts drops plugins attached with KongPlugins to Kong Routes that already have a plugin
+// of the same name generated by the translator (e.g. a pre-function plugin generated from HTTPRoute's RequestMirror
+// filters or an mtls-auth plugin generated from Gateway listener's frontendValidation). Kong accepts only a single
+// plugin of a given name per Route, so the conflict would otherwise make Kong reject the whole configuration.
+// Generated plugins take precedence as they implement the routes' specification. The conflict is reported as
+// a translation failure of both the route and the KongPlugin.
+func (t *Translator) resolveRoutePluginsConflicts(result *kongstate.KongState) {
+	generated := make(map[routePluginKey]kongstate.Route)
+	for _, service := range result.Services {
+		for _, route := range service.Routes {
+			if route.Name == nil {
+				continue
+			}
+			for _, plugin := range route.Plugins {
+				if plugin.Name != nil {
+					generated[routePluginKey{route: *route.Name, plugin: *plugin.Name}] = route
+				}
+			}
+		}
+	}
+	if len(generated) == 0 {
+		return
+	}
+
+	result.Plugins = slices.DeleteFunc(slices.Clone(result.Plugins), func(p kongstate.Plugin) bool {
+		if p.Name == nil || p.Route == nil || p.Route.ID == nil ||
+			p.Service != nil || p.Consumer != nil || p.ConsumerGroup != nil {
+			return false
+		}
+		route, ok := generated[routePluginKey{route: *p.Route.ID, plugin: *p.Name}]
+		if !ok {
+			return false
+		}
+		t.registerTranslationFailure(
+			fmt.Sprintf("plugin %s conflicts with the %s plugin generated for route %s, ignoring it",
+				p.K8sParent.GetName(), *p.Name, *route.Name),
+			routeParentObject(route), p.K8sParent,
+		)
+		return true
+	})
+}
+
+// routeParentObject returns an object identifying the Kubernetes object the Kong Route was translated from,
+// to be used as a causing object of translation failures.
+func routeParentObject(route kongstate.Route) *metav1.PartialObjectMetadata {
+	return &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       route.Ingress.GroupVersionKind.Kind,
+			APIVersion: route.Ingress.GroupVersionKind.GroupVersion().String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: route.Ingress.Namespace,
+			Name:      route.Ingress.Name,
+		},
+	}
+}
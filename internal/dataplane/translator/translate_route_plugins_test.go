@@ -0,0 +1,216 @@
+package translator
+
+import (
+	"testing"
+
+	"github.com/kong/go-kong/kong"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+	kongv1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1"
+	"github.com/kong/kubernetes-ingress-controller/v3/test/helpers/certificate"
+)
+
+func TestResolveRoutePluginsConflicts(t *testing.T) {
+	httpRoute := &gatewayapi.HTTPRoute{
+		TypeMeta: gatewayapi.V1HTTPRouteTypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route",
+			Namespace: "default",
+		},
+	}
+	kongPlugin := &kongv1.KongPlugin{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kongv1.GroupVersion.String(),
+			Kind:       "KongPlugin",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pre-function",
+			Namespace: "default",
+		},
+		PluginName: "pre-function",
+	}
+	newPlugin := func(name string, route string) kongstate.Plugin {
+		return kongstate.Plugin{
+			Plugin: kong.Plugin{
+				Name:  kong.String(name),
+				Route: &kong.Route{ID: kong.String(route)},
+			},
+			K8sParent: kongPlugin,
+		}
+	}
+
+	s, err := store.NewFakeStore(store.FakeObjects{})
+	require.NoError(t, err)
+	translator := mustNewTranslator(t, s)
+
+	state := kongstate.KongState{
+		Services: []kongstate.Service{
+			{
+				Service: kong.Service{Name: kong.String("svc")},
+				Routes: []kongstate.Route{
+					{
+						Route:   kong.Route{Name: kong.String("mirrored")},
+						Ingress: util.FromK8sObject(httpRoute),
+						Plugins: []kong.Plugin{{Name: kong.String("pre-function")}},
+					},
+					{
+						Route:   kong.Route{Name: kong.String("plain")},
+						Ingress: util.FromK8sObject(httpRoute),
+					},
+				},
+			},
+		},
+		Plugins: []kongstate.Plugin{
+			newPlugin("pre-function", "mirrored"),
+			newPlugin("pre-function", "plain"),
+			newPlugin("cors", "mirrored"),
+		},
+	}
+	translator.resolveRoutePluginsConflicts(&state)
+
+	require.Len(t, state.Plugins, 2)
+	assert.Equal(t, "plain", *state.Plugins[0].Route.ID)
+	assert.Equal(t, "cors", *state.Plugins[1].Name)
+
+	failures := translator.popTranslationFailures()
+	require.Len(t, failures, 1)
+	assert.Equal(t,
+		"plugin pre-function conflicts with the pre-function plugin generated for route mirrored, ignoring it",
+		failures[0].Message(),
+	)
+	causingObjects := failures[0].CausingObjects()
+	require.Len(t, causingObjects, 2)
+	assert.Equal(t, "HTTPRoute", causingObjects[0].GetObjectKind().GroupVersionKind().Kind)
+	assert.Equal(t, "route", causingObjects[0].GetName())
+	assert.Equal(t, kongPlugin, causingObjects[1])
+}
+
+func TestResolveRoutePluginsConflicts_FrontendTLSValidationMTLSAuth(t *testing.T) {
+	caCert, _ := certificate.MustGenerateSelfSignedCertPEMFormat(certificate.WithCATrue())
+	caConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ca",
+			Namespace: "default",
+		},
+		Data: map[string]string{GatewayListenerCACertificateKey: string(caCert)},
+	}
+	gateway := &gatewayapi.Gateway{
+		TypeMeta: gatewayapi.V1GatewayTypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gateway",
+			Namespace: "default",
+		},
+		Spec: gatewayapi.GatewaySpec{
+			Listeners: []gatewayapi.Listener{
+				{
+					Name:     "https-mtls",
+					Protocol: gatewayapi.HTTPSProtocolType,
+					Port:     443,
+					TLS: &gatewayapi.GatewayTLSConfig{
+						FrontendValidation: &gatewayapi.FrontendTLSValidation{
+							CACertificateRefs: []gatewayapi.ObjectReference{{Kind: "ConfigMap", Name: "ca"}},
+						},
+					},
+				},
+			},
+		},
+		Status: gatewayapi.GatewayStatus{
+			Listeners: []gatewayapi.ListenerStatus{
+				{
+					Name: "https-mtls",
+					Conditions: []metav1.Condition{
+						{
+							Type:   string(gatewayapi.ListenerConditionProgrammed),
+							Status: metav1.ConditionTrue,
+							Reason: string(gatewayapi.ListenerReasonProgrammed),
+						},
+					},
+				},
+			},
+		},
+	}
+	httpRoute := &gatewayapi.HTTPRoute{
+		TypeMeta: gatewayapi.V1HTTPRouteTypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "route",
+			Namespace: "default",
+		},
+		Spec: gatewayapi.HTTPRouteSpec{
+			CommonRouteSpec: gatewayapi.CommonRouteSpec{
+				ParentRefs: []gatewayapi.ParentReference{{Name: "gateway"}},
+			},
+		},
+	}
+	kongPlugin := &kongv1.KongPlugin{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kongv1.GroupVersion.String(),
+			Kind:       "KongPlugin",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mtls-auth",
+			Namespace: "default",
+		},
+		PluginName: "mtls-auth",
+	}
+
+	s, err := store.NewFakeStore(store.FakeObjects{
+		Gateways:   []*gatewayapi.Gateway{gateway},
+		HTTPRoutes: []*gatewayapi.HTTPRoute{httpRoute},
+		ConfigMaps: []*corev1.ConfigMap{caConfigMap},
+	})
+	require.NoError(t, err)
+	translator := mustNewTranslator(t, s)
+	translator.featureFlags.EnterpriseEdition = true
+
+	state := kongstate.KongState{
+		Services: []kongstate.Service{
+			{
+				Service: kong.Service{Name: kong.String("svc")},
+				Routes: []kongstate.Route{
+					{
+						Route:   kong.Route{Name: kong.String("route")},
+						Ingress: util.FromK8sObject(httpRoute),
+					},
+				},
+			},
+		},
+		Plugins: []kongstate.Plugin{
+			{
+				Plugin: kong.Plugin{
+					Name:   kong.String("mtls-auth"),
+					Route:  &kong.Route{ID: kong.String("route")},
+					Config: kong.Configuration{"ca_certificates": []string{"user-ca"}},
+				},
+				K8sParent: kongPlugin,
+			},
+		},
+	}
+	translator.applyGatewayFrontendTLSValidation(&state)
+	translator.resolveRoutePluginsConflicts(&state)
+
+	t.Log("Verifying that the mtls-auth plugin generated from frontendValidation takes precedence")
+	assert.Empty(t, state.Plugins)
+	routePlugins := state.Services[0].Routes[0].Plugins
+	require.Len(t, routePlugins, 1)
+	assert.Equal(t, "mtls-auth", *routePlugins[0].Name)
+	assert.Equal(t, []string{*state.CACertificates[0].ID}, routePlugins[0].Config["ca_certificates"])
+
+	t.Log("Verifying that the clash is reported as a translation failure")
+	failures := translator.popTranslationFailures()
+	require.Len(t, failures, 1)
+	assert.Equal(t,
+		"plugin mtls-auth conflicts with the mtls-auth plugin generated for route route, ignoring it",
+		failures[0].Message(),
+	)
+	causingObjects := failures[0].CausingObjects()
+	require.Len(t, causingObjects, 2)
+	assert.Equal(t, "HTTPRoute", causingObjects[0].GetObjectKind().GroupVersionKind().Kind)
+	assert.Equal(t, kongPlugin, causingObjects[1])
+}
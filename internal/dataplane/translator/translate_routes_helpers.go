@@ -4,12 +4,15 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/go-logr/logr"
 	"github.com/kong/go-kong/kong"
 	"github.com/samber/lo"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 )
 
@@ -144,6 +147,116 @@ func tlsRouteToKongRoute(r *gatewayapi.TLSRoute, ruleNumber int) kong.Route {
 	}
 }
 
+// GenerateKongRoutesFromL4Route translates all rules of a TCPRoute, UDPRoute or TLSRoute to Kong Routes the same
+// way they're translated when building Kong configuration. Gateways the route is attached to (determining listening
+// ports and TLS mode) are resolved using the provided storer. It's meant to be used for validating routes before
+// they're translated.
+func GenerateKongRoutesFromL4Route[T tRoute](
+	logger logr.Logger, route T, storer store.Storer, expressionRoutes bool,
+) ([]kongstate.Route, error) {
+	var (
+		routesByRule [][]kongstate.Route
+		err          error
+	)
+	switch r := any(route).(type) {
+	case *gatewayapi.TCPRoute:
+		routesByRule, err = generateKongRoutesFromTCPRouteRules(storer, r)
+	case *gatewayapi.UDPRoute:
+		routesByRule, err = generateKongRoutesFromUDPRouteRules(storer, r)
+	case *gatewayapi.TLSRoute:
+		routesByRule, err = generateKongRoutesFromTLSRouteRules(logger, storer, r)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	routes := lo.Flatten(routesByRule)
+	if expressionRoutes {
+		applyExpressionToL4KongRoutes(routes)
+	}
+	return routes, nil
+}
+
+// generateKongRoutesFromTCPRouteRules returns Kong Routes generated for each rule of the TCPRoute. Routes match
+// the listening ports of the Gateways' TCP listeners the TCPRoute is attached to.
+func generateKongRoutesFromTCPRouteRules(storer store.Storer, tcproute *gatewayapi.TCPRoute) ([][]kongstate.Route, error) {
+	if len(tcproute.Spec.Rules) == 0 {
+		return nil, subtranslator.ErrRouteValidationNoRules
+	}
+	gwPorts := getGatewayListeningPorts(storer, tcproute.Namespace, gatewayapi.TCPProtocolType, tcproute.Spec.ParentRefs)
+	return generateKongRoutesFromRouteRules(tcproute, gwPorts, tcproute.Spec.Rules)
+}
+
+// generateKongRoutesFromUDPRouteRules returns Kong Routes generated for each rule of the UDPRoute. Routes match
+// the listening ports of the Gateways' UDP listeners the UDPRoute is attached to.
+func generateKongRoutesFromUDPRouteRules(storer store.Storer, udproute *gatewayapi.UDPRoute) ([][]kongstate.Route, error) {
+	if len(udproute.Spec.Rules) == 0 {
+		return nil, subtranslator.ErrRouteValidationNoRules
+	}
+	gwPorts := getGatewayListeningPorts(storer, udproute.Namespace, gatewayapi.UDPProtocolType, udproute.Spec.ParentRefs)
+	return generateKongRoutesFromRouteRules(udproute, gwPorts, udproute.Spec.Rules)
+}
+
+// generateKongRoutesFromTLSRouteRules returns Kong Routes generated for each rule of the TLSRoute. Routes match
+// the TLSRoute's hostnames by SNI. Routes attached to Terminate listeners keep the tls protocol: Kong terminates TLS
+// with the listener's certificate (matched by SNI) and forwards plain TCP to the backends. Routes attached to
+// Passthrough listeners use the tls_passthrough protocol and forward the TLS stream as is.
+func generateKongRoutesFromTLSRouteRules(
+	logger logr.Logger, storer store.Storer, tlsroute *gatewayapi.TLSRoute,
+) ([][]kongstate.Route, error) {
+	if len(tlsroute.Spec.Hostnames) == 0 {
+		return nil, fmt.Errorf("no hostnames provided")
+	}
+	if len(tlsroute.Spec.Rules) == 0 {
+		return nil, subtranslator.ErrRouteValidationNoRules
+	}
+
+	tlsMode, err := getTLSRouteMode(logger, storer, tlsroute)
+	if err != nil {
+		return nil, err
+	}
+
+	// TLSRoute matches based on hostname with Gateway listener thus passing gwPorts is pointless.
+	routesByRule, err := generateKongRoutesFromRouteRules(tlsroute, nil, tlsroute.Spec.Rules)
+	if err != nil {
+		return nil, err
+	}
+	if tlsMode == gatewayapi.TLSModePassthrough {
+		for _, routes := range routesByRule {
+			for i := range routes {
+				routes[i].Protocols = kong.StringSlice("tls_passthrough")
+			}
+		}
+	}
+	return routesByRule, nil
+}
+
+// generateKongRoutesFromRouteRules returns Kong Routes generated for each of the Gateway Route (TCP, UDP or TLS) rules.
+func generateKongRoutesFromRouteRules[T tRoute, TRule tRouteRule](
+	route T,
+	gwPorts []gatewayapi.PortNumber,
+	rules []TRule,
+) ([][]kongstate.Route, error) {
+	routesByRule := make([][]kongstate.Route, 0, len(rules))
+	for ruleNumber, rule := range rules {
+		routes, err := generateKongRoutesFromRouteRule(route, gwPorts, ruleNumber, rule)
+		if err != nil {
+			return nil, err
+		}
+		routesByRule = append(routesByRule, routes)
+	}
+	return routesByRule, nil
+}
+
+// applyExpressionToL4KongRoutes translates Kong Routes generated from TCP, UDP and TLS routes to expression routes.
+func applyExpressionToL4KongRoutes(routes []kongstate.Route) {
+	for i := range routes {
+		subtranslator.ApplyExpressionToL4KongRoute(&routes[i])
+		routes[i].Destinations = nil
+		routes[i].SNIs = nil
+	}
+}
+
 type routeType string
 
 const (
@@ -168,6 +281,15 @@ func (t *Translator) getGatewayListeningPorts(
 	routeNamespace string,
 	protocol gatewayapi.ProtocolType,
 	prs []gatewayapi.ParentReference,
+) []gatewayapi.PortNumber {
+	return getGatewayListeningPorts(t.storer, routeNamespace, protocol, prs)
+}
+
+func getGatewayListeningPorts(
+	storer store.Storer,
+	routeNamespace string,
+	protocol gatewayapi.ProtocolType,
+	prs []gatewayapi.ParentReference,
 ) []gatewayapi.PortNumber {
 	var gwPorts []gatewayapi.PortNumber
 	for _, pr := range prs {
@@ -177,7 +299,7 @@ func (t *Translator) getGatewayListeningPorts(
 		if ns == "" {
 			ns = routeNamespace
 		}
-		gw, err := t.storer.GetGateway(ns, string(pr.Name))
+		gw, err := storer.GetGateway(ns, string(pr.Name))
 		if err != nil {
 			continue // Skip when attached Gateway is not found.
 		}
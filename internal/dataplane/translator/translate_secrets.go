@@ -1,7 +1,9 @@
 package translator
 
 import (
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
 	"errors"
@@ -13,6 +15,7 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 )
@@ -54,26 +57,59 @@ func toKongCACertificate(certSecret *corev1.Secret, secretID string) (kong.CACer
 	if !certExists {
 		return kong.CACertificate{}, errors.New("missing 'cert' field in data")
 	}
-	pemBlock, _ := pem.Decode(caCertbytes)
+	if err := validateCACertificate(caCertbytes); err != nil {
+		return kong.CACertificate{}, err
+	}
+
+	return kong.CACertificate{
+		ID:   kong.String(secretID),
+		Cert: kong.String(string(caCertbytes)),
+		Tags: util.GenerateTagsForObject(certSecret),
+	}, nil
+}
+
+// validateCACertificate ensures the PEM encoded certificate is a valid, non-expired CA certificate.
+func validateCACertificate(caCertBytes []byte) error {
+	pemBlock, _ := pem.Decode(caCertBytes)
 	if pemBlock == nil {
-		return kong.CACertificate{}, errors.New("invalid PEM block")
+		return errors.New("invalid PEM block")
 	}
 	x509Cert, err := x509.ParseCertificate(pemBlock.Bytes)
 	if err != nil {
-		return kong.CACertificate{}, errors.New("failed to parse certificate")
+		return errors.New("failed to parse certificate")
 	}
 	if !x509Cert.IsCA {
-		return kong.CACertificate{}, errors.New("certificate is missing the 'CA' basic constraint")
+		return errors.New("certificate is missing the 'CA' basic constraint")
 	}
 	if time.Now().After(x509Cert.NotAfter) {
-		return kong.CACertificate{}, errors.New("expired")
+		return errors.New("expired")
 	}
+	return nil
+}
 
-	return kong.CACertificate{
-		ID:   kong.String(secretID),
-		Cert: kong.String(string(caCertbytes)),
-		Tags: util.GenerateTagsForObject(certSecret),
-	}, nil
+// addCACertificate adds the CA certificate to the KongState unless it's already there, either with the same ID
+// or the same digest (e.g. the same certificate from a konghq.com/ca-cert Secret). Kong rejects CA certificates
+// with duplicate digests, so the ID of the CA certificate already in the KongState is returned to be referred to.
+func addCACertificate(result *kongstate.KongState, caCert kong.CACertificate) string {
+	digest := caCertificateDigest(*caCert.Cert)
+	for _, c := range result.CACertificates {
+		if *c.ID == *caCert.ID || (digest != "" && caCertificateDigest(*c.Cert) == digest) {
+			return *c.ID
+		}
+	}
+	result.CACertificates = append(result.CACertificates, caCert)
+	return *caCert.ID
+}
+
+// caCertificateDigest returns the SHA-256 digest of the PEM encoded certificate's DER form, the same way Kong
+// calculates CA certificates' cert_digest. It returns an empty string if the certificate can't be decoded.
+func caCertificateDigest(cert string) string {
+	pemBlock, _ := pem.Decode([]byte(cert))
+	if pemBlock == nil {
+		return ""
+	}
+	digest := sha256.Sum256(pemBlock.Bytes)
+	return hex.EncodeToString(digest[:])
 }
 
 func getPluginsAssociatedWithCACertSecret(secretID string, storer store.Storer) []client.Object {
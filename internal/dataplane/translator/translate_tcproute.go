@@ -3,7 +3,6 @@ package translator
 import (
 	"fmt"
 
-	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 )
 
@@ -14,13 +13,17 @@ import (
 // ingressRulesFromTCPRoutes processes a list of TCPRoute objects and translates
 // then into Kong configuration objects.
 func (t *Translator) ingressRulesFromTCPRoutes() ingressRules {
-	result := newIngressRules()
-
 	tcpRouteList, err := t.storer.ListTCPRoutes()
 	if err != nil {
 		t.logger.Error(err, "Failed to list TCPRoutes")
-		return result
+		return newIngressRules()
 	}
+	return t.ingressRulesFromTCPRouteList(tcpRouteList)
+}
+
+// ingressRulesFromTCPRouteList translates the given TCPRoutes into Kong configuration objects.
+func (t *Translator) ingressRulesFromTCPRouteList(tcpRouteList []*gatewayapi.TCPRoute) ingressRules {
+	result := newIngressRules()
 
 	var errs []error
 	for _, tcproute := range tcpRouteList {
@@ -46,29 +49,21 @@ func (t *Translator) ingressRulesFromTCPRoutes() ingressRules {
 }
 
 func (t *Translator) ingressRulesFromTCPRoute(result *ingressRules, tcproute *gatewayapi.TCPRoute) error {
-	spec := tcproute.Spec
-	if len(spec.Rules) == 0 {
-		return subtranslator.ErrRouteValidationNoRules
+	// Determine the routes needed to route traffic to services for each rule.
+	routesByRule, err := generateKongRoutesFromTCPRouteRules(t.storer, tcproute)
+	if err != nil {
+		return err
 	}
 
-	gwPorts := t.getGatewayListeningPorts(tcproute.Namespace, gatewayapi.TCPProtocolType, spec.CommonRouteSpec.ParentRefs)
-
 	// Each rule may represent a different set of backend services that will be accepting
 	// traffic, so we make separate routes and Kong services for every present rule.
-	for ruleNumber, rule := range spec.Rules {
-
-		// Determine the routes needed to route traffic to services for this rule.
-		routes, err := generateKongRoutesFromRouteRule(tcproute, gwPorts, ruleNumber, rule)
-		if err != nil {
-			return err
-		}
-
+	for ruleNumber, rule := range tcproute.Spec.Rules {
 		// create a service and attach the routes to it
-		service, err := generateKongServiceFromBackendRefWithRuleNumber(t.logger, t.storer, result, tcproute, ruleNumber, "tcp", rule.BackendRefs...)
+		service, err := generateKongServiceFromBackendRefWithRuleNumber(t.logger, t.storer, t.featureFlags, result, tcproute, ruleNumber, "tcp", rule.BackendRefs...)
 		if err != nil {
 			return err
 		}
-		service.Routes = append(service.Routes, routes...)
+		service.Routes = append(service.Routes, routesByRule[ruleNumber]...)
 
 		// cache the service to avoid duplicates in further loop iterations
 		result.ServiceNameToServices[*service.Service.Name] = service
@@ -4,10 +4,10 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/kong/go-kong/kong"
+	"github.com/go-logr/logr"
+	"github.com/samber/lo"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
-	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 )
@@ -19,13 +19,17 @@ import (
 // ingressRulesFromTLSRoutes processes a list of TLSRoute objects and translates
 // then into Kong configuration objects.
 func (t *Translator) ingressRulesFromTLSRoutes() ingressRules {
-	result := newIngressRules()
-
 	tlsRouteList, err := t.storer.ListTLSRoutes()
 	if err != nil {
 		t.logger.Error(err, "Failed to list TLSRoutes")
-		return result
+		return newIngressRules()
 	}
+	return t.ingressRulesFromTLSRouteList(tlsRouteList)
+}
+
+// ingressRulesFromTLSRouteList translates the given TLSRoutes into Kong configuration objects.
+func (t *Translator) ingressRulesFromTLSRouteList(tlsRouteList []*gatewayapi.TLSRoute) ingressRules {
+	result := newIngressRules()
 
 	var errs []error
 	for _, tlsroute := range tlsRouteList {
@@ -51,42 +55,21 @@ func (t *Translator) ingressRulesFromTLSRoutes() ingressRules {
 }
 
 func (t *Translator) ingressRulesFromTLSRoute(result *ingressRules, tlsroute *gatewayapi.TLSRoute) error {
-	spec := tlsroute.Spec
-
-	if len(spec.Hostnames) == 0 {
-		return fmt.Errorf("no hostnames provided")
-	}
-	if len(spec.Rules) == 0 {
-		return subtranslator.ErrRouteValidationNoRules
-	}
-
-	tlsPassthrough, err := t.isTLSRoutePassthrough(tlsroute)
+	// Determine the routes needed to route traffic to services for each rule.
+	routesByRule, err := generateKongRoutesFromTLSRouteRules(t.logger, t.storer, tlsroute)
 	if err != nil {
 		return err
 	}
 
 	// Each rule may represent a different set of backend services that will be accepting
 	// traffic, so we make separate routes and Kong services for every present rule.
-	for ruleNumber, rule := range spec.Rules {
-		// Determine the routes needed to route traffic to services for this rule.
-		// TLSRoute matches based on hostname with Gateway listener thus passing gwPorts is pointless.
-		routes, err := generateKongRoutesFromRouteRule(tlsroute, nil, ruleNumber, rule)
-		// Change protocols in route to tls_passthrough.
-		if tlsPassthrough {
-			for i := range routes {
-				routes[i].Protocols = kong.StringSlice("tls_passthrough")
-			}
-		}
-		if err != nil {
-			return err
-		}
-
+	for ruleNumber, rule := range tlsroute.Spec.Rules {
 		// create a service and attach the routes to it
-		service, err := generateKongServiceFromBackendRefWithRuleNumber(t.logger, t.storer, result, tlsroute, ruleNumber, "tcp", rule.BackendRefs...)
+		service, err := generateKongServiceFromBackendRefWithRuleNumber(t.logger, t.storer, t.featureFlags, result, tlsroute, ruleNumber, "tcp", rule.BackendRefs...)
 		if err != nil {
 			return err
 		}
-		service.Routes = append(service.Routes, routes...)
+		service.Routes = append(service.Routes, routesByRule[ruleNumber]...)
 
 		// cache the service to avoid duplicates in further loop iterations
 		result.ServiceNameToServices[*service.Service.Name] = service
@@ -96,10 +79,12 @@ func (t *Translator) ingressRulesFromTLSRoute(result *ingressRules, tlsroute *ga
 	return nil
 }
 
-// isTLSRoutePassthrough returns true if we need to configure TLS passthrough to kong
-// for the tlsroute object.
-// returns a non-nil error if we failed to get the supported gateway.
-func (t *Translator) isTLSRoutePassthrough(tlsroute *gatewayapi.TLSRoute) (bool, error) {
+// getTLSRouteMode returns the TLS mode of the listeners the tlsroute is attached to. Terminate is returned
+// if the route is not attached to any listener yet. Kong can't serve a route both ways, so a non-nil error is
+// returned if the route is attached to listeners with different TLS modes or if we failed to get the supported
+// gateways.
+func getTLSRouteMode(logger logr.Logger, storer store.Storer, tlsroute *gatewayapi.TLSRoute) (gatewayapi.TLSModeType, error) {
+	modes := make(map[gatewayapi.TLSModeType]struct{})
 	// reconcile loop will push TLSRoute object with updated status when
 	// gateway is ready and TLSRoute object becomes stable.
 	// so we get the supported gateways from status.parents.
@@ -119,31 +104,44 @@ func (t *Translator) isTLSRoutePassthrough(tlsroute *gatewayapi.TLSRoute) (bool,
 			gatewayNamespace = string(*parentRef.Namespace)
 		}
 
-		gateway, err := t.storer.GetGateway(gatewayNamespace, string(parentRef.Name))
+		gateway, err := storer.GetGateway(gatewayNamespace, string(parentRef.Name))
 		if err != nil {
 			if errors.As(err, &store.NotFoundError{}) {
 				// log an error if the gateway expected to support the TLSRoute is not found in our cache.
-				t.logger.Error(err, "Gateway not found for TLSRoute",
+				logger.Error(err, "Gateway not found for TLSRoute",
 					"gateway_namespace", gatewayNamespace,
 					"gateway_name", parentRef.Name,
 					"tlsroute_namesapce", tlsroute.Namespace,
 					"tlsroute_name", tlsroute.Name)
 				continue
 			}
-			return false, err
+			return "", err
 		}
 
-		// If any of the gateway's listeners is configured to passthrough
-		// TLS requests, we return true.
 		for _, listener := range gateway.Spec.Listeners {
-			if parentRef.SectionName == nil || listener.Name == *parentRef.SectionName {
-				if listener.TLS != nil && listener.TLS.Mode != nil &&
-					*listener.TLS.Mode == gatewayapi.TLSModePassthrough {
-					return true, nil
-				}
+			if listener.Protocol != gatewayapi.TLSProtocolType || listener.TLS == nil {
+				continue
 			}
+			if parentRef.SectionName != nil && listener.Name != *parentRef.SectionName {
+				continue
+			}
+			if parentRef.Port != nil && listener.Port != *parentRef.Port {
+				continue
+			}
+			mode := gatewayapi.TLSModeTerminate
+			if listener.TLS.Mode != nil {
+				mode = *listener.TLS.Mode
+			}
+			modes[mode] = struct{}{}
 		}
 	}
 
-	return false, nil
+	switch {
+	case len(modes) > 1:
+		return "", errors.New("attached to both Passthrough and Terminate listeners")
+	case len(modes) == 1:
+		return lo.Keys(modes)[0], nil
+	default:
+		return gatewayapi.TLSModeTerminate, nil
+	}
 }
@@ -257,3 +257,123 @@ func TestIngressRulesFromTLSRoutesUsingExpressionRoutes(t *testing.T) {
 		})
 	}
 }
+
+func TestIngressRulesFromTLSRoutes_TLSModes(t *testing.T) {
+	gateway := &gatewayapi.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "gateway",
+		},
+		Spec: gatewayapi.GatewaySpec{
+			Listeners: []gatewayapi.Listener{
+				{
+					Name:     "passthrough",
+					Port:     443,
+					Protocol: gatewayapi.TLSProtocolType,
+					TLS:      &gatewayapi.GatewayTLSConfig{Mode: lo.ToPtr(gatewayapi.TLSModePassthrough)},
+				},
+				{
+					Name:     "terminate",
+					Port:     8443,
+					Protocol: gatewayapi.TLSProtocolType,
+					TLS:      &gatewayapi.GatewayTLSConfig{Mode: lo.ToPtr(gatewayapi.TLSModeTerminate)},
+				},
+			},
+		},
+	}
+	newTLSRoute := func(name string, parentRef gatewayapi.ParentReference) *gatewayapi.TLSRoute {
+		return &gatewayapi.TLSRoute{
+			TypeMeta: gatewayapi.TLSRouteTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+			},
+			Spec: gatewayapi.TLSRouteSpec{
+				CommonRouteSpec: gatewayapi.CommonRouteSpec{
+					ParentRefs: []gatewayapi.ParentReference{parentRef},
+				},
+				Hostnames: []gatewayapi.Hostname{gatewayapi.Hostname(name + ".example.com")},
+				Rules: []gatewayapi.TLSRouteRule{
+					{
+						BackendRefs: []gatewayapi.BackendRef{
+							builder.NewBackendRef("service").WithPort(80).Build(),
+						},
+					},
+				},
+			},
+			Status: gatewayapi.TLSRouteStatus{
+				RouteStatus: gatewayapi.RouteStatus{
+					Parents: []gatewayapi.RouteParentStatus{{ParentRef: parentRef}},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		tlsRoute          *gatewayapi.TLSRoute
+		expectedProtocols []*string
+		expectError       bool
+	}{
+		{
+			name: "attached to a passthrough listener",
+			tlsRoute: newTLSRoute("passthrough", gatewayapi.ParentReference{
+				Name: "gateway", SectionName: lo.ToPtr(gatewayapi.SectionName("passthrough")),
+			}),
+			expectedProtocols: kong.StringSlice("tls_passthrough"),
+		},
+		{
+			name: "attached to a terminate listener",
+			tlsRoute: newTLSRoute("terminate", gatewayapi.ParentReference{
+				Name: "gateway", SectionName: lo.ToPtr(gatewayapi.SectionName("terminate")),
+			}),
+			expectedProtocols: kong.StringSlice("tls"),
+		},
+		{
+			name: "attached to a terminate listener by its port",
+			tlsRoute: newTLSRoute("terminate-port", gatewayapi.ParentReference{
+				Name: "gateway", Port: lo.ToPtr(gatewayapi.PortNumber(8443)),
+			}),
+			expectedProtocols: kong.StringSlice("tls"),
+		},
+		{
+			name:        "attached to both passthrough and terminate listeners",
+			tlsRoute:    newTLSRoute("both", gatewayapi.ParentReference{Name: "gateway"}),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakestore, err := store.NewFakeStore(store.FakeObjects{
+				Gateways:  []*gatewayapi.Gateway{gateway},
+				TLSRoutes: []*gatewayapi.TLSRoute{tc.tlsRoute},
+				Services: []*corev1.Service{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "default",
+							Name:      "service",
+						},
+					},
+				},
+			})
+			require.NoError(t, err)
+			translator := mustNewTranslator(t, fakestore)
+
+			result := newIngressRules()
+			err = translator.ingressRulesFromTLSRoute(&result, tc.tlsRoute)
+			if tc.expectError {
+				require.Error(t, err)
+				require.Empty(t, result.ServiceNameToServices)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, result.ServiceNameToServices, 1)
+			for _, service := range result.ServiceNameToServices {
+				require.Equal(t, "tcp", *service.Protocol)
+				require.Len(t, service.Routes, 1)
+				require.Equal(t, tc.expectedProtocols, service.Routes[0].Protocols)
+			}
+		})
+	}
+}
@@ -14,13 +14,17 @@ import (
 // ingressRulesFromUDPRoutes processes a list of UDPRoute objects and translates
 // then into Kong configuration objects.
 func (t *Translator) ingressRulesFromUDPRoutes() ingressRules {
-	result := newIngressRules()
-
 	udpRouteList, err := t.storer.ListUDPRoutes()
 	if err != nil {
 		t.logger.Error(err, "Failed to list UDPRoutes")
-		return result
+		return newIngressRules()
 	}
+	return t.ingressRulesFromUDPRouteList(udpRouteList)
+}
+
+// ingressRulesFromUDPRouteList translates the given UDPRoutes into Kong configuration objects.
+func (t *Translator) ingressRulesFromUDPRouteList(udpRouteList []*gatewayapi.UDPRoute) ingressRules {
+	result := newIngressRules()
 
 	var errs []error
 	for _, udproute := range udpRouteList {
@@ -53,27 +57,21 @@ func (t *Translator) ingressRulesFromUDPRoutes() ingressRules {
 }
 
 func (t *Translator) ingressRulesFromUDPRoute(result *ingressRules, udproute *gatewayapi.UDPRoute) error {
-	spec := udproute.Spec
-	if len(spec.Rules) == 0 {
-		return subtranslator.ErrRouteValidationNoRules
+	// Determine the routes needed to route traffic to services for each rule.
+	routesByRule, err := generateKongRoutesFromUDPRouteRules(t.storer, udproute)
+	if err != nil {
+		return err
 	}
 
-	gwPorts := t.getGatewayListeningPorts(udproute.Namespace, gatewayapi.UDPProtocolType, spec.CommonRouteSpec.ParentRefs)
 	// Each rule may represent a different set of backend services that will be accepting
 	// traffic, so we make separate routes and Kong services for every present rule.
-	for ruleNumber, rule := range spec.Rules {
-		// Determine the routes needed to route traffic to services for this rule.
-		routes, err := generateKongRoutesFromRouteRule(udproute, gwPorts, ruleNumber, rule)
-		if err != nil {
-			return err
-		}
-
+	for ruleNumber, rule := range udproute.Spec.Rules {
 		// create a service and attach the routes to it
-		service, err := generateKongServiceFromBackendRefWithRuleNumber(t.logger, t.storer, result, udproute, ruleNumber, "udp", rule.BackendRefs...)
+		service, err := generateKongServiceFromBackendRefWithRuleNumber(t.logger, t.storer, t.featureFlags, result, udproute, ruleNumber, "udp", rule.BackendRefs...)
 		if err != nil {
 			return err
 		}
-		service.Routes = append(service.Routes, routes...)
+		service.Routes = append(service.Routes, routesByRule[ruleNumber]...)
 
 		// cache the service to avoid duplicates in further loop iterations
 		result.ServiceNameToServices[*service.Service.Name] = service
@@ -3,6 +3,7 @@ package translator
 import (
 	"fmt"
 	"net"
+	"strconv"
 
 	"github.com/go-logr/logr"
 	"github.com/kong/go-kong/kong"
@@ -16,6 +17,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 	kongv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/configuration/v1alpha1"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 func (t *Translator) getUpstreams(serviceMap map[string]kongstate.Service) ([]kongstate.Upstream, map[string]kongstate.Service) {
@@ -38,6 +40,22 @@ func (t *Translator) getUpstreams(serviceMap map[string]kongstate.Service) ([]ko
 			targetMap := map[string]kongstate.Target{}
 			// populate all the kong targets for the upstream given all the backends
 			for _, backend := range service.Backends {
+				// KongUpstreamTarget defines its endpoints statically, there's no Kubernetes Service to look up.
+				if backend.IsKongUpstreamTarget() {
+					upstreamTarget, err := t.storer.GetKongUpstreamTarget(backend.Namespace(), backend.Name())
+					if err != nil {
+						t.registerTranslationFailure(
+							fmt.Sprintf("couldn't get KongUpstreamTarget %s: %v", backend.Name(), err),
+							service.Parent,
+						)
+						continue
+					}
+					for _, t := range getKongUpstreamTargetEndpoints(upstreamTarget, backend, len(service.Backends) > 1) {
+						targetMap = updateTargetMap(targetMap, t)
+					}
+					continue
+				}
+
 				// gather the Kubernetes service for the backend
 				backendNamespace := backend.Namespace()
 
@@ -83,31 +101,7 @@ func (t *Translator) getUpstreams(serviceMap map[string]kongstate.Service) ([]ko
 						"namespace", k8sService.Namespace, "name", k8sService.Name, "kong_service", *service.Name)
 				}
 
-				// if weights were set for the backend then that weight needs to be
-				// distributed equally among all the targets.
-				if weight, weightPresent := backend.Weight().Get(); weightPresent && len(newTargets) != 0 {
-					// initialize the weight of the target based on the weight of the backend
-					// which governs that target (and potentially more). If the weight of the
-					// backend is 0 then this indicates an intention to drop all targets from
-					// this backend from the load-balancer and is a special situation where
-					// all derived targets will receive a weight of 0.
-					targetWeight := weight
-
-					// if the backend governing this target is not set to a weight of 0,
-					// all targets derived from the backend split the weight, therefore
-					// equally splitting the traffic load.
-					if weight != 0 {
-						targetWeight = weight / len(newTargets)
-						// minimum weight of 1 if weight zero was not specifically set.
-						if targetWeight == 0 {
-							targetWeight = 1
-						}
-					}
-
-					for i := range newTargets {
-						newTargets[i].Weight = &targetWeight
-					}
-				}
+				newTargets = distributeBackendWeight(backend, newTargets)
 
 				for _, t := range newTargets {
 					targetMap = updateTargetMap(targetMap, t)
@@ -137,6 +131,75 @@ func (t *Translator) getUpstreams(serviceMap map[string]kongstate.Service) ([]ko
 	return upstreams, serviceMap
 }
 
+// distributeBackendWeight distributes the weight of the backend (if set) equally among all the targets derived
+// from it.
+func distributeBackendWeight(backend kongstate.ServiceBackend, newTargets []kongstate.Target) []kongstate.Target {
+	// if weights were set for the backend then that weight needs to be
+	// distributed equally among all the targets.
+	if weight, weightPresent := backend.Weight().Get(); weightPresent && len(newTargets) != 0 {
+		// initialize the weight of the target based on the weight of the backend
+		// which governs that target (and potentially more). If the weight of the
+		// backend is 0 then this indicates an intention to drop all targets from
+		// this backend from the load-balancer and is a special situation where
+		// all derived targets will receive a weight of 0.
+		targetWeight := weight
+
+		// if the backend governing this target is not set to a weight of 0,
+		// all targets derived from the backend split the weight, therefore
+		// equally splitting the traffic load.
+		if weight != 0 {
+			targetWeight = weight / len(newTargets)
+			// minimum weight of 1 if weight zero was not specifically set.
+			if targetWeight == 0 {
+				targetWeight = 1
+			}
+		}
+
+		for i := range newTargets {
+			newTargets[i].Weight = &targetWeight
+		}
+	}
+	return newTargets
+}
+
+// getKongUpstreamTargetEndpoints translates KongUpstreamTarget's endpoints into Kong Targets. When the Kong Service
+// has multiple backends and the backend's weight is set, endpoints' weights are scaled so that their sum matches
+// the backend's weight, keeping the proportions between the endpoints.
+func getKongUpstreamTargetEndpoints(
+	upstreamTarget *incubatorv1alpha1.KongUpstreamTarget,
+	backend kongstate.ServiceBackend,
+	multipleBackends bool,
+) []kongstate.Target {
+	endpointWeight := func(endpoint incubatorv1alpha1.KongUpstreamTargetEndpoint) int {
+		if endpoint.Weight == nil {
+			return targetWeightOrDefault(nil)
+		}
+		return int(*endpoint.Weight)
+	}
+	totalWeight := lo.SumBy(upstreamTarget.Spec.Targets, endpointWeight)
+	backendWeight, backendWeightPresent := backend.Weight().Get()
+	scaleWeights := multipleBackends && backendWeightPresent && totalWeight != 0
+
+	targets := make([]kongstate.Target, 0, len(upstreamTarget.Spec.Targets))
+	for _, endpoint := range upstreamTarget.Spec.Targets {
+		weight := endpointWeight(endpoint)
+		if scaleWeights && weight != 0 {
+			weight = backendWeight * weight / totalWeight
+			// minimum weight of 1 if weight zero was not specifically set.
+			if weight == 0 && backendWeight != 0 {
+				weight = 1
+			}
+		}
+		targets = append(targets, kongstate.Target{
+			Target: kong.Target{
+				Target: kong.String(net.JoinHostPort(endpoint.Host, strconv.Itoa(int(endpoint.Port)))),
+				Weight: kong.Int(weight),
+			},
+		})
+	}
+	return targets
+}
+
 // findPort finds a port matching the specified definition in a Kubernetes Service.
 func findPort(svc *corev1.Service, wantPort kongstate.PortDef) (*corev1.ServicePort, error) {
 	switch wantPort.Mode {
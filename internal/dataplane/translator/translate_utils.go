@@ -9,7 +9,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
-	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator/subtranslator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
 )
@@ -70,6 +69,7 @@ func GetPermittedForReferenceGrantFrom(
 func generateKongServiceFromBackendRefWithName(
 	logger logr.Logger,
 	storer store.Storer,
+	features FeatureFlags,
 	rules *ingressRules,
 	serviceName string,
 	route client.Object,
@@ -88,7 +88,7 @@ func generateKongServiceFromBackendRefWithName(
 		Namespace: gatewayapi.Namespace(route.GetNamespace()),
 	}, grants)
 
-	backends := backendRefsToKongStateBackends(logger, storer, route, backendRefs, allowed)
+	backends := backendRefsToKongStateBackends(logger, storer, features, route, backendRefs, allowed)
 
 	// the service host needs to be a resolvable name due to legacy logic so we'll
 	// use the anchor backendRef as the basis for the name
@@ -138,6 +138,7 @@ func generateKongServiceFromBackendRefWithName(
 func generateKongServiceFromBackendRefWithRuleNumber(
 	logger logr.Logger,
 	storer store.Storer,
+	features FeatureFlags,
 	rules *ingressRules,
 	route client.Object,
 	ruleNumber int,
@@ -151,6 +152,7 @@ func generateKongServiceFromBackendRefWithRuleNumber(
 	return generateKongServiceFromBackendRefWithName(
 		logger,
 		storer,
+		features,
 		rules,
 		serviceName,
 		route,
@@ -161,10 +163,6 @@ func generateKongServiceFromBackendRefWithRuleNumber(
 
 func applyExpressionToIngressRules(result *ingressRules) {
 	for _, svc := range result.ServiceNameToServices {
-		for i := range svc.Routes {
-			subtranslator.ApplyExpressionToL4KongRoute(&svc.Routes[i])
-			svc.Routes[i].Destinations = nil
-			svc.Routes[i].SNIs = nil
-		}
+		applyExpressionToL4KongRoutes(svc.Routes)
 	}
 }
@@ -14,10 +14,18 @@ const (
 	// be given before timing out by default.
 	DefaultServiceTimeout = 60000
 
+	// MaxServiceTimeout is the maximum timeout (in milliseconds) accepted by Kong
+	// for connections, reads and writes to a service.
+	MaxServiceTimeout = 2147483646
+
 	// DefaultRetries indicates the number of times a connection should be
 	// retried by default.
 	DefaultRetries = 5
 
+	// MaxServiceRetries is the maximum number of retries accepted by Kong
+	// for a service.
+	MaxServiceRetries = 32767
+
 	// DefaultHTTPPort is the network port that should be assumed by default
 	// for HTTP traffic to services.
 	DefaultHTTPPort = 80
@@ -0,0 +1,444 @@
+package translator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/samber/lo"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/fallback"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+)
+
+// ingressRulesSource is a source of ingress rules translated from Kubernetes objects of a single kind.
+type ingressRulesSource struct {
+	// name identifies the source in the translation cache.
+	name string
+
+	// objects returns the Kubernetes objects translated by the source.
+	objects func() ([]client.Object, error)
+
+	// translate translates the objects into ingress rules.
+	translate func() ingressRules
+
+	// translateObject translates a single object of the source into ingress rules. It's set only for sources
+	// translating their objects independently of each other, which allows caching their results per object.
+	// Sources translating objects together (e.g. Ingresses sharing Kong Services or expression routes with
+	// priorities assigned across objects) are cached as a whole.
+	translateObject func(obj client.Object) ingressRules
+}
+
+// translationCacheEntry stores the result of a single object or a whole ingress rules source translation along
+// with its side effects (translation failures and successfully translated objects) so that they can be replayed
+// when the entry is reused.
+type translationCacheEntry struct {
+	objects           []string
+	rules             ingressRules
+	failures          []failures.ResourceFailure
+	translatedObjects []client.Object
+}
+
+// translatedObjectState is the state of a translated object the translation cache entries were built for.
+type translatedObjectState struct {
+	uid             k8stypes.UID
+	resourceVersion string
+	// dependencies are the object's transitive dependencies resolved with the fallback configuration
+	// dependency graph.
+	dependencies []client.Object
+}
+
+// translationCache caches results of ingress rules translation per object (or per source for sources that
+// translate their objects together). An entry is reused as long as its objects' UIDs and resource versions and
+// their dependencies don't change.
+//
+// Dependencies are resolved when an object changes and are verified against the cache stores later on. They're
+// resolved again for all objects only when objects are added to or removed from the cache stores, as a new object
+// may become a dependency of an existing one. Changes of objects affecting translation globally invalidate
+// all entries.
+type translationCache struct {
+	globalFingerprint     string
+	membershipFingerprint string
+	objects               map[string]translatedObjectState
+	entries               map[string]translationCacheEntry
+}
+
+func newTranslationCache() *translationCache {
+	return &translationCache{
+		objects: make(map[string]translatedObjectState),
+		entries: make(map[string]translationCacheEntry),
+	}
+}
+
+// incrementalTranslation holds the state of a single translation using the translation cache.
+type incrementalTranslation struct {
+	cache  *translationCache
+	stores store.CacheStores
+
+	// resolveDependencies is set when dependencies of all objects have to be resolved again.
+	resolveDependencies bool
+
+	usedEntries map[string]struct{}
+	seenObjects map[string]struct{}
+	translated  int
+}
+
+// ingressRulesSources returns all sources of ingress rules in the order their results are merged.
+func (t *Translator) ingressRulesSources() []ingressRulesSource {
+	sources := []ingressRulesSource{
+		{
+			name:      "Ingress",
+			objects:   func() ([]client.Object, error) { return asObjects(t.storer.ListIngressesV1()), nil },
+			translate: t.ingressRulesFromIngressV1,
+		},
+		{
+			name:      "TCPIngress",
+			objects:   listObjects(t.storer.ListTCPIngresses),
+			translate: t.ingressRulesFromTCPIngressV1beta1,
+		},
+		{
+			name:      "UDPIngress",
+			objects:   listObjects(t.storer.ListUDPIngresses),
+			translate: t.ingressRulesFromUDPIngressV1beta1,
+		},
+		{
+			name:            "HTTPRoute",
+			objects:         listObjects(t.storer.ListHTTPRoutes),
+			translate:       t.ingressRulesFromHTTPRoutes,
+			translateObject: translateSingle(t.ingressRulesFromHTTPRouteList),
+		},
+		{
+			name:            "UDPRoute",
+			objects:         listObjects(t.storer.ListUDPRoutes),
+			translate:       t.ingressRulesFromUDPRoutes,
+			translateObject: translateSingle(t.ingressRulesFromUDPRouteList),
+		},
+		{
+			name:            "TCPRoute",
+			objects:         listObjects(t.storer.ListTCPRoutes),
+			translate:       t.ingressRulesFromTCPRoutes,
+			translateObject: translateSingle(t.ingressRulesFromTCPRouteList),
+		},
+		{
+			name:            "TLSRoute",
+			objects:         listObjects(t.storer.ListTLSRoutes),
+			translate:       t.ingressRulesFromTLSRoutes,
+			translateObject: translateSingle(t.ingressRulesFromTLSRouteList),
+		},
+		{
+			name:            "GRPCRoute",
+			objects:         listObjects(t.storer.ListGRPCRoutes),
+			translate:       t.ingressRulesFromGRPCRoutes,
+			translateObject: translateSingle(t.ingressRulesFromGRPCRouteList),
+		},
+	}
+	if t.featureFlags.ExpressionRoutes {
+		// Priorities of expression routes generated from HTTPRoutes and GRPCRoutes depend on all the routes.
+		for i := range sources {
+			if sources[i].name == "HTTPRoute" || sources[i].name == "GRPCRoute" {
+				sources[i].translateObject = nil
+			}
+		}
+	}
+	return sources
+}
+
+// translateIngressRules translates all ingress rules sources. When incremental translation is enabled, only objects
+// that (or whose dependencies) changed since the previous translation are translated, the rest is reused from
+// the translation cache.
+func (t *Translator) translateIngressRules() []ingressRules {
+	sources := t.ingressRulesSources()
+	if t.translationCache == nil {
+		return lo.Map(sources, func(s ingressRulesSource, _ int) ingressRules { return s.translate() })
+	}
+
+	it := t.beginIncrementalTranslation()
+	results := make([]ingressRules, 0, len(sources))
+	for _, source := range sources {
+		results = append(results, t.translateIngressRulesSourceIncrementally(it, source))
+	}
+	it.pruneCache()
+	t.logger.V(util.DebugLevel).Info("Incrementally translated ingress rules",
+		"translated_entries", it.translated, "cached_entries", len(it.usedEntries)-it.translated)
+	return results
+}
+
+// beginIncrementalTranslation starts a translation using the translation cache. All cache entries are dropped
+// if objects affecting translation globally changed.
+func (t *Translator) beginIncrementalTranslation() *incrementalTranslation {
+	c := t.translationCache
+	stores := t.storer.CacheStores()
+
+	globalFingerprint := objectsFingerprint(t.globalTranslationObjects())
+	if globalFingerprint != c.globalFingerprint {
+		c.globalFingerprint = globalFingerprint
+		c.objects = make(map[string]translatedObjectState)
+		c.entries = make(map[string]translationCacheEntry)
+	}
+	membershipFingerprint := storesMembershipFingerprint(stores)
+	resolveDependencies := membershipFingerprint != c.membershipFingerprint
+	c.membershipFingerprint = membershipFingerprint
+
+	return &incrementalTranslation{
+		cache:               c,
+		stores:              stores,
+		resolveDependencies: resolveDependencies,
+		usedEntries:         make(map[string]struct{}),
+		seenObjects:         make(map[string]struct{}),
+	}
+}
+
+// translateIngressRulesSourceIncrementally translates the source's objects that changed since the previous
+// translation and takes the rest from the translation cache.
+func (t *Translator) translateIngressRulesSourceIncrementally(it *incrementalTranslation, source ingressRulesSource) ingressRules {
+	// Listing errors are not expected from the cache stores, but if they happen, the source is always translated
+	// to get the same behavior as with the full translation.
+	objects, err := source.objects()
+	if err != nil {
+		t.logger.V(util.DebugLevel).Info("Failed to list objects, translating", "source", source.name, "error", err.Error())
+		return source.translate()
+	}
+
+	if source.translateObject == nil {
+		return t.translateCacheEntry(it, source.name, objects, source.translate)
+	}
+	results := make([]ingressRules, 0, len(objects))
+	for _, obj := range objects {
+		results = append(results, t.translateCacheEntry(it, source.name+"/"+translationObjectKey(obj), []client.Object{obj},
+			func() ingressRules { return source.translateObject(obj) },
+		))
+	}
+	return mergeIngressRules(results...)
+}
+
+// translateCacheEntry returns the ingress rules of the cache entry if none of its objects changed. Otherwise,
+// it translates the objects and stores the result in the cache.
+func (t *Translator) translateCacheEntry(
+	it *incrementalTranslation, key string, objects []client.Object, translate func() ingressRules,
+) ingressRules {
+	it.usedEntries[key] = struct{}{}
+	objectKeys := make([]string, 0, len(objects))
+	changed := false
+	for _, obj := range objects {
+		objectKeys = append(objectKeys, translationObjectKey(obj))
+		// All objects are checked to keep their recorded states up to date.
+		objChanged, err := it.objectChanged(obj)
+		if err != nil {
+			t.logger.V(util.DebugLevel).Info("Failed to resolve dependencies, translating", "entry", key, "error", err.Error())
+		}
+		changed = changed || objChanged
+	}
+
+	entry, ok := it.cache.entries[key]
+	if !ok || changed || !slices.Equal(entry.objects, objectKeys) {
+		entry = t.translateWithSideEffects(translate)
+		entry.objects = objectKeys
+		it.cache.entries[key] = entry
+		it.translated++
+	}
+
+	for _, f := range entry.failures {
+		t.registerTranslationFailure(f.Message(), f.CausingObjects()...)
+	}
+	for _, obj := range entry.translatedObjects {
+		t.registerSuccessfullyTranslatedObject(obj)
+	}
+	return entry.rules.deepCopy()
+}
+
+// translateWithSideEffects translates with separate collectors to capture side effects of the translation.
+func (t *Translator) translateWithSideEffects(translate func() ingressRules) translationCacheEntry {
+	failuresCollector, translatedObjectsCollector := t.failuresCollector, t.translatedObjectsCollector
+	t.failuresCollector = failures.NewResourceFailuresCollector(t.logger)
+	if translatedObjectsCollector != nil {
+		t.translatedObjectsCollector = NewObjectsCollector()
+	}
+	rules := translate()
+	entry := translationCacheEntry{
+		rules:             rules,
+		failures:          t.failuresCollector.PopResourceFailures(),
+		translatedObjects: t.translatedObjectsCollector.Pop(),
+	}
+	t.failuresCollector, t.translatedObjectsCollector = failuresCollector, translatedObjectsCollector
+	return entry
+}
+
+// objectChanged returns true if the object or any of its dependencies changed since the previous translation
+// and records the object's current state.
+func (it *incrementalTranslation) objectChanged(obj client.Object) (bool, error) {
+	key := translationObjectKey(obj)
+	it.seenObjects[key] = struct{}{}
+
+	state, ok := it.cache.objects[key]
+	changed := !ok ||
+		state.uid != obj.GetUID() ||
+		state.resourceVersion != obj.GetResourceVersion() ||
+		!it.dependenciesUpToDate(state.dependencies)
+	if !changed && !it.resolveDependencies {
+		return false, nil
+	}
+
+	dependencies, err := resolveTransitiveDependencies(it.stores, obj)
+	if err != nil {
+		delete(it.cache.objects, key)
+		return true, err
+	}
+	it.cache.objects[key] = translatedObjectState{
+		uid:             obj.GetUID(),
+		resourceVersion: obj.GetResourceVersion(),
+		dependencies:    dependencies,
+	}
+	return changed || objectsFingerprint(state.dependencies) != objectsFingerprint(dependencies), nil
+}
+
+// dependenciesUpToDate returns true if all the dependencies are still in the cache stores in the same version.
+func (it *incrementalTranslation) dependenciesUpToDate(dependencies []client.Object) bool {
+	for _, dep := range dependencies {
+		item, exists, err := it.stores.Get(dep)
+		if err != nil || !exists {
+			return false
+		}
+		current, ok := item.(client.Object)
+		if !ok || current.GetUID() != dep.GetUID() || current.GetResourceVersion() != dep.GetResourceVersion() {
+			return false
+		}
+	}
+	return true
+}
+
+// pruneCache removes entries and states of objects that were not part of the translation, e.g. because
+// they were deleted.
+func (it *incrementalTranslation) pruneCache() {
+	for key := range it.cache.entries {
+		if _, ok := it.usedEntries[key]; !ok {
+			delete(it.cache.entries, key)
+		}
+	}
+	for key := range it.cache.objects {
+		if _, ok := it.seenObjects[key]; !ok {
+			delete(it.cache.objects, key)
+		}
+	}
+}
+
+// globalTranslationObjects returns objects that can affect translation of any ingress rules source, but are not
+// necessarily linked to the translated objects in the dependency graph (e.g. IngressClassParameters used for all
+// Ingresses or ReferenceGrants allowing cross-namespace references).
+func (t *Translator) globalTranslationObjects() []client.Object {
+	objects := asObjects(t.storer.ListIngressClassesV1())
+	objects = append(objects, asObjects(t.storer.ListIngressClassParametersV1Alpha1())...)
+	if referenceGrants, err := t.storer.ListReferenceGrants(); err == nil {
+		objects = append(objects, asObjects(referenceGrants)...)
+	}
+	if gateways, err := t.storer.ListGateways(); err == nil {
+		objects = append(objects, asObjects(gateways)...)
+	}
+	return objects
+}
+
+// resolveTransitiveDependencies returns all transitive dependencies of the object resolved with the fallback
+// configuration dependency graph.
+func resolveTransitiveDependencies(cache store.CacheStores, obj client.Object) ([]client.Object, error) {
+	seen := map[string]struct{}{translationObjectKey(obj): {}}
+	var dependencies []client.Object
+	var visit func(obj client.Object) error
+	visit = func(obj client.Object) error {
+		deps, err := fallback.ResolveDependencies(cache, obj)
+		if err != nil {
+			return fmt.Errorf("failed to resolve dependencies of %T %s/%s: %w", obj, obj.GetNamespace(), obj.GetName(), err)
+		}
+		for _, dep := range deps {
+			key := translationObjectKey(dep)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			dependencies = append(dependencies, dep)
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := visit(obj); err != nil {
+		return nil, err
+	}
+	return dependencies, nil
+}
+
+// objectsFingerprint calculates a fingerprint of the objects identified by their type, namespace, name, UID and
+// resource version, regardless of their order.
+func objectsFingerprint(objects []client.Object) string {
+	keys := lo.Map(objects, func(obj client.Object, _ int) string {
+		return fmt.Sprintf("%s/%s/%s", translationObjectKey(obj), obj.GetUID(), obj.GetResourceVersion())
+	})
+	return hashSortedKeys(keys)
+}
+
+// storesMembershipFingerprint calculates a fingerprint of keys of all objects in the cache stores. It changes
+// only when objects are added or removed.
+func storesMembershipFingerprint(cache store.CacheStores) string {
+	var keys []string
+	for i, s := range cache.ListAllStores() {
+		for _, k := range s.ListKeys() {
+			keys = append(keys, fmt.Sprintf("%d/%s", i, k))
+		}
+	}
+	return hashSortedKeys(keys)
+}
+
+func hashSortedKeys(keys []string) string {
+	sort.Strings(keys)
+	h := sha256.New()
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// translationObjectKey identifies an object by its type, namespace and name.
+func translationObjectKey(obj client.Object) string {
+	return fmt.Sprintf("%T/%s/%s", obj, obj.GetNamespace(), obj.GetName())
+}
+
+// deepCopy returns a copy of the ingress rules with Kong Services deep copied so that modifications made to them
+// while building the Kong state don't affect the cached rules.
+func (ir ingressRules) deepCopy() ingressRules {
+	c := newIngressRules()
+	c.SecretNameToSNIs.merge(ir.SecretNameToSNIs)
+	for k, v := range ir.ServiceNameToServices {
+		c.ServiceNameToServices[k] = v.DeepCopy()
+	}
+	for k, v := range ir.ServiceNameToParent {
+		c.ServiceNameToParent[k] = v
+	}
+	return c
+}
+
+func asObjects[T client.Object](objs []T) []client.Object {
+	return lo.Map(objs, func(o T, _ int) client.Object { return o })
+}
+
+// translateSingle adapts a function translating a list of objects to translate a single object.
+func translateSingle[T client.Object](translate func([]T) ingressRules) func(client.Object) ingressRules {
+	return func(obj client.Object) ingressRules {
+		return translate([]T{obj.(T)})
+	}
+}
+
+func listObjects[T client.Object](list func() ([]T, error)) func() ([]client.Object, error) {
+	return func() ([]client.Object, error) {
+		objs, err := list()
+		if err != nil {
+			return nil, err
+		}
+		return asObjects(objs), nil
+	}
+}
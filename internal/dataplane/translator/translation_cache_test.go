@@ -0,0 +1,187 @@
+package translator
+
+import (
+	"testing"
+
+	"github.com/go-logr/zapr"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/builder"
+)
+
+func TestTranslator_IncrementalTranslation(t *testing.T) {
+	ingress := builder.NewIngress("foo", annotations.DefaultIngressClass).
+		WithNamespace("default").
+		WithRules(netv1.IngressRule{
+			IngressRuleValue: netv1.IngressRuleValue{
+				HTTP: &netv1.HTTPIngressRuleValue{
+					Paths: []netv1.HTTPIngressPath{
+						{
+							Path:     "/",
+							PathType: lo.ToPtr(netv1.PathTypePrefix),
+							Backend: netv1.IngressBackend{
+								Service: &netv1.IngressServiceBackend{
+									Name: "foo-svc",
+									Port: netv1.ServiceBackendPort{Number: 80},
+								},
+							},
+						},
+					},
+				},
+			},
+		}).Build()
+	ingress.ResourceVersion = "1"
+	service := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "foo-svc",
+			Namespace:       "default",
+			ResourceVersion: "1",
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Port: 80}},
+		},
+	}
+	cacheStores, err := store.NewCacheStoresFromObjs(ingress, service)
+	require.NoError(t, err)
+
+	logger := zapr.NewLogger(zap.NewNop())
+	tr, err := NewTranslator(logger, store.New(cacheStores, annotations.DefaultIngressClass, logger), "", FeatureFlags{
+		IncrementalTranslation: true,
+	}, fakeSchemaServiceProvier{})
+	require.NoError(t, err)
+
+	requireServicePaths := func(result KongConfigBuildingResult, expected string) {
+		t.Helper()
+		require.Len(t, result.KongState.Services, 1)
+		require.Equal(t, expected, *result.KongState.Services[0].Path)
+	}
+
+	result := tr.BuildKongConfig()
+	requireServicePaths(result, "/")
+	entry, ok := tr.translationCache.entries["Ingress"]
+	require.True(t, ok, "Ingress translation result should be cached")
+	cachedServiceName := entry.rules.ServiceNameToServices["default.foo-svc.80"].Name
+
+	t.Log("Modifying the cached result returned by the translator shouldn't affect the cache")
+	result.KongState.Services[0].Path = lo.ToPtr("/modified")
+	result = tr.BuildKongConfig()
+	requireServicePaths(result, "/")
+	require.Same(t, cachedServiceName, tr.translationCache.entries["Ingress"].rules.ServiceNameToServices["default.foo-svc.80"].Name,
+		"Ingress translation result should be reused")
+
+	t.Log("Updating a dependency of the Ingress should invalidate the cached result")
+	service = service.DeepCopy()
+	service.ResourceVersion = "2"
+	service.Annotations = map[string]string{annotations.AnnotationPrefix + annotations.PathKey: "/updated"}
+	require.NoError(t, cacheStores.Add(service))
+	result = tr.BuildKongConfig()
+	requireServicePaths(result, "/updated")
+	require.NotSame(t, cachedServiceName, tr.translationCache.entries["Ingress"].rules.ServiceNameToServices["default.foo-svc.80"].Name)
+}
+
+func TestTranslator_IncrementalTranslationPerObject(t *testing.T) {
+	newHTTPRoute := func(name, path string) *gatewayapi.HTTPRoute {
+		return &gatewayapi.HTTPRoute{
+			TypeMeta: gatewayapi.V1HTTPRouteTypeMeta,
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       "default",
+				UID:             k8stypes.UID(name),
+				ResourceVersion: "1",
+			},
+			Spec: gatewayapi.HTTPRouteSpec{
+				CommonRouteSpec: commonRouteSpecMock("fake-gateway"),
+				Rules: []gatewayapi.HTTPRouteRule{
+					{
+						Matches: []gatewayapi.HTTPRouteMatch{
+							builder.NewHTTPRouteMatch().WithPathPrefix(path).Build(),
+						},
+						BackendRefs: []gatewayapi.HTTPBackendRef{
+							builder.NewHTTPBackendRef("svc").WithPort(80).Build(),
+						},
+					},
+				},
+			},
+		}
+	}
+	routeA, routeB := newHTTPRoute("a", "/a"), newHTTPRoute("b", "/b")
+	service := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "svc",
+			Namespace:       "default",
+			ResourceVersion: "1",
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Port: 80}},
+		},
+	}
+	cacheStores, err := store.NewCacheStoresFromObjs(routeA, routeB, service)
+	require.NoError(t, err)
+
+	logger := zapr.NewLogger(zap.NewNop())
+	tr, err := NewTranslator(logger, store.New(cacheStores, annotations.DefaultIngressClass, logger), "", FeatureFlags{
+		IncrementalTranslation: true,
+	}, fakeSchemaServiceProvier{})
+	require.NoError(t, err)
+
+	entryKey := func(route *gatewayapi.HTTPRoute) string {
+		return "HTTPRoute/" + translationObjectKey(route)
+	}
+	// cachedServiceName returns the pointer to the name of the Kong Service cached for the HTTPRoute. It's only
+	// replaced when the HTTPRoute is translated again.
+	cachedServiceName := func(route *gatewayapi.HTTPRoute) *string {
+		t.Helper()
+		entry, ok := tr.translationCache.entries[entryKey(route)]
+		require.True(t, ok, "HTTPRoute %s translation result should be cached", route.Name)
+		return entry.rules.ServiceNameToServices["httproute.default."+route.Name+".0"].Name
+	}
+	requireRoutePaths := func(result KongConfigBuildingResult, expected ...string) {
+		t.Helper()
+		var paths []string
+		for _, s := range result.KongState.Services {
+			for _, r := range s.Routes {
+				for _, p := range r.Paths {
+					paths = append(paths, *p)
+				}
+			}
+		}
+		require.ElementsMatch(t, expected, paths)
+	}
+
+	result := tr.BuildKongConfig()
+	requireRoutePaths(result, "~/a$", "/a/", "~/b$", "/b/")
+	serviceNameA, serviceNameB := cachedServiceName(routeA), cachedServiceName(routeB)
+
+	t.Log("Updating one HTTPRoute should re-translate only that HTTPRoute")
+	routeA = newHTTPRoute("a", "/updated")
+	routeA.ResourceVersion = "2"
+	require.NoError(t, cacheStores.Add(routeA))
+	result = tr.BuildKongConfig()
+	requireRoutePaths(result, "~/updated$", "/updated/", "~/b$", "/b/")
+	require.NotSame(t, serviceNameA, cachedServiceName(routeA))
+	require.Same(t, serviceNameB, cachedServiceName(routeB), "HTTPRoute b translation result should be reused")
+
+	t.Log("Deleting an HTTPRoute should remove its translation result from the cache")
+	require.NoError(t, cacheStores.Delete(routeA))
+	result = tr.BuildKongConfig()
+	requireRoutePaths(result, "~/b$", "/b/")
+	require.NotContains(t, tr.translationCache.entries, entryKey(routeA))
+	require.NotContains(t, tr.translationCache.objects, translationObjectKey(routeA))
+}
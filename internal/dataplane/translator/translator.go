@@ -6,8 +6,10 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/kong/go-kong/kong"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	dpconf "github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/config"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/kongstate"
@@ -47,11 +49,29 @@ type FeatureFlags struct {
 	// RewriteURIs enables the translator to translate the konghq.com/rewrite annotation to the proper set of Kong plugins.
 	RewriteURIs bool
 
-	// KongServiceFacade indicates whether we should support KongServiceFacades as Ingress backends.
+	// KongServiceFacade indicates whether we should support KongServiceFacades as Ingress and Gateway API routes' backends.
 	KongServiceFacade bool
 
+	// KongUpstreamTarget indicates whether we should support KongUpstreamTargets as Gateway API routes' backends.
+	KongUpstreamTarget bool
+
 	// KongCustomEntity indicates whether we should support translating custom entities from KongCustomEntity CRs.
 	KongCustomEntity bool
+
+	// ObjectsProvenance enables mapping Kubernetes objects to Kong entities they were translated to. The mapping
+	// is only consumed by the diagnostics server, so it's not built unless config dumps are enabled.
+	ObjectsProvenance bool
+
+	// IncrementalTranslation enables caching results of Kubernetes objects translation so that only objects affected
+	// by a change are translated again.
+	IncrementalTranslation bool
+
+	// MultiWorkspace enables partitioning the Kong configuration by Kong workspaces assigned to namespaces.
+	MultiWorkspace bool
+
+	// GatewaySharding enables attributing routes and certificates to Gateways so that they're configured only
+	// in Kong nodes backing the Gateways' publish Services.
+	GatewaySharding bool
 }
 
 func NewFeatureFlags(
@@ -68,6 +88,10 @@ func NewFeatureFlags(
 		RewriteURIs:                       featureGates.Enabled(featuregates.RewriteURIsFeature),
 		KongServiceFacade:                 featureGates.Enabled(featuregates.KongServiceFacade),
 		KongCustomEntity:                  featureGates.Enabled(featuregates.KongCustomEntity),
+		KongUpstreamTarget:                featureGates.Enabled(featuregates.KongUpstreamTarget),
+		IncrementalTranslation:            featureGates.Enabled(featuregates.IncrementalTranslation),
+		MultiWorkspace:                    featureGates.Enabled(featuregates.MultiWorkspace),
+		GatewaySharding:                   featureGates.Enabled(featuregates.GatewaySharding),
 	}
 }
 
@@ -91,6 +115,10 @@ type Translator struct {
 
 	failuresCollector          *failures.ResourceFailuresCollector
 	translatedObjectsCollector *ObjectsCollector
+	certificateInventory       *certificateInventory
+
+	// translationCache caches results of ingress rules translation. It's nil if incremental translation is disabled.
+	translationCache *translationCache
 }
 
 // NewTranslator produces a new Translator object provided a logging mechanism
@@ -110,6 +138,11 @@ func NewTranslator(
 		translatedObjectsCollector = NewObjectsCollector()
 	}
 
+	var translationCache *translationCache
+	if featureFlags.IncrementalTranslation {
+		translationCache = newTranslationCache()
+	}
+
 	return &Translator{
 		logger:                     logger,
 		storer:                     storer,
@@ -118,6 +151,8 @@ func NewTranslator(
 		schemaServiceProvider:      schemaServiceProvider,
 		failuresCollector:          failuresCollector,
 		translatedObjectsCollector: translatedObjectsCollector,
+		certificateInventory:       newCertificateInventory(),
+		translationCache:           translationCache,
 	}, nil
 }
 
@@ -128,14 +163,34 @@ func NewTranslator(
 // KongConfigBuildingResult is a result of Translator.BuildKongConfig method.
 type KongConfigBuildingResult struct {
 	// KongState is the Kong configuration used to configure the Gateway(s).
+	// When the MultiWorkspace feature is enabled, it holds the configuration of the default workspace only.
 	KongState *kongstate.KongState
 
+	// WorkspacesKongStates maps Kong workspaces other than the default one to the Kong configuration that should be
+	// applied in them. It's populated only when the MultiWorkspace feature is enabled.
+	WorkspacesKongStates map[string]*kongstate.KongState
+
+	// NamespacesWorkspaces maps namespaces to the Kong workspaces their objects were translated into. Namespaces
+	// translated into the default workspace are not included.
+	NamespacesWorkspaces map[string]string
+
 	// TranslationFailures is a list of resource failures that occurred during parsing.
 	// They should be used to provide users with feedback on Kubernetes objects validity.
 	TranslationFailures []failures.ResourceFailure
 
 	// ConfiguredKubernetesObjects is a list of Kubernetes objects that were successfully translated.
 	ConfiguredKubernetesObjects []client.Object
+
+	// ObjectsProvenance maps Kubernetes objects to Kong entities they were translated to.
+	ObjectsProvenance ObjectsProvenance
+
+	// GatewaysPods maps Gateways to pods backing their publish Services. It's populated only when the
+	// GatewaySharding feature is enabled.
+	GatewaysPods map[k8stypes.NamespacedName][]k8stypes.NamespacedName
+
+	// CertificateInventory describes the certificates from TLS Secrets that were translated into Kong certificates,
+	// including the expired ones that were excluded from the configuration.
+	CertificateInventory []CertificateInventoryEntry
 }
 
 // UpdateCache updates the store cache used by the translator.
@@ -148,16 +203,7 @@ func (t *Translator) UpdateCache(c store.CacheStores) {
 // defined in Kubernetes.
 func (t *Translator) BuildKongConfig() KongConfigBuildingResult {
 	// Translate and merge all rules together from all Kubernetes API sources
-	ingressRules := mergeIngressRules(
-		t.ingressRulesFromIngressV1(),
-		t.ingressRulesFromTCPIngressV1beta1(),
-		t.ingressRulesFromUDPIngressV1beta1(),
-		t.ingressRulesFromHTTPRoutes(),
-		t.ingressRulesFromUDPRoutes(),
-		t.ingressRulesFromTCPRoutes(),
-		t.ingressRulesFromTLSRoutes(),
-		t.ingressRulesFromGRPCRoutes(),
-	)
+	ingressRules := mergeIngressRules(t.translateIngressRules()...)
 
 	// populate any Kubernetes Service objects relevant objects and get the
 	// services to be skipped because of annotations inconsistency
@@ -195,7 +241,7 @@ func (t *Translator) BuildKongConfig() KongConfigBuildingResult {
 	}
 
 	// process consumer groups
-	result.FillConsumerGroups(t.logger, t.storer)
+	result.FillConsumerGroups(t.logger, t.storer, t.failuresCollector)
 	for i := range result.ConsumerGroups {
 		t.registerSuccessfullyTranslatedObject(&result.ConsumerGroups[i].K8sKongConsumerGroup)
 	}
@@ -238,6 +284,15 @@ func (t *Translator) BuildKongConfig() KongConfigBuildingResult {
 	// populate CA certificates in Kong
 	result.CACertificates = t.getCACerts()
 
+	// configure TLS to upstream services targeted by BackendTLSPolicies
+	t.applyBackendTLSPolicies(&result)
+
+	// require client certificates for routes attached to Gateway listeners with frontend TLS validation
+	t.applyGatewayFrontendTLSValidation(&result)
+
+	// drop KongPlugins conflicting with plugins generated for routes
+	t.resolveRoutePluginsConflicts(&result)
+
 	if t.licenseGetter != nil && t.featureFlags.EnterpriseEdition {
 		optionalLicense := t.licenseGetter.GetLicense()
 		if l, ok := optionalLicense.Get(); ok {
@@ -245,15 +300,44 @@ func (t *Translator) BuildKongConfig() KongConfigBuildingResult {
 		}
 	}
 
+	var gatewaysPods map[k8stypes.NamespacedName][]k8stypes.NamespacedName
+	if t.featureFlags.GatewaySharding {
+		t.attributeRoutesToGateways(result.Services)
+		gatewaysPods = t.getGatewaysPods()
+	}
+
+	// Provenance is built before the state gets partitioned by workspaces so that it covers all the entities.
+	var objectsProvenance ObjectsProvenance
+	if t.featureFlags.ObjectsProvenance {
+		objectsProvenance = buildObjectsProvenance(&result)
+	}
+
+	var (
+		namespacesWorkspaces map[string]string
+		workspacesStates     map[string]*kongstate.KongState
+	)
+	if t.featureFlags.MultiWorkspace {
+		namespacesWorkspaces = t.getNamespacesWorkspaces()
+		workspacesStates = result.PartitionByWorkspace(namespacesWorkspaces)
+	}
+
 	if t.featureFlags.FillIDs {
 		// generate IDs for Kong entities
 		result.FillIDs(t.logger, t.workspace)
+		for workspace, state := range workspacesStates {
+			state.FillIDs(t.logger, workspace)
+		}
 	}
 
 	return KongConfigBuildingResult{
 		KongState:                   &result,
+		WorkspacesKongStates:        workspacesStates,
+		NamespacesWorkspaces:        namespacesWorkspaces,
 		TranslationFailures:         t.popTranslationFailures(),
 		ConfiguredKubernetesObjects: t.popConfiguredKubernetesObjects(),
+		ObjectsProvenance:           objectsProvenance,
+		GatewaysPods:                gatewaysPods,
+		CertificateInventory:        t.certificateInventory.pop(),
 	}
 }
 
@@ -270,6 +354,18 @@ func (t *Translator) InjectLicenseGetter(licenseGetter license.Getter) {
 // Translator - Private Methods
 // -----------------------------------------------------------------------------
 
+// getNamespacesWorkspaces returns a map of namespaces to the Kong workspaces assigned to them with the
+// konghq.com/workspace annotation. Namespaces assigned to the default workspace are omitted.
+func (t *Translator) getNamespacesWorkspaces() map[string]string {
+	namespacesWorkspaces := make(map[string]string)
+	for _, namespace := range t.storer.ListNamespaces() {
+		if workspace := annotations.ExtractWorkspace(namespace.Annotations); workspace != "" && workspace != t.workspace {
+			namespacesWorkspaces[namespace.Name] = workspace
+		}
+	}
+	return namespacesWorkspaces
+}
+
 // registerTranslationFailure should be called when any Kubernetes object translation failure is encountered.
 func (t *Translator) registerTranslationFailure(reason string, causingObjects ...client.Object) {
 	t.failuresCollector.PushResourceFailure(reason, causingObjects...)
@@ -4804,6 +4804,115 @@ func TestTranslator_License(t *testing.T) {
 	})
 }
 
+func TestTranslator_ObjectsProvenance(t *testing.T) {
+	s, err := store.NewFakeStore(store.FakeObjects{})
+	require.NoError(t, err)
+	p := mustNewTranslator(t, s)
+
+	t.Run("provenance is not built by default", func(t *testing.T) {
+		result := p.BuildKongConfig()
+		require.Nil(t, result.ObjectsProvenance)
+	})
+
+	t.Run("provenance is built when enabled", func(t *testing.T) {
+		p.featureFlags.ObjectsProvenance = true
+		result := p.BuildKongConfig()
+		require.NotNil(t, result.ObjectsProvenance)
+	})
+}
+
+func TestTranslator_MultiWorkspace(t *testing.T) {
+	newService := func(namespace string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "svc",
+				Namespace: namespace,
+			},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{{Name: "http", Port: 80}},
+			},
+		}
+	}
+	newIngress := func(namespace string) *netv1.Ingress {
+		return &netv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ingress",
+				Namespace: namespace,
+				Annotations: map[string]string{
+					annotations.IngressClassKey: annotations.DefaultIngressClass,
+				},
+			},
+			Spec: netv1.IngressSpec{
+				Rules: []netv1.IngressRule{
+					{
+						Host: namespace + ".example.com",
+						IngressRuleValue: netv1.IngressRuleValue{
+							HTTP: &netv1.HTTPIngressRuleValue{
+								Paths: []netv1.HTTPIngressPath{
+									{
+										Path:     "/",
+										PathType: lo.ToPtr(netv1.PathTypePrefix),
+										Backend: netv1.IngressBackend{
+											Service: &netv1.IngressServiceBackend{
+												Name: "svc",
+												Port: netv1.ServiceBackendPort{Number: 80},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	s, err := store.NewFakeStore(store.FakeObjects{
+		Namespaces: []*corev1.Namespace{
+			{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tenant-a",
+					Annotations: map[string]string{
+						annotations.AnnotationPrefix + annotations.WorkspaceKey: "workspace-a",
+					},
+				},
+			},
+		},
+		Services:    []*corev1.Service{newService("default"), newService("tenant-a")},
+		IngressesV1: []*netv1.Ingress{newIngress("default"), newIngress("tenant-a")},
+	})
+	require.NoError(t, err)
+	p := mustNewTranslator(t, s)
+
+	t.Run("workspaces are ignored when the feature is disabled", func(t *testing.T) {
+		result := p.BuildKongConfig()
+		require.Empty(t, result.TranslationFailures)
+		require.Len(t, result.KongState.Services, 2)
+		require.Empty(t, result.WorkspacesKongStates)
+		require.Empty(t, result.NamespacesWorkspaces)
+	})
+
+	t.Run("objects of namespaces assigned to workspaces are translated into their workspaces", func(t *testing.T) {
+		p.featureFlags.MultiWorkspace = true
+		result := p.BuildKongConfig()
+		require.Empty(t, result.TranslationFailures)
+		require.Equal(t, map[string]string{"tenant-a": "workspace-a"}, result.NamespacesWorkspaces)
+
+		require.Len(t, result.KongState.Services, 1)
+		require.Equal(t, "default", result.KongState.Services[0].Namespace)
+
+		require.Len(t, result.WorkspacesKongStates, 1)
+		workspaceState, ok := result.WorkspacesKongStates["workspace-a"]
+		require.True(t, ok)
+		require.Len(t, workspaceState.Services, 1)
+		require.Equal(t, "tenant-a", workspaceState.Services[0].Namespace)
+		require.NotNil(t, workspaceState.Services[0].ID, "expected IDs to be filled in workspace")
+		require.Len(t, result.ConfiguredKubernetesObjects, 4, "objects from all workspaces should be reported")
+	})
+}
+
 func TestTranslator_ConfiguredKubernetesObjects(t *testing.T) {
 	testCases := []struct {
 		name                          string
@@ -0,0 +1,116 @@
+package dataplane
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/go-logr/zapr"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/deckgen"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/store"
+)
+
+// TestTranslator_IncrementalTranslationGoldenEquivalence proves that incremental translation produces the same
+// results as full translation for all inputs of the golden tests (see TestKongClient_GoldenTests).
+//
+// For every test case, both translators share the same store. Their results are compared after the initial
+// translation, after a translation with no changes (when everything is taken from the translation cache), and
+// after every single object is removed from the store and added back.
+func TestTranslator_IncrementalTranslationGoldenEquivalence(t *testing.T) {
+	testCasesDirectories, err := os.ReadDir(goldenDir)
+	require.NoError(t, err, "failed to iterate over files in testdata/golden")
+
+	for _, testCaseDir := range testCasesDirectories {
+		testCaseDirPath := filepath.Join(goldenDir, testCaseDir.Name())
+		for _, settings := range resolveSetsOfSettingsForTestCaseDir(t, testCaseDirPath) {
+			t.Run(fmt.Sprintf("%s,settings=%s", testCaseDir.Name(), settings.name), func(t *testing.T) {
+				runIncrementalTranslationEquivalenceTest(t, filepath.Join(testCaseDirPath, inFileName), settings.featureFlags)
+			})
+		}
+	}
+}
+
+func runIncrementalTranslationEquivalenceTest(t *testing.T, k8sConfigFile string, featureFlags translator.FeatureFlags) {
+	cacheStores, err := store.NewCacheStoresFromObjYAML(extractObjectsFromYAML(t, k8sConfigFile)...)
+	require.NoError(t, err, "failed creating cache stores")
+
+	logger := zapr.NewLogger(zap.NewNop())
+	featureFlags.ReportConfiguredKubernetesObjects = true
+	featureFlags.IncrementalTranslation = false
+	fullTranslator, err := translator.NewTranslator(logger, store.New(cacheStores, "kong", logger), "", featureFlags, fakeSchemaServiceProvier{})
+	require.NoError(t, err)
+	featureFlags.IncrementalTranslation = true
+	incrementalTranslator, err := translator.NewTranslator(logger, store.New(cacheStores, "kong", logger), "", featureFlags, fakeSchemaServiceProvier{})
+	require.NoError(t, err)
+
+	requireEquivalent := func(msg string) {
+		t.Helper()
+		expected := summarizeKongConfigBuildingResult(t, fullTranslator.BuildKongConfig())
+		actual := summarizeKongConfigBuildingResult(t, incrementalTranslator.BuildKongConfig())
+		require.Equal(t, expected, actual, msg)
+	}
+
+	requireEquivalent("initial translation")
+	requireEquivalent("translation with no changes")
+
+	var objects []client.Object
+	for _, s := range cacheStores.ListAllStores() {
+		for _, o := range s.List() {
+			objects = append(objects, o.(client.Object))
+		}
+	}
+	for _, obj := range objects {
+		objDesc := fmt.Sprintf("%T %s/%s", obj, obj.GetNamespace(), obj.GetName())
+		require.NoError(t, cacheStores.Delete(obj))
+		requireEquivalent("translation after removing " + objDesc)
+		require.NoError(t, cacheStores.Add(obj))
+		requireEquivalent("translation after adding back " + objDesc)
+	}
+}
+
+// kongConfigBuildingResultSummary is a comparable representation of translator.KongConfigBuildingResult.
+type kongConfigBuildingResultSummary struct {
+	Config            string
+	Failures          []string
+	TranslatedObjects []string
+}
+
+func summarizeKongConfigBuildingResult(t *testing.T, result translator.KongConfigBuildingResult) kongConfigBuildingResultSummary {
+	t.Helper()
+
+	content := deckgen.ToDeckContent(context.Background(), zapr.NewLogger(zap.NewNop()), result.KongState, deckgen.GenerateDeckContentParams{
+		PluginSchemas: emptyPluginSchemaStore{},
+	})
+	config, err := yaml.Marshal(content)
+	require.NoError(t, err)
+
+	var summary kongConfigBuildingResultSummary
+	summary.Config = string(config)
+	// Causing objects are not compared as for Kong Services shared by multiple objects, failures are attributed
+	// to the service's parent which depends on the order objects are listed from the store in.
+	for _, f := range result.TranslationFailures {
+		summary.Failures = append(summary.Failures, f.Message())
+	}
+	for _, obj := range result.ConfiguredKubernetesObjects {
+		summary.TranslatedObjects = append(summary.TranslatedObjects, fmt.Sprintf("%T %s/%s", obj, obj.GetNamespace(), obj.GetName()))
+	}
+	sort.Strings(summary.Failures)
+	sort.Strings(summary.TranslatedObjects)
+	return summary
+}
+
+// emptyPluginSchemaStore is a stub implementation of deckgen.PluginSchemaStore returning empty schemas.
+type emptyPluginSchemaStore struct{}
+
+func (emptyPluginSchemaStore) Schema(context.Context, string) (map[string]interface{}, error) {
+	return map[string]interface{}{}, nil
+}
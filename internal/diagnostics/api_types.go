@@ -1,6 +1,12 @@
 package diagnostics
 
-import "github.com/kong/go-database-reconciler/pkg/file"
+import (
+	"time"
+
+	"github.com/kong/go-database-reconciler/pkg/file"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
+)
 
 // ConfigDumpResponse is the GET /debug/config/[successful|failed] response schema.
 type ConfigDumpResponse struct {
@@ -8,6 +14,84 @@ type ConfigDumpResponse struct {
 	Config     file.Content `json:"config"`
 }
 
+// ConfigHistoryResponse is the GET /debug/config/history response schema.
+type ConfigHistoryResponse struct {
+	// Dumps is the list of retained config dumps ordered from the oldest to the newest.
+	Dumps []ConfigHistoryEntry `json:"dumps"`
+}
+
+// ConfigHistoryEntry describes a retained config dump.
+type ConfigHistoryEntry struct {
+	// ConfigHash is the configuration hash identifying the dump.
+	ConfigHash string `json:"hash"`
+	// Timestamp is the time the dump was received at.
+	Timestamp time.Time `json:"timestamp"`
+	// Failed indicates the configuration was not accepted by the Kong Admin API.
+	Failed bool `json:"failed"`
+	// Fallback indicates the configuration was a fallback configuration.
+	Fallback bool `json:"fallback"`
+	// Workspace is the Kong workspace assigned to namespaces the configuration was applied in. It's omitted for
+	// the configuration of the default workspace.
+	Workspace string `json:"workspace,omitempty"`
+}
+
+// ConfigDiffResponse is the GET /debug/config/diff response schema.
+type ConfigDiffResponse struct {
+	// From is the config dump the diff is computed from.
+	From ConfigHistoryEntry `json:"from"`
+	// To is the config dump the diff is computed to.
+	To ConfigHistoryEntry `json:"to"`
+	// Entities is the per-entity diff between the configurations.
+	Entities ConfigEntitiesDiff `json:"entities"`
+}
+
+// ConfigEntitiesDiff is a diff of configurations' entities grouped by their type.
+type ConfigEntitiesDiff struct {
+	Services     EntitiesDiff `json:"services"`
+	Routes       EntitiesDiff `json:"routes"`
+	Plugins      EntitiesDiff `json:"plugins"`
+	Consumers    EntitiesDiff `json:"consumers"`
+	Certificates EntitiesDiff `json:"certificates"`
+}
+
+// EntitiesDiff is a diff of entities of a single type. Entities are identified by their keys: names
+// (services, routes), usernames or custom IDs (consumers), IDs (certificates) or names and the entities
+// they're attached to (plugins, e.g. "rate-limiting@service=default.echo.80").
+type EntitiesDiff struct {
+	// Added is the list of keys of added entities.
+	Added []string `json:"added,omitempty"`
+	// Removed is the list of keys of removed entities.
+	Removed []string `json:"removed,omitempty"`
+	// Modified is the list of modified entities.
+	Modified []ModifiedEntity `json:"modified,omitempty"`
+}
+
+// ModifiedEntity describes an entity that exists in both configurations, but differs between them.
+type ModifiedEntity struct {
+	// Key identifies the entity.
+	Key string `json:"key"`
+	// ChangedFields is the list of the entity's top-level fields that differ.
+	ChangedFields []string `json:"changedFields"`
+	// Before is the entity in the configuration the diff is computed from. Nested entities are omitted.
+	Before map[string]any `json:"before"`
+	// After is the entity in the configuration the diff is computed to. Nested entities are omitted.
+	After map[string]any `json:"after"`
+}
+
+// ObjectProvenanceResponse is the GET /debug/objects/{kind}/{namespace}/{name} response schema.
+type ObjectProvenanceResponse struct {
+	// Group is the object's API group.
+	Group string `json:"group"`
+	// Kind is the object's kind.
+	Kind string `json:"kind"`
+	// Namespace is the object's namespace. It's empty for cluster-scoped objects.
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the object's name.
+	Name string `json:"name"`
+	// Entities are Kong entities translated from the object.
+	Entities translator.TranslatedKongEntities `json:"entities"`
+}
+
 // FallbackResponse is the GET /debug/config/fallback response schema.
 type FallbackResponse struct {
 	// Status is the fallback configuration generation status.
@@ -49,3 +133,49 @@ type FallbackAffectedObjectMeta struct {
 	// CausingObjects is the object that triggered this
 	CausingObjects []string `json:"causingObjects,omitempty"`
 }
+
+// SyncEventResponse is the schema of events streamed by GET /debug/sync/events.
+type SyncEventResponse struct {
+	// Timestamp is the time the synchronisation started at.
+	Timestamp time.Time `json:"timestamp"`
+	// DurationMilliseconds is the time the synchronisation took in milliseconds.
+	DurationMilliseconds int64 `json:"durationMs"`
+	// Success indicates the current configuration was applied to all gateways. It's false if any gateway rejected
+	// it (even if a fallback configuration was applied afterwards) or deferred the push.
+	Success bool `json:"success"`
+	// Gateways are results of pushing configuration to gateways in the order they were completed.
+	Gateways []SyncResult `json:"gateways"`
+	// Konnect are results of pushing configuration to Konnect. It's omitted when Konnect sync is disabled.
+	Konnect []SyncResult `json:"konnect,omitempty"`
+	// TranslationFailures are failures that occurred when translating Kubernetes objects.
+	TranslationFailures []TranslationFailure `json:"translationFailures,omitempty"`
+	// Fallback describes the fallback configuration generated during the synchronisation.
+	Fallback FallbackResponse `json:"fallback"`
+}
+
+// SyncResult is a result of pushing configuration to a single gateway or Konnect.
+type SyncResult struct {
+	// URL is the base root URL of the client's Admin API.
+	URL string `json:"url"`
+	// Workspace is the Kong workspace assigned to namespaces the configuration was pushed to. It's omitted for
+	// the configuration of the default workspace.
+	Workspace string `json:"workspace,omitempty"`
+	// ConfigHash is the hash of the pushed configuration.
+	ConfigHash string `json:"hash,omitempty"`
+	// Success indicates the configuration was applied. It's false for deferred pushes.
+	Success bool `json:"success"`
+	// Fallback indicates the pushed configuration was a fallback or the last valid configuration.
+	Fallback bool `json:"fallback"`
+	// Deferred indicates the push was deferred by the adaptive push scheduler.
+	Deferred bool `json:"deferred,omitempty"`
+	// Error is the error returned when pushing the configuration.
+	Error string `json:"error,omitempty"`
+}
+
+// TranslationFailure is a failure that occurred when translating Kubernetes objects.
+type TranslationFailure struct {
+	// Message is the failure message.
+	Message string `json:"message"`
+	// CausingObjects are the objects that caused the failure.
+	CausingObjects []string `json:"causingObjects"`
+}
@@ -0,0 +1,228 @@
+package diagnostics
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kong/go-database-reconciler/pkg/file"
+	"github.com/samber/lo"
+)
+
+// DefaultConfigDumpsHistorySize is the default number of the most recent config dumps retained for diffing.
+const DefaultConfigDumpsHistorySize = 10
+
+// configDumpHistoryEntry is a config dump retained in the history along with the time it was received.
+type configDumpHistoryEntry struct {
+	dump      ConfigDump
+	timestamp time.Time
+}
+
+// configDumpHistory is a ring buffer retaining a fixed number of the most recent config dumps.
+// It's not safe for concurrent use.
+type configDumpHistory struct {
+	entries []configDumpHistoryEntry
+	// next is the index the next entry will be written at.
+	next int
+	// full indicates whether the buffer has wrapped around at least once.
+	full bool
+}
+
+func newConfigDumpHistory(size int) *configDumpHistory {
+	if size <= 0 {
+		size = DefaultConfigDumpsHistorySize
+	}
+	return &configDumpHistory{entries: make([]configDumpHistoryEntry, size)}
+}
+
+// add stores the dump in the history, overwriting the oldest one if the history is full.
+func (h *configDumpHistory) add(dump ConfigDump, timestamp time.Time) {
+	h.entries[h.next] = configDumpHistoryEntry{dump: dump, timestamp: timestamp}
+	h.next = (h.next + 1) % len(h.entries)
+	if h.next == 0 {
+		h.full = true
+	}
+}
+
+// list returns the retained entries ordered from the oldest to the newest.
+func (h *configDumpHistory) list() []configDumpHistoryEntry {
+	if !h.full {
+		return append([]configDumpHistoryEntry(nil), h.entries[:h.next]...)
+	}
+	return append(append([]configDumpHistoryEntry(nil), h.entries[h.next:]...), h.entries[:h.next]...)
+}
+
+// diffConfigs computes a per-entity diff between two configurations.
+func diffConfigs(from, to file.Content) ConfigEntitiesDiff {
+	fromEntities, toEntities := flattenConfigEntities(from), flattenConfigEntities(to)
+	return ConfigEntitiesDiff{
+		Services:     diffEntities(fromEntities.services, toEntities.services),
+		Routes:       diffEntities(fromEntities.routes, toEntities.routes),
+		Plugins:      diffEntities(fromEntities.plugins, toEntities.plugins),
+		Consumers:    diffEntities(fromEntities.consumers, toEntities.consumers),
+		Certificates: diffEntities(fromEntities.certificates, toEntities.certificates),
+	}
+}
+
+// configEntities holds entities of a configuration by their keys. Entities are kept in their JSON form
+// with nested entities (e.g. routes of a service) removed, so that a change in a nested entity is only
+// reported for the nested entity itself.
+type configEntities struct {
+	services     map[string]map[string]any
+	routes       map[string]map[string]any
+	plugins      map[string]map[string]any
+	consumers    map[string]map[string]any
+	certificates map[string]map[string]any
+}
+
+func flattenConfigEntities(content file.Content) configEntities {
+	entities := configEntities{
+		services:     map[string]map[string]any{},
+		routes:       map[string]map[string]any{},
+		plugins:      map[string]map[string]any{},
+		consumers:    map[string]map[string]any{},
+		certificates: map[string]map[string]any{},
+	}
+	addPlugin := func(plugin *file.FPlugin, scope string) {
+		entities.plugins[pluginKey(plugin, scope)] = toEntityFields(plugin)
+	}
+	addRoute := func(route *file.FRoute) {
+		key := entityKey(route.Name, route.ID)
+		entities.routes[key] = toEntityFields(route, "plugins")
+		for _, plugin := range route.Plugins {
+			addPlugin(plugin, "route="+key)
+		}
+	}
+
+	for i := range content.Services {
+		service := &content.Services[i]
+		key := entityKey(service.Name, service.ID)
+		entities.services[key] = toEntityFields(service, "routes", "plugins")
+		for _, route := range service.Routes {
+			addRoute(route)
+		}
+		for _, plugin := range service.Plugins {
+			addPlugin(plugin, "service="+key)
+		}
+	}
+	for i := range content.Routes {
+		addRoute(&content.Routes[i])
+	}
+	for i := range content.Consumers {
+		consumer := &content.Consumers[i]
+		key := entityKey(consumer.Username, consumer.CustomID, consumer.ID)
+		entities.consumers[key] = toEntityFields(consumer, "plugins")
+		for _, plugin := range consumer.Plugins {
+			addPlugin(plugin, "consumer="+key)
+		}
+	}
+	for i := range content.Plugins {
+		plugin := &content.Plugins[i]
+		var scopes []string
+		if plugin.Service != nil {
+			scopes = append(scopes, "service="+entityKey(plugin.Service.Name, plugin.Service.ID))
+		}
+		if plugin.Route != nil {
+			scopes = append(scopes, "route="+entityKey(plugin.Route.Name, plugin.Route.ID))
+		}
+		if plugin.Consumer != nil {
+			scopes = append(scopes, "consumer="+entityKey(plugin.Consumer.Username, plugin.Consumer.CustomID, plugin.Consumer.ID))
+		}
+		if plugin.ConsumerGroup != nil {
+			scopes = append(scopes, "consumer_group="+entityKey(plugin.ConsumerGroup.Name, plugin.ConsumerGroup.ID))
+		}
+		if len(scopes) == 0 {
+			scopes = append(scopes, "global")
+		}
+		addPlugin(plugin, strings.Join(scopes, ","))
+	}
+	for i := range content.Certificates {
+		certificate := &content.Certificates[i]
+		entities.certificates[entityKey(certificate.ID)] = toEntityFields(certificate)
+	}
+	return entities
+}
+
+// entityKey returns the first non-empty of the given identifiers.
+func entityKey(identifiers ...*string) string {
+	for _, id := range identifiers {
+		if id != nil && *id != "" {
+			return *id
+		}
+	}
+	return ""
+}
+
+// pluginKey identifies a plugin by its name, instance name and the entities it's attached to as plugins' IDs
+// are not guaranteed to be stable.
+func pluginKey(plugin *file.FPlugin, scope string) string {
+	key := lo.FromPtr(plugin.Name)
+	if instanceName := lo.FromPtr(plugin.InstanceName); instanceName != "" {
+		key += "/" + instanceName
+	}
+	return fmt.Sprintf("%s@%s", key, scope)
+}
+
+// toEntityFields converts an entity into its JSON fields, omitting the given nested entities' fields.
+func toEntityFields(entity any, nestedFields ...string) map[string]any {
+	b, err := json.Marshal(entity)
+	if err != nil {
+		return map[string]any{"error": err.Error()}
+	}
+	fields := map[string]any{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return map[string]any{"error": err.Error()}
+	}
+	for _, nested := range nestedFields {
+		delete(fields, nested)
+	}
+	return fields
+}
+
+func diffEntities(from, to map[string]map[string]any) EntitiesDiff {
+	var diff EntitiesDiff
+	for key, toFields := range to {
+		fromFields, ok := from[key]
+		if !ok {
+			diff.Added = append(diff.Added, key)
+			continue
+		}
+		if changedFields := diffEntityFields(fromFields, toFields); len(changedFields) > 0 {
+			diff.Modified = append(diff.Modified, ModifiedEntity{
+				Key:           key,
+				ChangedFields: changedFields,
+				Before:        fromFields,
+				After:         toFields,
+			})
+		}
+	}
+	for key := range from {
+		if _, ok := to[key]; !ok {
+			diff.Removed = append(diff.Removed, key)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Slice(diff.Modified, func(i, j int) bool { return diff.Modified[i].Key < diff.Modified[j].Key })
+	return diff
+}
+
+// diffEntityFields returns sorted names of top-level fields that differ between two entities.
+func diffEntityFields(from, to map[string]any) []string {
+	var changed []string
+	for field, toValue := range to {
+		if fromValue, ok := from[field]; !ok || !reflect.DeepEqual(fromValue, toValue) {
+			changed = append(changed, field)
+		}
+	}
+	for field := range from {
+		if _, ok := to[field]; !ok {
+			changed = append(changed, field)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
@@ -0,0 +1,209 @@
+package diagnostics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/kong/go-database-reconciler/pkg/file"
+	"github.com/kong/go-kong/kong"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigDumpHistory(t *testing.T) {
+	h := newConfigDumpHistory(3)
+	require.Empty(t, h.list())
+
+	hashes := func() []string {
+		return lo.Map(h.list(), func(e configDumpHistoryEntry, _ int) string { return e.dump.Meta.Hash })
+	}
+	for _, hash := range []string{"1", "2"} {
+		h.add(ConfigDump{Meta: DumpMeta{Hash: hash}}, time.Now())
+	}
+	require.Equal(t, []string{"1", "2"}, hashes())
+
+	for _, hash := range []string{"3", "4", "5"} {
+		h.add(ConfigDump{Meta: DumpMeta{Hash: hash}}, time.Now())
+	}
+	require.Equal(t, []string{"3", "4", "5"}, hashes(), "oldest dumps should be overwritten")
+
+	require.Len(t, newConfigDumpHistory(0).entries, DefaultConfigDumpsHistorySize)
+}
+
+func TestDiffConfigs(t *testing.T) {
+	from := file.Content{
+		Services: []file.FService{
+			{
+				Service: kong.Service{Name: kong.String("svc-unchanged"), Host: kong.String("unchanged")},
+				Routes: []*file.FRoute{
+					{
+						Route: kong.Route{Name: kong.String("route-modified"), Paths: kong.StringSlice("/foo")},
+						Plugins: []*file.FPlugin{
+							{Plugin: kong.Plugin{Name: kong.String("key-auth")}},
+						},
+					},
+					{Route: kong.Route{Name: kong.String("route-removed")}},
+				},
+			},
+			{Service: kong.Service{Name: kong.String("svc-modified"), Port: kong.Int(80)}},
+		},
+		Plugins: []file.FPlugin{
+			{Plugin: kong.Plugin{Name: kong.String("cors"), Config: kong.Configuration{"origins": []any{"a"}}}},
+		},
+		Consumers: []file.FConsumer{
+			{Consumer: kong.Consumer{Username: kong.String("consumer-removed")}},
+		},
+		Certificates: []file.FCertificate{
+			{ID: kong.String("cert"), Cert: kong.String("old")},
+		},
+	}
+	to := file.Content{
+		Services: []file.FService{
+			{
+				Service: kong.Service{Name: kong.String("svc-unchanged"), Host: kong.String("unchanged")},
+				Routes: []*file.FRoute{
+					{
+						Route: kong.Route{Name: kong.String("route-modified"), Paths: kong.StringSlice("/bar")},
+						Plugins: []*file.FPlugin{
+							{Plugin: kong.Plugin{Name: kong.String("key-auth")}},
+							{Plugin: kong.Plugin{Name: kong.String("rate-limiting")}},
+						},
+					},
+				},
+			},
+			{Service: kong.Service{Name: kong.String("svc-modified"), Port: kong.Int(8080)}},
+			{Service: kong.Service{Name: kong.String("svc-added")}},
+		},
+		Plugins: []file.FPlugin{
+			{Plugin: kong.Plugin{Name: kong.String("cors"), Config: kong.Configuration{"origins": []any{"a"}}}},
+		},
+		Consumers: []file.FConsumer{
+			{Consumer: kong.Consumer{CustomID: kong.String("consumer-added")}},
+		},
+		Certificates: []file.FCertificate{
+			{ID: kong.String("cert"), Cert: kong.String("new")},
+		},
+	}
+
+	diff := diffConfigs(from, to)
+
+	assert.Equal(t, []string{"svc-added"}, diff.Services.Added)
+	assert.Empty(t, diff.Services.Removed)
+	require.Len(t, diff.Services.Modified, 1, "changes of nested routes should not make a service modified")
+	assert.Equal(t, "svc-modified", diff.Services.Modified[0].Key)
+	assert.Equal(t, []string{"port"}, diff.Services.Modified[0].ChangedFields)
+	assert.EqualValues(t, 80, diff.Services.Modified[0].Before["port"])
+	assert.EqualValues(t, 8080, diff.Services.Modified[0].After["port"])
+
+	assert.Empty(t, diff.Routes.Added)
+	assert.Equal(t, []string{"route-removed"}, diff.Routes.Removed)
+	require.Len(t, diff.Routes.Modified, 1, "changes of nested plugins should not be reported as route's fields")
+	assert.Equal(t, "route-modified", diff.Routes.Modified[0].Key)
+	assert.Equal(t, []string{"paths"}, diff.Routes.Modified[0].ChangedFields)
+
+	assert.Equal(t, []string{"rate-limiting@route=route-modified"}, diff.Plugins.Added)
+	assert.Empty(t, diff.Plugins.Removed)
+	assert.Empty(t, diff.Plugins.Modified)
+
+	assert.Equal(t, []string{"consumer-added"}, diff.Consumers.Added)
+	assert.Equal(t, []string{"consumer-removed"}, diff.Consumers.Removed)
+
+	require.Len(t, diff.Certificates.Modified, 1)
+	assert.Equal(t, "cert", diff.Certificates.Modified[0].Key)
+	assert.Equal(t, []string{"cert"}, diff.Certificates.Modified[0].ChangedFields)
+}
+
+func TestDiagnosticsServer_ConfigDiff(t *testing.T) {
+	s := NewServer(logr.Discard(), ServerConfig{ConfigDumpsEnabled: true})
+	mux := http.NewServeMux()
+	s.installConfigDebugHandlers(mux)
+
+	get := func(t *testing.T, url string) *httptest.ResponseRecorder {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, url, nil))
+		return rec
+	}
+
+	t.Run("no dumps", func(t *testing.T) {
+		require.Equal(t, http.StatusNotFound, get(t, "/debug/config/diff").Code)
+	})
+
+	configWithServices := func(names ...string) file.Content {
+		return file.Content{Services: lo.Map(names, func(name string, _ int) file.FService {
+			return file.FService{Service: kong.Service{Name: kong.String(name)}}
+		})}
+	}
+	s.onConfigDump(ConfigDump{Config: configWithServices("a"), Meta: DumpMeta{Hash: "hash-1"}})
+
+	t.Run("single dump", func(t *testing.T) {
+		require.Equal(t, http.StatusNotFound, get(t, "/debug/config/diff").Code)
+	})
+
+	s.onConfigDump(ConfigDump{Config: configWithServices("a", "b"), Meta: DumpMeta{Hash: "hash-2", Failed: true}})
+	s.onConfigDump(ConfigDump{Config: configWithServices("c"), Meta: DumpMeta{Hash: "hash-3"}})
+
+	t.Run("history", func(t *testing.T) {
+		rec := get(t, "/debug/config/history")
+		require.Equal(t, http.StatusOK, rec.Code)
+		var resp ConfigHistoryResponse
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+		require.Len(t, resp.Dumps, 3)
+		require.Equal(t, "hash-2", resp.Dumps[1].ConfigHash)
+		require.True(t, resp.Dumps[1].Failed)
+	})
+
+	testCases := []struct {
+		name            string
+		url             string
+		expectedFrom    string
+		expectedTo      string
+		expectedAdded   []string
+		expectedRemoved []string
+	}{
+		{
+			name:            "defaults to the two newest dumps",
+			url:             "/debug/config/diff",
+			expectedFrom:    "hash-2",
+			expectedTo:      "hash-3",
+			expectedAdded:   []string{"c"},
+			expectedRemoved: []string{"a", "b"},
+		},
+		{
+			name:          "from the dump preceding the requested one",
+			url:           "/debug/config/diff?to=hash-2",
+			expectedFrom:  "hash-1",
+			expectedTo:    "hash-2",
+			expectedAdded: []string{"b"},
+		},
+		{
+			name:            "between requested dumps",
+			url:             "/debug/config/diff?from=hash-1&to=hash-3",
+			expectedFrom:    "hash-1",
+			expectedTo:      "hash-3",
+			expectedAdded:   []string{"c"},
+			expectedRemoved: []string{"a"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := get(t, tc.url)
+			require.Equal(t, http.StatusOK, rec.Code)
+			var resp ConfigDiffResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Equal(t, tc.expectedFrom, resp.From.ConfigHash)
+			assert.Equal(t, tc.expectedTo, resp.To.ConfigHash)
+			assert.Equal(t, tc.expectedAdded, resp.Entities.Services.Added)
+			assert.Equal(t, tc.expectedRemoved, resp.Entities.Services.Removed)
+		})
+	}
+
+	t.Run("unknown hash", func(t *testing.T) {
+		require.Equal(t, http.StatusNotFound, get(t, "/debug/config/diff?from=unknown").Code)
+	})
+}
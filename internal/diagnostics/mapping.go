@@ -2,7 +2,9 @@ package diagnostics
 
 import (
 	"github.com/samber/lo"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/fallback"
 )
 
@@ -46,3 +48,44 @@ func mapFallbackCacheMetadataIntoFallbackResponse(meta *fallback.GeneratedCacheM
 		BackfilledObjects: mapAffectedObjectsMeta(meta.BackfilledObjects),
 	}
 }
+
+// mapSyncEventIntoSyncEventResponse maps the sync event into a SyncEventResponse.
+func mapSyncEventIntoSyncEventResponse(event SyncEvent) SyncEventResponse {
+	resp := SyncEventResponse{
+		Timestamp:            event.Timestamp,
+		DurationMilliseconds: event.Duration.Milliseconds(),
+		Success:              true,
+		Gateways:             []SyncResult{},
+		TranslationFailures: lo.Map(event.TranslationFailures, func(f failures.ResourceFailure, _ int) TranslationFailure {
+			return TranslationFailure{
+				Message: f.Message(),
+				CausingObjects: lo.Map(f.CausingObjects(), func(obj client.Object, _ int) string {
+					return fallback.GetObjectHash(obj).String()
+				}),
+			}
+		}),
+		Fallback: mapFallbackCacheMetadataIntoFallbackResponse(event.FallbackCacheMetadata),
+	}
+	for _, result := range event.Results {
+		syncResult := SyncResult{
+			URL:        result.URL,
+			Workspace:  result.Workspace,
+			ConfigHash: result.Hash,
+			Success:    result.Err == nil && !result.Deferred,
+			Fallback:   result.Fallback,
+			Deferred:   result.Deferred,
+		}
+		if result.Err != nil {
+			syncResult.Error = result.Err.Error()
+		}
+		if result.Konnect {
+			resp.Konnect = append(resp.Konnect, syncResult)
+			continue
+		}
+		if !syncResult.Success || syncResult.Fallback {
+			resp.Success = false
+		}
+		resp.Gateways = append(resp.Gateways, syncResult)
+	}
+	return resp
+}
@@ -5,15 +5,20 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"net/http/pprof"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/kong/go-database-reconciler/pkg/file"
+	"github.com/samber/lo"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/fallback"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
 )
 
@@ -41,10 +46,32 @@ type Server struct {
 	lastFailedHash       string
 	lastRawErrBody       []byte
 
+	// workspacesConfigDumps holds the most recent config dumps of Kong workspaces assigned to namespaces.
+	workspacesConfigDumps map[string]*workspaceConfigDumps
+
+	// configHistory retains the most recent config dumps, so they can be diffed.
+	configHistory *configDumpHistory
+
 	currentFallbackCacheMetadata *fallback.GeneratedCacheMetadata
 
-	configLock   *sync.RWMutex
-	fallbackLock *sync.RWMutex
+	currentObjectsProvenance translator.ObjectsProvenance
+
+	// syncEventsSubscribers fans out sync events to clients streaming them. It's nil if sync events are disabled.
+	syncEventsSubscribers *syncEventsSubscribers
+
+	configLock     *sync.RWMutex
+	fallbackLock   *sync.RWMutex
+	provenanceLock *sync.RWMutex
+}
+
+// workspaceConfigDumps holds the most recent config dumps of a Kong workspace assigned to namespaces.
+type workspaceConfigDumps struct {
+	lastSuccessfulConfigDump file.Content
+	lastSuccessHash          string
+
+	lastFailedConfigDump file.Content
+	lastFailedHash       string
+	lastRawErrBody       []byte
 }
 
 // ServerConfig contains configuration for the diagnostics server.
@@ -57,6 +84,13 @@ type ServerConfig struct {
 
 	// DumpSensitiveConfig makes config dumps to include sensitive information.
 	DumpSensitiveConfig bool
+
+	// ConfigDumpsHistorySize is the number of the most recent config dumps retained for diffing.
+	// DefaultConfigDumpsHistorySize is used when it's not positive.
+	ConfigDumpsHistorySize int
+
+	// SyncEventsEnabled enables the sync events stream endpoint.
+	SyncEventsEnabled bool
 }
 
 // NewServer creates a diagnostics server ready to start listening.
@@ -66,6 +100,7 @@ func NewServer(logger logr.Logger, cfg ServerConfig) Server {
 		profilingEnabled: cfg.ProfilingEnabled,
 		configLock:       &sync.RWMutex{},
 		fallbackLock:     &sync.RWMutex{},
+		provenanceLock:   &sync.RWMutex{},
 	}
 
 	if cfg.ConfigDumpsEnabled {
@@ -73,14 +108,21 @@ func NewServer(logger logr.Logger, cfg ServerConfig) Server {
 			DumpsIncludeSensitive: cfg.DumpSensitiveConfig,
 			Configs:               make(chan ConfigDump, diagnosticConfigBufferDepth),
 			FallbackCacheMetadata: make(chan fallback.GeneratedCacheMetadata, diagnosticConfigBufferDepth),
+			ObjectsProvenance:     make(chan translator.ObjectsProvenance, diagnosticConfigBufferDepth),
 		}
+		s.configHistory = newConfigDumpHistory(cfg.ConfigDumpsHistorySize)
+		s.workspacesConfigDumps = make(map[string]*workspaceConfigDumps)
+	}
+	if cfg.SyncEventsEnabled {
+		s.configDumps.SyncEvents = make(chan SyncEvent, diagnosticConfigBufferDepth)
+		s.syncEventsSubscribers = newSyncEventsSubscribers()
 	}
 
 	return s
 }
 
 // ConfigDumps returns an object allowing dumping succeeded and failed configuration updates.
-// It will return a zero value of the type in case the config dumps are not enabled.
+// Channels of diagnostics that are not enabled are nil.
 func (s *Server) ConfigDumps() ConfigDumpDiagnostic {
 	return s.configDumps
 }
@@ -88,9 +130,12 @@ func (s *Server) ConfigDumps() ConfigDumpDiagnostic {
 // Listen starts up the HTTP server and blocks until ctx expires.
 func (s *Server) Listen(ctx context.Context, port int) error {
 	mux := http.NewServeMux()
-	if s.configDumps != (ConfigDumpDiagnostic{}) {
+	if s.configDumps.Configs != nil {
 		s.installConfigDebugHandlers(mux)
 	}
+	if s.configDumps.SyncEvents != nil {
+		s.installSyncEventsHandlers(mux)
+	}
 	if s.profilingEnabled {
 		installProfilingHandlers(mux)
 	}
@@ -99,6 +144,8 @@ func (s *Server) Listen(ctx context.Context, port int) error {
 		Addr:              fmt.Sprintf(":%d", port),
 		Handler:           mux,
 		ReadHeaderTimeout: defaultHTTPReadHeaderTimeout,
+		// Requests' contexts are derived from ctx, so that long-lived sync events streams are closed on shutdown.
+		BaseContext: func(net.Listener) context.Context { return ctx },
 	}
 	errChan := make(chan error)
 
@@ -133,6 +180,10 @@ func (s *Server) receiveConfig(ctx context.Context) {
 			s.onConfigDump(dump)
 		case meta := <-s.configDumps.FallbackCacheMetadata:
 			s.onFallbackCacheMetadata(meta)
+		case provenance := <-s.configDumps.ObjectsProvenance:
+			s.onObjectsProvenance(provenance)
+		case event := <-s.configDumps.SyncEvents:
+			s.onSyncEvent(event)
 		case <-ctx.Done():
 			if err := ctx.Err(); err != nil && !errors.Is(err, context.Canceled) {
 				s.logger.Error(err, "Shutting down diagnostic config collection: context completed with error")
@@ -148,6 +199,25 @@ func (s *Server) onConfigDump(dump ConfigDump) {
 	s.configLock.Lock()
 	defer s.configLock.Unlock()
 
+	s.configHistory.add(dump, time.Now())
+
+	if workspace := dump.Meta.Workspace; workspace != "" {
+		dumps, ok := s.workspacesConfigDumps[workspace]
+		if !ok {
+			dumps = &workspaceConfigDumps{}
+			s.workspacesConfigDumps[workspace] = dumps
+		}
+		if dump.Meta.Failed {
+			dumps.lastFailedConfigDump = dump.Config
+			dumps.lastFailedHash = dump.Meta.Hash
+			dumps.lastRawErrBody = dump.RawResponseBody
+		} else {
+			dumps.lastSuccessfulConfigDump = dump.Config
+			dumps.lastSuccessHash = dump.Meta.Hash
+		}
+		return
+	}
+
 	if dump.Meta.Failed {
 		// If the config push failed, we need to keep the failed config dump and the raw error body.
 		s.lastFailedConfigDump = dump.Config
@@ -174,6 +244,12 @@ func (s *Server) onFallbackCacheMetadata(meta fallback.GeneratedCacheMetadata) {
 	s.currentFallbackCacheMetadata = &meta
 }
 
+func (s *Server) onObjectsProvenance(provenance translator.ObjectsProvenance) {
+	s.provenanceLock.Lock()
+	defer s.provenanceLock.Unlock()
+	s.currentObjectsProvenance = provenance
+}
+
 // installProfilingHandlers adds the Profiling webservice to the given mux.
 func installProfilingHandlers(mux *http.ServeMux) {
 	mux.HandleFunc("/debug/pprof", redirectTo("/debug/pprof/"))
@@ -195,6 +271,10 @@ func (s *Server) installConfigDebugHandlers(mux *http.ServeMux) {
 	mux.HandleFunc("/debug/config/failed", s.handleLastFailedConfig)
 	mux.HandleFunc("/debug/config/fallback", s.handleCurrentFallback)
 	mux.HandleFunc("/debug/config/raw-error", s.handleLastErrBody)
+	mux.HandleFunc("/debug/config/history", s.handleConfigHistory)
+	mux.HandleFunc("/debug/config/diff", s.handleConfigDiff)
+	mux.HandleFunc("GET /debug/objects/{kind}/{namespace}/{name}", s.handleObjectProvenance)
+	mux.HandleFunc("GET /debug/objects/{kind}/{name}", s.handleObjectProvenance)
 }
 
 // redirectTo redirects request to a certain destination.
@@ -204,28 +284,42 @@ func redirectTo(to string) func(http.ResponseWriter, *http.Request) {
 	}
 }
 
-func (s *Server) handleLastValidConfig(rw http.ResponseWriter, _ *http.Request) {
+func (s *Server) handleLastValidConfig(rw http.ResponseWriter, req *http.Request) {
 	rw.Header().Set("Content-Type", "application/json")
 	s.configLock.RLock()
 	defer s.configLock.RUnlock()
-	if err := json.NewEncoder(rw).Encode(
-		ConfigDumpResponse{
-			Config:     s.lastSuccessfulConfigDump,
-			ConfigHash: s.lastSuccessHash,
-		}); err != nil {
+	resp := ConfigDumpResponse{
+		Config:     s.lastSuccessfulConfigDump,
+		ConfigHash: s.lastSuccessHash,
+	}
+	if workspace := req.URL.Query().Get("workspace"); workspace != "" {
+		dumps := s.getWorkspaceConfigDumps(workspace)
+		resp = ConfigDumpResponse{
+			Config:     dumps.lastSuccessfulConfigDump,
+			ConfigHash: dumps.lastSuccessHash,
+		}
+	}
+	if err := json.NewEncoder(rw).Encode(resp); err != nil {
 		rw.WriteHeader(http.StatusInternalServerError)
 	}
 }
 
-func (s *Server) handleLastFailedConfig(rw http.ResponseWriter, _ *http.Request) {
+func (s *Server) handleLastFailedConfig(rw http.ResponseWriter, req *http.Request) {
 	rw.Header().Set("Content-Type", "application/json")
 	s.configLock.RLock()
 	defer s.configLock.RUnlock()
-	if err := json.NewEncoder(rw).Encode(
-		ConfigDumpResponse{
-			Config:     s.lastFailedConfigDump,
-			ConfigHash: s.lastFailedHash,
-		}); err != nil {
+	resp := ConfigDumpResponse{
+		Config:     s.lastFailedConfigDump,
+		ConfigHash: s.lastFailedHash,
+	}
+	if workspace := req.URL.Query().Get("workspace"); workspace != "" {
+		dumps := s.getWorkspaceConfigDumps(workspace)
+		resp = ConfigDumpResponse{
+			Config:     dumps.lastFailedConfigDump,
+			ConfigHash: dumps.lastFailedHash,
+		}
+	}
+	if err := json.NewEncoder(rw).Encode(resp); err != nil {
 		rw.WriteHeader(http.StatusInternalServerError)
 	}
 }
@@ -240,11 +334,14 @@ func (s *Server) handleCurrentFallback(rw http.ResponseWriter, _ *http.Request)
 	}
 }
 
-func (s *Server) handleLastErrBody(rw http.ResponseWriter, _ *http.Request) {
+func (s *Server) handleLastErrBody(rw http.ResponseWriter, req *http.Request) {
 	rw.Header().Set("Content-Type", "text/plain")
 	s.configLock.RLock()
 	defer s.configLock.RUnlock()
 	raw := s.lastRawErrBody
+	if workspace := req.URL.Query().Get("workspace"); workspace != "" {
+		raw = s.getWorkspaceConfigDumps(workspace).lastRawErrBody
+	}
 	if len(raw) == 0 {
 		raw = []byte("No raw error body available.\n")
 	}
@@ -252,3 +349,142 @@ func (s *Server) handleLastErrBody(rw http.ResponseWriter, _ *http.Request) {
 		rw.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+func (s *Server) handleConfigHistory(rw http.ResponseWriter, req *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	s.configLock.RLock()
+	defer s.configLock.RUnlock()
+	entries := s.listConfigHistory(req.URL.Query().Get("workspace"))
+	resp := ConfigHistoryResponse{Dumps: make([]ConfigHistoryEntry, 0, len(entries))}
+	for _, entry := range entries {
+		resp.Dumps = append(resp.Dumps, toConfigHistoryEntry(entry))
+	}
+	if err := json.NewEncoder(rw).Encode(resp); err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// handleConfigDiff responds with a per-entity diff between two retained config dumps identified by their
+// hashes passed in the "from" and "to" query parameters. When "to" is omitted, the newest dump is used.
+// When "from" is omitted, the dump preceding "to" is used.
+func (s *Server) handleConfigDiff(rw http.ResponseWriter, req *http.Request) {
+	s.configLock.RLock()
+	defer s.configLock.RUnlock()
+
+	entries := s.listConfigHistory(req.URL.Query().Get("workspace"))
+	if len(entries) == 0 {
+		http.Error(rw, "No config dumps available.", http.StatusNotFound)
+		return
+	}
+
+	toIdx := len(entries) - 1
+	if toHash := req.URL.Query().Get("to"); toHash != "" {
+		toIdx = lastIndexOfConfigHash(entries, toHash)
+		if toIdx < 0 {
+			http.Error(rw, fmt.Sprintf("Config dump with hash %q not found.", toHash), http.StatusNotFound)
+			return
+		}
+	}
+	fromIdx := toIdx - 1
+	if fromHash := req.URL.Query().Get("from"); fromHash != "" {
+		fromIdx = lastIndexOfConfigHash(entries, fromHash)
+		if fromIdx < 0 {
+			http.Error(rw, fmt.Sprintf("Config dump with hash %q not found.", fromHash), http.StatusNotFound)
+			return
+		}
+	}
+	if fromIdx < 0 {
+		http.Error(rw, "No preceding config dump to compute the diff from.", http.StatusNotFound)
+		return
+	}
+
+	from, to := entries[fromIdx], entries[toIdx]
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(ConfigDiffResponse{
+		From:     toConfigHistoryEntry(from),
+		To:       toConfigHistoryEntry(to),
+		Entities: diffConfigs(from.dump.Config, to.dump.Config),
+	}); err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// getWorkspaceConfigDumps returns the most recent config dumps of the given workspace. A zero value is returned
+// if there are no dumps for the workspace.
+func (s *Server) getWorkspaceConfigDumps(workspace string) workspaceConfigDumps {
+	if dumps, ok := s.workspacesConfigDumps[workspace]; ok {
+		return *dumps
+	}
+	return workspaceConfigDumps{}
+}
+
+// listConfigHistory returns the retained config dumps of the given workspace ordered from the oldest to the newest.
+// An empty workspace denotes the default one.
+func (s *Server) listConfigHistory(workspace string) []configDumpHistoryEntry {
+	return lo.Filter(s.configHistory.list(), func(entry configDumpHistoryEntry, _ int) bool {
+		return entry.dump.Meta.Workspace == workspace
+	})
+}
+
+// lastIndexOfConfigHash returns the index of the newest entry with the given config hash or -1 if there's none.
+func lastIndexOfConfigHash(entries []configDumpHistoryEntry, hash string) int {
+	for i := len(entries) - 1; i >= 0; i-- {
+		if entries[i].dump.Meta.Hash == hash {
+			return i
+		}
+	}
+	return -1
+}
+
+func toConfigHistoryEntry(entry configDumpHistoryEntry) ConfigHistoryEntry {
+	return ConfigHistoryEntry{
+		ConfigHash: entry.dump.Meta.Hash,
+		Timestamp:  entry.timestamp,
+		Failed:     entry.dump.Meta.Failed,
+		Fallback:   entry.dump.Meta.Fallback,
+		Workspace:  entry.dump.Meta.Workspace,
+	}
+}
+
+// handleObjectProvenance responds with Kong entities that were translated from a Kubernetes object in
+// the most recent translation. The object's kind is matched case-insensitively. The "group" query
+// parameter can be used to disambiguate kinds existing in multiple API groups.
+func (s *Server) handleObjectProvenance(rw http.ResponseWriter, req *http.Request) {
+	var (
+		kind      = req.PathValue("kind")
+		namespace = req.PathValue("namespace")
+		name      = req.PathValue("name")
+		group     = req.URL.Query().Get("group")
+	)
+
+	s.provenanceLock.RLock()
+	defer s.provenanceLock.RUnlock()
+
+	matching := lo.Filter(lo.Keys(s.currentObjectsProvenance), func(ref translator.ObjectRef, _ int) bool {
+		return strings.EqualFold(ref.Kind, kind) && ref.Namespace == namespace && ref.Name == name &&
+			(group == "" || ref.Group == group)
+	})
+	if len(matching) == 0 {
+		http.Error(rw, "No Kong entities were translated from the object.", http.StatusNotFound)
+		return
+	}
+	if len(matching) > 1 {
+		groups := lo.Map(matching, func(ref translator.ObjectRef, _ int) string { return ref.Group })
+		sort.Strings(groups)
+		http.Error(rw, fmt.Sprintf("Kind %s exists in multiple groups (%s), use the group query parameter to pick one.",
+			kind, strings.Join(groups, ", ")), http.StatusBadRequest)
+		return
+	}
+
+	ref := matching[0]
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(ObjectProvenanceResponse{
+		Group:     ref.Group,
+		Kind:      ref.Kind,
+		Namespace: ref.Namespace,
+		Name:      ref.Name,
+		Entities:  *s.currentObjectsProvenance[ref],
+	}); err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -2,8 +2,10 @@ package diagnostics
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/fallback"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
 	testhelpers "github.com/kong/kubernetes-ingress-controller/v3/test/helpers"
 )
 
@@ -142,4 +145,103 @@ func TestServer_EventsHandling(t *testing.T) {
 		require.Equal(t, successfulDump.Meta.Hash, s.lastSuccessHash)
 		require.Nil(t, s.currentFallbackCacheMetadata, "expected fallback cache metadata to be dropped as it's no more relevant")
 	})
+	t.Run("on workspace config dumps", func(t *testing.T) {
+		workspaceSuccessfulDump := successfulDump
+		workspaceSuccessfulDump.Meta.Workspace = "tenant-a"
+		workspaceSuccessfulDump.Meta.Hash = "workspace-success-hash"
+		workspaceFailedDump := failedDump
+		workspaceFailedDump.Meta.Workspace = "tenant-a"
+
+		s.onConfigDump(workspaceSuccessfulDump)
+		s.onConfigDump(workspaceFailedDump)
+		dumps := s.getWorkspaceConfigDumps("tenant-a")
+		require.Equal(t, workspaceSuccessfulDump.Config, dumps.lastSuccessfulConfigDump)
+		require.Equal(t, workspaceSuccessfulDump.Meta.Hash, dumps.lastSuccessHash)
+		require.Equal(t, workspaceFailedDump.Config, dumps.lastFailedConfigDump)
+		require.Equal(t, workspaceFailedDump.RawResponseBody, dumps.lastRawErrBody)
+		require.Equal(t, successfulDump.Meta.Hash, s.lastSuccessHash, "expected default workspace dumps to be unaffected")
+		require.Len(t, s.listConfigHistory("tenant-a"), 2)
+	})
+}
+
+func TestDiagnosticsServer_ObjectProvenance(t *testing.T) {
+	s := NewServer(logr.Discard(), ServerConfig{ConfigDumpsEnabled: true})
+	mux := http.NewServeMux()
+	s.installConfigDebugHandlers(mux)
+
+	s.onObjectsProvenance(translator.ObjectsProvenance{
+		{Group: "gateway.networking.k8s.io", Kind: "HTTPRoute", Namespace: "default", Name: "route"}: {
+			Routes: []string{"httproute.default.route.0.0"},
+		},
+		{Group: "configuration.konghq.com", Kind: "KongClusterPlugin", Name: "plugin"}: {
+			Plugins: []string{"key-auth@global"},
+		},
+		{Group: "example.com", Kind: "HTTPRoute", Namespace: "default", Name: "ambiguous"}:               {},
+		{Group: "gateway.networking.k8s.io", Kind: "HTTPRoute", Namespace: "default", Name: "ambiguous"}: {},
+	})
+
+	testCases := []struct {
+		name             string
+		url              string
+		expectedCode     int
+		expectedResponse ObjectProvenanceResponse
+	}{
+		{
+			name:         "namespaced object with case-insensitive kind",
+			url:          "/debug/objects/httproute/default/route",
+			expectedCode: http.StatusOK,
+			expectedResponse: ObjectProvenanceResponse{
+				Group:     "gateway.networking.k8s.io",
+				Kind:      "HTTPRoute",
+				Namespace: "default",
+				Name:      "route",
+				Entities:  translator.TranslatedKongEntities{Routes: []string{"httproute.default.route.0.0"}},
+			},
+		},
+		{
+			name:         "cluster-scoped object",
+			url:          "/debug/objects/KongClusterPlugin/plugin",
+			expectedCode: http.StatusOK,
+			expectedResponse: ObjectProvenanceResponse{
+				Group:    "configuration.konghq.com",
+				Kind:     "KongClusterPlugin",
+				Name:     "plugin",
+				Entities: translator.TranslatedKongEntities{Plugins: []string{"key-auth@global"}},
+			},
+		},
+		{
+			name:         "kind existing in multiple groups",
+			url:          "/debug/objects/HTTPRoute/default/ambiguous",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "kind existing in multiple groups disambiguated with group",
+			url:          "/debug/objects/HTTPRoute/default/ambiguous?group=example.com",
+			expectedCode: http.StatusOK,
+			expectedResponse: ObjectProvenanceResponse{
+				Group:     "example.com",
+				Kind:      "HTTPRoute",
+				Namespace: "default",
+				Name:      "ambiguous",
+			},
+		},
+		{
+			name:         "unknown object",
+			url:          "/debug/objects/HTTPRoute/default/unknown",
+			expectedCode: http.StatusNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.url, nil))
+			require.Equal(t, tc.expectedCode, rec.Code, rec.Body.String())
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+			var resp ObjectProvenanceResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.Equal(t, tc.expectedResponse, resp)
+		})
+	}
 }
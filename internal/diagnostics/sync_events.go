@@ -0,0 +1,100 @@
+package diagnostics
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/util"
+)
+
+// syncEventsSubscriberBufferDepth is the number of sync events buffered for a single subscriber. Subscribers
+// that do not keep up with reading the stream miss events that do not fit into the buffer.
+const syncEventsSubscriberBufferDepth = 16
+
+// syncEventsSubscribers fans out sync events to all clients streaming them.
+type syncEventsSubscribers struct {
+	lock        sync.Mutex
+	subscribers map[chan SyncEventResponse]struct{}
+}
+
+func newSyncEventsSubscribers() *syncEventsSubscribers {
+	return &syncEventsSubscribers{
+		subscribers: make(map[chan SyncEventResponse]struct{}),
+	}
+}
+
+// subscribe registers a new subscriber. The returned function must be called to unregister it.
+func (s *syncEventsSubscribers) subscribe() (<-chan SyncEventResponse, func()) {
+	ch := make(chan SyncEventResponse, syncEventsSubscriberBufferDepth)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.subscribers[ch] = struct{}{}
+	return ch, func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		delete(s.subscribers, ch)
+	}
+}
+
+// publish sends the event to all subscribers without blocking. It returns the number of subscribers
+// the event was dropped for because their buffers were full.
+func (s *syncEventsSubscribers) publish(event SyncEventResponse) (dropped int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for ch := range s.subscribers {
+		select {
+		case ch <- event:
+		default:
+			dropped++
+		}
+	}
+	return dropped
+}
+
+func (s *Server) onSyncEvent(event SyncEvent) {
+	if dropped := s.syncEventsSubscribers.publish(mapSyncEventIntoSyncEventResponse(event)); dropped > 0 {
+		s.logger.V(util.DebugLevel).Info("Sync event dropped for slow subscribers", "count", dropped)
+	}
+}
+
+// installSyncEventsHandlers adds the sync events webservice to the given mux.
+func (s *Server) installSyncEventsHandlers(mux *http.ServeMux) {
+	mux.HandleFunc("GET /debug/sync/events", s.handleSyncEvents)
+}
+
+// handleSyncEvents streams sync events as server-sent events until the client disconnects. Every event is
+// a single "sync" event with a SyncEventResponse JSON as its data.
+func (s *Server) handleSyncEvents(rw http.ResponseWriter, req *http.Request) {
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		http.Error(rw, "Streaming is not supported.", http.StatusInternalServerError)
+		return
+	}
+
+	events, unsubscribe := s.syncEventsSubscribers.subscribe()
+	defer unsubscribe()
+
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case event := <-events:
+			data, err := json.Marshal(event)
+			if err != nil {
+				s.logger.Error(err, "Failed to marshal sync event")
+				continue
+			}
+			if _, err := fmt.Fprintf(rw, "event: sync\ndata: %s\n\n", data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package diagnostics
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/fallback"
+)
+
+func TestDiagnosticsServer_SyncEvents(t *testing.T) {
+	s := NewServer(logr.Discard(), ServerConfig{SyncEventsEnabled: true})
+	require.NotNil(t, s.ConfigDumps().SyncEvents)
+	require.Nil(t, s.ConfigDumps().Configs, "config dumps should stay disabled")
+
+	mux := http.NewServeMux()
+	s.installSyncEventsHandlers(mux)
+	httpServer := httptest.NewServer(mux)
+	t.Cleanup(httpServer.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, httpServer.URL+"/debug/sync/events", nil)
+	require.NoError(t, err)
+	resp, err := httpServer.Client().Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	timestamp := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	// The subscription is registered before the response headers are sent, so the event can't be missed.
+	s.onSyncEvent(SyncEvent{
+		Timestamp: timestamp,
+		Duration:  1500 * time.Millisecond,
+		Results: []ClientSyncResult{
+			{URL: "https://gateway-1:8444", Hash: "current-hash", Err: errors.New("rejected")},
+			{URL: "https://konnect", Konnect: true, Hash: "current-hash"},
+			{URL: "https://gateway-1:8444", Hash: "fallback-hash", Fallback: true},
+			{URL: "https://gateway-2:8444", Hash: "old-hash", Deferred: true},
+		},
+		FallbackCacheMetadata: &fallback.GeneratedCacheMetadata{
+			BrokenObjects: []fallback.ObjectHash{{Kind: "KongConsumer", Namespace: "default", Name: "broken"}},
+		},
+	})
+
+	reader := bufio.NewReader(resp.Body)
+	eventLine, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "event: sync\n", eventLine)
+	dataLine, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	data, ok := strings.CutPrefix(strings.TrimSuffix(dataLine, "\n"), "data: ")
+	require.True(t, ok, "expected data line, got %q", dataLine)
+
+	var event SyncEventResponse
+	require.NoError(t, json.Unmarshal([]byte(data), &event))
+	require.Equal(t, SyncEventResponse{
+		Timestamp:            timestamp,
+		DurationMilliseconds: 1500,
+		Success:              false,
+		Gateways: []SyncResult{
+			{URL: "https://gateway-1:8444", ConfigHash: "current-hash", Error: "rejected"},
+			{URL: "https://gateway-1:8444", ConfigHash: "fallback-hash", Success: true, Fallback: true},
+			{URL: "https://gateway-2:8444", ConfigHash: "old-hash", Deferred: true},
+		},
+		Konnect: []SyncResult{
+			{URL: "https://konnect", ConfigHash: "current-hash", Success: true},
+		},
+		Fallback: FallbackResponse{
+			Status: FallbackStatusTriggered,
+			BrokenObjects: []FallbackAffectedObjectMeta{
+				{Kind: "KongConsumer", Namespace: "default", Name: "broken"},
+			},
+		},
+	}, event)
+
+	t.Log("Verifying that the subscriber is unregistered when the client disconnects")
+	cancel()
+	require.Eventually(t, func() bool {
+		s.syncEventsSubscribers.lock.Lock()
+		defer s.syncEventsSubscribers.lock.Unlock()
+		return len(s.syncEventsSubscribers.subscribers) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestMapSyncEventIntoSyncEventResponse_Success(t *testing.T) {
+	resp := mapSyncEventIntoSyncEventResponse(SyncEvent{
+		Results: []ClientSyncResult{
+			{URL: "https://gateway-1:8444", Hash: "hash"},
+			{URL: "https://konnect", Konnect: true, Err: errors.New("konnect failure")},
+		},
+	})
+	require.True(t, resp.Success, "Konnect failures should not affect the result")
+	require.Equal(t, FallbackStatusNotTriggered, resp.Fallback.Status)
+}
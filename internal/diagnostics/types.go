@@ -1,9 +1,13 @@
 package diagnostics
 
 import (
+	"time"
+
 	"github.com/kong/go-database-reconciler/pkg/file"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/failures"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/fallback"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
 )
 
 // DumpMeta annotates a config dump.
@@ -14,6 +18,9 @@ type DumpMeta struct {
 	Fallback bool
 	// Hash is the configuration hash.
 	Hash string
+	// Workspace is the Kong workspace assigned to namespaces the configuration was applied in. It's empty for
+	// the configuration of the default workspace.
+	Workspace string
 }
 
 // ConfigDump contains a config dump and a flag indicating that the config was not successfully applid.
@@ -35,4 +42,47 @@ type ConfigDumpDiagnostic struct {
 	Configs chan ConfigDump
 	// FallbackCacheMetadata is the channel that receives fallback metadata from the fallback cache generator.
 	FallbackCacheMetadata chan fallback.GeneratedCacheMetadata
+	// ObjectsProvenance is the channel that receives mappings of Kubernetes objects to Kong entities they were
+	// translated to.
+	ObjectsProvenance chan translator.ObjectsProvenance
+	// SyncEvents is the channel that receives outcomes of configuration synchronisations. Unlike other channels,
+	// it's set only when sync events are enabled (--sync-events) and is nil otherwise.
+	SyncEvents chan SyncEvent
+}
+
+// SyncEvent describes the outcome of a single synchronisation of the configuration with gateways.
+type SyncEvent struct {
+	// Timestamp is the time the synchronisation started at.
+	Timestamp time.Time
+	// Duration is the time the synchronisation took, including translation and recovery from failures.
+	Duration time.Duration
+	// Results are results of pushing configuration to gateways and Konnect in the order they were completed.
+	// A single client may have multiple results if a fallback or the last valid configuration was pushed
+	// after the current configuration had been rejected.
+	Results []ClientSyncResult
+	// TranslationFailures are failures that occurred when translating Kubernetes objects into the configuration.
+	TranslationFailures []failures.ResourceFailure
+	// FallbackCacheMetadata describes the fallback configuration generated during the synchronisation. It's nil
+	// if no fallback configuration was generated.
+	FallbackCacheMetadata *fallback.GeneratedCacheMetadata
+}
+
+// ClientSyncResult is a result of pushing configuration to a single gateway or Konnect.
+type ClientSyncResult struct {
+	// URL is the base root URL of the client's Admin API.
+	URL string
+	// Workspace is the Kong workspace assigned to namespaces the configuration was pushed to. It's empty for
+	// the configuration of the default workspace.
+	Workspace string
+	// Konnect indicates the configuration was pushed to Konnect.
+	Konnect bool
+	// Hash is the hash of the pushed configuration. For deferred pushes, it's the hash of the configuration
+	// the client kept.
+	Hash string
+	// Fallback indicates the pushed configuration was a fallback or the last valid configuration.
+	Fallback bool
+	// Deferred indicates the push was deferred by the adaptive push scheduler and the client kept its configuration.
+	Deferred bool
+	// Err is the error returned when pushing the configuration. It's nil if the push succeeded or was deferred.
+	Err error
 }
@@ -3,6 +3,7 @@ package gatewayapi
 import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
@@ -20,12 +21,14 @@ type (
 	BackendRef                = gatewayv1.BackendRef
 	CommonRouteSpec           = gatewayv1.CommonRouteSpec
 	Duration                  = gatewayv1.Duration
+	FrontendTLSValidation     = gatewayv1.FrontendTLSValidation
 	Gateway                   = gatewayv1.Gateway
 	GatewayAddress            = gatewayv1.GatewayAddress
 	GatewayClass              = gatewayv1.GatewayClass
 	GatewayClassList          = gatewayv1.GatewayClassList
 	GatewayClassSpec          = gatewayv1.GatewayClassSpec
 	GatewayClassStatus        = gatewayv1.GatewayClassStatus
+	GatewayConditionReason    = gatewayv1.GatewayConditionReason
 	GatewayController         = gatewayv1.GatewayController
 	GatewayList               = gatewayv1.GatewayList
 	GatewaySpec               = gatewayv1.GatewaySpec
@@ -41,6 +44,7 @@ type (
 	HTTPMethod                = gatewayv1.HTTPMethod
 	HTTPPathMatch             = gatewayv1.HTTPPathMatch
 	HTTPQueryParamMatch       = gatewayv1.HTTPQueryParamMatch
+	HTTPRequestMirrorFilter   = gatewayv1.HTTPRequestMirrorFilter
 	HTTPRequestRedirectFilter = gatewayv1.HTTPRequestRedirectFilter
 	HTTPRoute                 = gatewayv1.HTTPRoute
 	HTTPRouteFilter           = gatewayv1.HTTPRouteFilter
@@ -62,6 +66,8 @@ type (
 	ListenerStatus            = gatewayv1.ListenerStatus
 	Namespace                 = gatewayv1.Namespace
 	ObjectName                = gatewayv1.ObjectName
+	ObjectReference           = gatewayv1.ObjectReference
+	ParametersReference       = gatewayv1.ParametersReference
 	ParentReference           = gatewayv1.ParentReference
 	PathMatchType             = gatewayv1.PathMatchType
 	PortNumber                = gatewayv1.PortNumber
@@ -79,6 +85,12 @@ type (
 	RouteStatus               = gatewayv1.RouteStatus
 	SecretObjectReference     = gatewayv1.SecretObjectReference
 	SectionName               = gatewayv1.SectionName
+	SessionPersistence        = gatewayv1.SessionPersistence
+	SessionPersistenceType    = gatewayv1.SessionPersistenceType
+	CookieConfig              = gatewayv1.CookieConfig
+	CookieLifetimeType        = gatewayv1.CookieLifetimeType
+	SupportedFeature          = gatewayv1.SupportedFeature
+	TLSModeType               = gatewayv1.TLSModeType
 	GRPCBackendRef            = gatewayv1.GRPCBackendRef
 	GRPCHeaderMatch           = gatewayv1.GRPCHeaderMatch
 	GRPCHeaderName            = gatewayv1.GRPCHeaderName
@@ -91,23 +103,32 @@ type (
 	GRPCRouteSpec             = gatewayv1.GRPCRouteSpec
 	GRPCRouteStatus           = gatewayv1.GRPCRouteStatus
 
-	PolicyAncestorStatus = gatewayv1alpha2.PolicyAncestorStatus
-	PolicyStatus         = gatewayv1alpha2.PolicyStatus
-	TCPRoute             = gatewayv1alpha2.TCPRoute
-	TCPRouteList         = gatewayv1alpha2.TCPRouteList
-	TCPRouteRule         = gatewayv1alpha2.TCPRouteRule
-	TCPRouteSpec         = gatewayv1alpha2.TCPRouteSpec
-	TCPRouteStatus       = gatewayv1alpha2.TCPRouteStatus
-	TLSRoute             = gatewayv1alpha2.TLSRoute
-	TLSRouteList         = gatewayv1alpha2.TLSRouteList
-	TLSRouteRule         = gatewayv1alpha2.TLSRouteRule
-	TLSRouteSpec         = gatewayv1alpha2.TLSRouteSpec
-	TLSRouteStatus       = gatewayv1alpha2.TLSRouteStatus
-	UDPRoute             = gatewayv1alpha2.UDPRoute
-	UDPRouteList         = gatewayv1alpha2.UDPRouteList
-	UDPRouteRule         = gatewayv1alpha2.UDPRouteRule
-	UDPRouteSpec         = gatewayv1alpha2.UDPRouteSpec
-	UDPRouteStatus       = gatewayv1alpha2.UDPRouteStatus
+	LocalPolicyTargetReference                = gatewayv1alpha2.LocalPolicyTargetReference
+	LocalPolicyTargetReferenceWithSectionName = gatewayv1alpha2.LocalPolicyTargetReferenceWithSectionName
+	PolicyAncestorStatus                      = gatewayv1alpha2.PolicyAncestorStatus
+	PolicyConditionReason                     = gatewayv1alpha2.PolicyConditionReason
+	PolicyStatus                              = gatewayv1alpha2.PolicyStatus
+	TCPRoute                                  = gatewayv1alpha2.TCPRoute
+	TCPRouteList                              = gatewayv1alpha2.TCPRouteList
+	TCPRouteRule                              = gatewayv1alpha2.TCPRouteRule
+	TCPRouteSpec                              = gatewayv1alpha2.TCPRouteSpec
+	TCPRouteStatus                            = gatewayv1alpha2.TCPRouteStatus
+	TLSRoute                                  = gatewayv1alpha2.TLSRoute
+	TLSRouteList                              = gatewayv1alpha2.TLSRouteList
+	TLSRouteRule                              = gatewayv1alpha2.TLSRouteRule
+	TLSRouteSpec                              = gatewayv1alpha2.TLSRouteSpec
+	TLSRouteStatus                            = gatewayv1alpha2.TLSRouteStatus
+	UDPRoute                                  = gatewayv1alpha2.UDPRoute
+	UDPRouteList                              = gatewayv1alpha2.UDPRouteList
+	UDPRouteRule                              = gatewayv1alpha2.UDPRouteRule
+	UDPRouteSpec                              = gatewayv1alpha2.UDPRouteSpec
+	UDPRouteStatus                            = gatewayv1alpha2.UDPRouteStatus
+
+	BackendTLSPolicy            = gatewayv1alpha3.BackendTLSPolicy
+	BackendTLSPolicyList        = gatewayv1alpha3.BackendTLSPolicyList
+	BackendTLSPolicySpec        = gatewayv1alpha3.BackendTLSPolicySpec
+	BackendTLSPolicyValidation  = gatewayv1alpha3.BackendTLSPolicyValidation
+	WellKnownCACertificatesType = gatewayv1alpha3.WellKnownCACertificatesType
 )
 
 const (
@@ -118,11 +139,15 @@ const (
 	GatewayConditionAccepted              = gatewayv1.GatewayConditionAccepted
 	GatewayConditionProgrammed            = gatewayv1.GatewayConditionProgrammed
 	GatewayReasonAccepted                 = gatewayv1.GatewayReasonAccepted
+	GatewayReasonInvalidParameters        = gatewayv1.GatewayReasonInvalidParameters
 	GatewayReasonPending                  = gatewayv1.GatewayReasonPending
 	GatewayReasonProgrammed               = gatewayv1.GatewayReasonProgrammed
 	HTTPMethodDelete                      = gatewayv1.HTTPMethodDelete
 	HTTPMethodGet                         = gatewayv1.HTTPMethodGet
 	HTTPProtocolType                      = gatewayv1.HTTPProtocolType
+	CookieBasedSessionPersistence         = gatewayv1.CookieBasedSessionPersistence
+	HeaderBasedSessionPersistence         = gatewayv1.HeaderBasedSessionPersistence
+	PermanentCookieLifetimeType           = gatewayv1.PermanentCookieLifetimeType
 	HTTPRouteFilterExtensionRef           = gatewayv1.HTTPRouteFilterExtensionRef
 	HTTPRouteFilterRequestHeaderModifier  = gatewayv1.HTTPRouteFilterRequestHeaderModifier
 	HTTPRouteFilterRequestMirror          = gatewayv1.HTTPRouteFilterRequestMirror
@@ -177,7 +202,11 @@ const (
 	GRPCMethodMatchExact             = gatewayv1.GRPCMethodMatchExact
 	GRPCMethodMatchRegularExpression = gatewayv1.GRPCMethodMatchRegularExpression
 
-	PolicyConditionAccepted = gatewayv1alpha2.PolicyConditionAccepted
-	PolicyReasonAccepted    = gatewayv1alpha2.PolicyReasonAccepted
-	PolicyReasonConflicted  = gatewayv1alpha2.PolicyReasonConflicted
+	PolicyConditionAccepted    = gatewayv1alpha2.PolicyConditionAccepted
+	PolicyReasonAccepted       = gatewayv1alpha2.PolicyReasonAccepted
+	PolicyReasonConflicted     = gatewayv1alpha2.PolicyReasonConflicted
+	PolicyReasonInvalid        = gatewayv1alpha2.PolicyReasonInvalid
+	PolicyReasonTargetNotFound = gatewayv1alpha2.PolicyReasonTargetNotFound
+
+	WellKnownCACertificatesSystem = gatewayv1alpha3.WellKnownCACertificatesSystem
 )
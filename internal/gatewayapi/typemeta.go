@@ -4,6 +4,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
@@ -62,6 +63,11 @@ var UDPRouteTypeMeta = metav1.TypeMeta{
 	Kind:       "UDPRoute",
 }
 
+var BackendTLSPolicyTypeMeta = metav1.TypeMeta{
+	APIVersion: gatewayv1alpha3.GroupVersion.String(),
+	Kind:       "BackendTLSPolicy",
+}
+
 var (
 	V1GatewayGVResource = metav1.GroupVersionResource{
 		Group:    gatewayv1.GroupVersion.Group,
@@ -83,4 +89,29 @@ var (
 		Version:  gatewayv1beta1.GroupVersion.Version,
 		Resource: "httproutes",
 	}
+	V1GRPCRouteGVResource = metav1.GroupVersionResource{
+		Group:    gatewayv1.GroupVersion.Group,
+		Version:  gatewayv1.GroupVersion.Version,
+		Resource: "grpcroutes",
+	}
+	V1alpha2GRPCRouteGVResource = metav1.GroupVersionResource{
+		Group:    gatewayv1alpha2.GroupVersion.Group,
+		Version:  gatewayv1alpha2.GroupVersion.Version,
+		Resource: "grpcroutes",
+	}
+	V1alpha2TCPRouteGVResource = metav1.GroupVersionResource{
+		Group:    gatewayv1alpha2.GroupVersion.Group,
+		Version:  gatewayv1alpha2.GroupVersion.Version,
+		Resource: "tcproutes",
+	}
+	V1alpha2UDPRouteGVResource = metav1.GroupVersionResource{
+		Group:    gatewayv1alpha2.GroupVersion.Group,
+		Version:  gatewayv1alpha2.GroupVersion.Version,
+		Resource: "udproutes",
+	}
+	V1alpha2TLSRouteGVResource = metav1.GroupVersionResource{
+		Group:    gatewayv1alpha2.GroupVersion.Group,
+		Version:  gatewayv1alpha2.GroupVersion.Version,
+		Resource: "tlsroutes",
+	}
 )
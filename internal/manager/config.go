@@ -18,6 +18,7 @@ import (
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/gateway"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/diagnostics"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/konnect"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/license"
 	cfgtypes "github.com/kong/kubernetes-ingress-controller/v3/internal/manager/config/types"
@@ -78,6 +79,12 @@ type Config struct {
 	ProxySyncSeconds            float32
 	InitCacheSyncDuration       time.Duration
 	ProxyTimeoutSeconds         float32
+	CanaryRolloutCount          int
+	CanaryRolloutPercentage     int
+	CanaryRolloutSoakPeriod     time.Duration
+	ConfigPushMinInterval       time.Duration
+	ConfigPushMaxMemoryMiB      int
+	ConfigPushSlowReload        time.Duration
 
 	// Kubernetes configurations
 	KubeconfigPath           string
@@ -92,6 +99,10 @@ type Config struct {
 	Impersonate              string
 	EmitKubernetesEvents     bool
 
+	// CertificateExpiryWarningDays is the number of days before certificates' expiry in which Warning Events are
+	// emitted on objects referring to them.
+	CertificateExpiryWarningDays int
+
 	// Ingress status
 	PublishServiceUDP       OptionalNamespacedName
 	PublishService          OptionalNamespacedName
@@ -114,6 +125,7 @@ type Config struct {
 	ServiceEnabled                bool
 	KongUpstreamPolicyEnabled     bool
 	KongServiceFacadeEnabled      bool
+	KongUpstreamTargetEnabled     bool
 	KongVaultEnabled              bool
 	KongLicenseEnabled            bool
 	KongCustomEntityEnabled       bool
@@ -131,10 +143,12 @@ type Config struct {
 	AdmissionServer admission.ServerConfig
 
 	// Diagnostics and performance
-	EnableProfiling      bool
-	EnableConfigDumps    bool
-	DumpSensitiveConfig  bool
-	DiagnosticServerPort int
+	EnableProfiling        bool
+	EnableConfigDumps      bool
+	DumpSensitiveConfig    bool
+	ConfigDumpsHistorySize int
+	EnableSyncEvents       bool
+	DiagnosticServerPort   int
 
 	// Feature Gates
 	FeatureGates map[string]bool
@@ -216,6 +230,18 @@ func (c *Config) FlagSet() *pflag.FlagSet {
 	flagSet.DurationVar(&c.InitCacheSyncDuration, "init-cache-sync-duration", dataplane.DefaultCacheSyncWaitDuration, `The initial delay to wait for Kubernetes object caches to be synced before the initial configuration.`)
 	flagSet.Float32Var(&c.ProxyTimeoutSeconds, "proxy-timeout-seconds", dataplane.DefaultTimeoutSeconds,
 		"Sets the timeout (in seconds) for all requests to Kong's Admin API.")
+	flagSet.IntVar(&c.CanaryRolloutCount, "config-rollout-canary-count", 0,
+		`Number of Kong Gateways configuration is pushed to first. The remaining Gateways are configured only after the canaries report readiness and the soak period elapses. Only applies to DB-less mode. Mutually exclusive with --config-rollout-canary-percentage.`)
+	flagSet.IntVar(&c.CanaryRolloutPercentage, "config-rollout-canary-percentage", 0,
+		`Percentage (rounded up) of Kong Gateways configuration is pushed to first. The remaining Gateways are configured only after the canaries report readiness and the soak period elapses. Only applies to DB-less mode. Mutually exclusive with --config-rollout-canary-count.`)
+	flagSet.DurationVar(&c.CanaryRolloutSoakPeriod, "config-rollout-soak-period", 30*time.Second,
+		`Time to wait after canary Kong Gateways report readiness before pushing configuration to the remaining Gateways. Only relevant when --config-rollout-canary-count or --config-rollout-canary-percentage is set.`)
+	flagSet.DurationVar(&c.ConfigPushMinInterval, "config-push-min-interval", 0,
+		`Minimum interval between configuration pushes to a single Kong Gateway. Updates made in the meantime are coalesced into the next push. Only applies to DB-less mode. Set to 0 to disable.`)
+	flagSet.IntVar(&c.ConfigPushMaxMemoryMiB, "config-push-max-gateway-memory-mib", 0,
+		`Memory (in MiB) allocated by a Kong Gateway's workers, as reported by its status endpoint, above which configuration pushes to the Gateway are deferred. Only applies to DB-less mode. Set to 0 to disable.`)
+	flagSet.DurationVar(&c.ConfigPushSlowReload, "config-push-slow-reload-threshold", 0,
+		`Duration of a configuration push above which a Kong Gateway's reload is considered slow. After a slow reload, the next push to the Gateway is deferred for as long as the reload took. Only applies to DB-less mode. Set to 0 to disable.`)
 
 	// Kubernetes configurations
 	flagSet.Var(flags.NewValidatedValue(&c.GatewayAPIControllerName, gatewayAPIControllerNameFromFlagValue, flags.WithDefault(string(gateway.GetControllerName()))), "gateway-api-controller-name", "The controller name to match on Gateway API resources.")
@@ -232,6 +258,8 @@ func (c *Config) FlagSet() *pflag.FlagSet {
 	flagSet.StringSliceVar(&c.WatchNamespaces, "watch-namespace", nil,
 		`Namespace(s) in comma-separated format (or specify this flag multiple times) to watch for Kubernetes resources. Defaults to all namespaces.`)
 	flagSet.BoolVar(&c.EmitKubernetesEvents, "emit-kubernetes-events", true, `Emit Kubernetes events for successful configuration applies, translation failures and configuration apply failures on managed objects.`)
+	flagSet.IntVar(&c.CertificateExpiryWarningDays, "certificate-expiry-warning-days", 14,
+		`Emit Warning events on objects referring to TLS Secrets whose certificates expire within this number of days. Set to 0 to disable.`)
 
 	// Ingress status
 	flagSet.Var(flags.NewValidatedValue(&c.PublishService, namespacedNameFromFlagValue, nnTypeNameOverride), "publish-service",
@@ -269,6 +297,7 @@ func (c *Config) FlagSet() *pflag.FlagSet {
 	flagSet.Var(flags.NewValidatedValue(&c.GatewayToReconcile, namespacedNameFromFlagValue, nnTypeNameOverride), "gateway-to-reconcile",
 		`Gateway namespaced name in "namespace/name" format. Makes KIC reconcile only the specified Gateway.`)
 	flagSet.BoolVar(&c.KongServiceFacadeEnabled, "enable-controller-kong-service-facade", true, "Enable the KongServiceFacade controller.")
+	flagSet.BoolVar(&c.KongUpstreamTargetEnabled, "enable-controller-kong-upstream-target", true, "Enable the KongUpstreamTarget controller.")
 	flagSet.BoolVar(&c.KongVaultEnabled, "enable-controller-kong-vault", true, "Enable the KongVault controller.")
 	flagSet.BoolVar(&c.KongLicenseEnabled, "enable-controller-kong-license", true, "Enable the KongLicense controller.")
 	flagSet.BoolVar(&c.KongCustomEntityEnabled, "enable-controller-kong-custom-entity", true, "Enable the KongCustomEntity controller.")
@@ -291,6 +320,9 @@ func (c *Config) FlagSet() *pflag.FlagSet {
 	flagSet.BoolVar(&c.EnableProfiling, "profiling", false, fmt.Sprintf("Enable profiling via web interface host:%v/debug/pprof/.", DiagnosticsPort))
 	flagSet.BoolVar(&c.EnableConfigDumps, "dump-config", false, fmt.Sprintf("Enable config dumps via web interface host:%v/debug/config.", DiagnosticsPort))
 	flagSet.BoolVar(&c.DumpSensitiveConfig, "dump-sensitive-config", false, "Include credentials and TLS secrets in configs exposed with --dump-config flag.")
+	flagSet.IntVar(&c.ConfigDumpsHistorySize, "dump-config-history-size", diagnostics.DefaultConfigDumpsHistorySize,
+		fmt.Sprintf("Number of the most recent config dumps retained for diffing via web interface host:%v/debug/config/diff.", DiagnosticsPort))
+	flagSet.BoolVar(&c.EnableSyncEvents, "sync-events", false, fmt.Sprintf("Enable a stream of configuration sync events via web interface host:%v/debug/sync/events.", DiagnosticsPort))
 	flagSet.IntVar(&c.DiagnosticServerPort, "diagnostic-server-port", DiagnosticsPort, "The port to listen on for the profiling and config dump server.")
 	_ = flagSet.MarkHidden("diagnostic-server-port")
 
@@ -74,6 +74,18 @@ func (c *Config) Validate() error {
 	if err := c.validateFallbackConfiguration(); err != nil {
 		return fmt.Errorf("invalid fallback config settings: %w", err)
 	}
+	if err := c.validateManagedGateways(); err != nil {
+		return fmt.Errorf("invalid managed gateways settings: %w", err)
+	}
+	if err := c.validateCanaryRollout(); err != nil {
+		return fmt.Errorf("invalid canary rollout settings: %w", err)
+	}
+	if err := c.validateAdaptivePush(); err != nil {
+		return fmt.Errorf("invalid config push settings: %w", err)
+	}
+	if c.CertificateExpiryWarningDays < 0 {
+		return errors.New("--certificate-expiry-warning-days cannot be negative")
+	}
 
 	return nil
 }
@@ -119,6 +131,44 @@ func (c *Config) validateFallbackConfiguration() error {
 	return nil
 }
 
+func (c *Config) validateManagedGateways() error {
+	// Admin APIs of managed Gateways' data planes are configured alongside the ones discovered from
+	// --kong-admin-svc, which is required for the set of configured Admin APIs to be dynamic.
+	if c.FeatureGates[featuregates.ManagedGateways] && c.KongAdminSvc.IsAbsent() {
+		return fmt.Errorf("--kong-admin-svc has to be set when using %s feature gate", featuregates.ManagedGateways)
+	}
+	return nil
+}
+
+func (c *Config) validateCanaryRollout() error {
+	if c.CanaryRolloutCount < 0 {
+		return errors.New("--config-rollout-canary-count cannot be negative")
+	}
+	if c.CanaryRolloutPercentage < 0 || c.CanaryRolloutPercentage > 100 {
+		return errors.New("--config-rollout-canary-percentage has to be between 0 and 100")
+	}
+	if c.CanaryRolloutCount > 0 && c.CanaryRolloutPercentage > 0 {
+		return errors.New("--config-rollout-canary-count and --config-rollout-canary-percentage are mutually exclusive")
+	}
+	if c.CanaryRolloutSoakPeriod < 0 {
+		return errors.New("--config-rollout-soak-period cannot be negative")
+	}
+	return nil
+}
+
+func (c *Config) validateAdaptivePush() error {
+	if c.ConfigPushMinInterval < 0 {
+		return errors.New("--config-push-min-interval cannot be negative")
+	}
+	if c.ConfigPushMaxMemoryMiB < 0 {
+		return errors.New("--config-push-max-gateway-memory-mib cannot be negative")
+	}
+	if c.ConfigPushSlowReload < 0 {
+		return errors.New("--config-push-slow-reload-threshold cannot be negative")
+	}
+	return nil
+}
+
 func validateClientTLS(clientTLS adminapi.TLSClientConfig) error {
 	if clientTLS.Cert != "" && clientTLS.CertFile != "" {
 		return errors.New("both client certificate and client certificate file specified, only one allowed")
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/samber/mo"
 	"github.com/stretchr/testify/require"
@@ -320,4 +321,120 @@ func TestConfigValidate(t *testing.T) {
 			require.NoError(t, c.Validate())
 		})
 	})
+
+	t.Run("managed gateways", func(t *testing.T) {
+		t.Run("enabled without --kong-admin-svc is rejected", func(t *testing.T) {
+			c := manager.Config{
+				FeatureGates: map[string]bool{
+					featuregates.ManagedGateways: true,
+				},
+			}
+			require.ErrorContains(t, c.Validate(), "--kong-admin-svc has to be set when using ManagedGateways feature gate")
+		})
+		t.Run("enabled with --kong-admin-svc is accepted", func(t *testing.T) {
+			c := manager.Config{
+				KongAdminSvc: mo.Some(k8stypes.NamespacedName{Namespace: "kong", Name: "kong-admin"}),
+				FeatureGates: map[string]bool{
+					featuregates.ManagedGateways: true,
+				},
+			}
+			require.NoError(t, c.Validate())
+		})
+	})
+
+	t.Run("canary rollout", func(t *testing.T) {
+		testCases := []struct {
+			name          string
+			config        manager.Config
+			expectedError string
+		}{
+			{
+				name:   "canary count is accepted",
+				config: manager.Config{CanaryRolloutCount: 1, CanaryRolloutSoakPeriod: time.Minute},
+			},
+			{
+				name:   "canary percentage is accepted",
+				config: manager.Config{CanaryRolloutPercentage: 25},
+			},
+			{
+				name:          "negative canary count is rejected",
+				config:        manager.Config{CanaryRolloutCount: -1},
+				expectedError: "--config-rollout-canary-count cannot be negative",
+			},
+			{
+				name:          "canary percentage over 100 is rejected",
+				config:        manager.Config{CanaryRolloutPercentage: 101},
+				expectedError: "--config-rollout-canary-percentage has to be between 0 and 100",
+			},
+			{
+				name:          "both canary count and percentage are rejected",
+				config:        manager.Config{CanaryRolloutCount: 1, CanaryRolloutPercentage: 10},
+				expectedError: "--config-rollout-canary-count and --config-rollout-canary-percentage are mutually exclusive",
+			},
+			{
+				name:          "negative soak period is rejected",
+				config:        manager.Config{CanaryRolloutCount: 1, CanaryRolloutSoakPeriod: -time.Second},
+				expectedError: "--config-rollout-soak-period cannot be negative",
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := tc.config.Validate()
+				if tc.expectedError != "" {
+					require.ErrorContains(t, err, tc.expectedError)
+					return
+				}
+				require.NoError(t, err)
+			})
+		}
+	})
+
+	t.Run("adaptive config push", func(t *testing.T) {
+		testCases := []struct {
+			name          string
+			config        manager.Config
+			expectedError string
+		}{
+			{
+				name: "all settings are accepted",
+				config: manager.Config{
+					ConfigPushMinInterval:  10 * time.Second,
+					ConfigPushMaxMemoryMiB: 512,
+					ConfigPushSlowReload:   5 * time.Second,
+				},
+			},
+			{
+				name:          "negative min interval is rejected",
+				config:        manager.Config{ConfigPushMinInterval: -time.Second},
+				expectedError: "--config-push-min-interval cannot be negative",
+			},
+			{
+				name:          "negative max memory is rejected",
+				config:        manager.Config{ConfigPushMaxMemoryMiB: -1},
+				expectedError: "--config-push-max-gateway-memory-mib cannot be negative",
+			},
+			{
+				name:          "negative slow reload threshold is rejected",
+				config:        manager.Config{ConfigPushSlowReload: -time.Second},
+				expectedError: "--config-push-slow-reload-threshold cannot be negative",
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := tc.config.Validate()
+				if tc.expectedError != "" {
+					require.ErrorContains(t, err, tc.expectedError)
+					return
+				}
+				require.NoError(t, err)
+			})
+		}
+	})
+
+	t.Run("certificate expiry warnings", func(t *testing.T) {
+		require.NoError(t, (&manager.Config{CertificateExpiryWarningDays: 30}).Validate())
+		require.NoError(t, (&manager.Config{CertificateExpiryWarningDays: 0}).Validate())
+		require.ErrorContains(t, (&manager.Config{CertificateExpiryWarningDays: -1}).Validate(),
+			"--certificate-expiry-warning-days cannot be negative")
+	})
 }
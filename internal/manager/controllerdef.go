@@ -9,6 +9,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers"
@@ -18,6 +19,7 @@ import (
 	ctrlref "github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/reference"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/utils"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/translator"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/featuregates"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/util/kubernetes/object/status"
 )
@@ -65,8 +67,11 @@ func setupControllers(
 	kubernetesStatusQueue *status.Queue,
 	c *Config,
 	featureGates featuregates.FeatureGates,
+	translatorFeatureFlags translator.FeatureFlags,
 	kongAdminAPIEndpointsNotifier configuration.EndpointsNotifier,
 	adminAPIsDiscoverer configuration.AdminAPIsDiscoverer,
+	managedAdminAPIsDiscoverer gateway.ManagedAdminAPIsDiscoverer,
+	managedAdminAPIsNotifier gateway.ManagedAdminAPIsNotifier,
 ) []ControllerDef {
 	controllers := []ControllerDef{
 		// ---------------------------------------------------------------------------
@@ -120,6 +125,7 @@ func setupControllers(
 				DataplaneClient:   dataplaneClient,
 				CacheSyncTimeout:  c.CacheSyncTimeout,
 				ReferenceIndexers: referenceIndexers,
+				StatusQueue:       kubernetesStatusQueue,
 			},
 		},
 		{
@@ -132,6 +138,16 @@ func setupControllers(
 				CacheSyncTimeout: c.CacheSyncTimeout,
 			},
 		},
+		{
+			Enabled: featureGates.Enabled(featuregates.MultiWorkspace),
+			Controller: &configuration.CoreV1NamespaceReconciler{
+				Client:           mgr.GetClient(),
+				Log:              ctrl.LoggerFrom(ctx).WithName("controllers").WithName("Namespace"),
+				Scheme:           mgr.GetScheme(),
+				DataplaneClient:  dataplaneClient,
+				CacheSyncTimeout: c.CacheSyncTimeout,
+			},
+		},
 		{
 			Enabled: true,
 			Controller: &configuration.CoreV1SecretReconciler{
@@ -143,6 +159,17 @@ func setupControllers(
 				ReferenceIndexers: referenceIndexers,
 			},
 		},
+		{
+			Enabled: c.KongPluginEnabled || c.KongClusterPluginEnabled || c.GatewayAPIGatewayController,
+			Controller: &configuration.CoreV1ConfigMapReconciler{
+				Client:            mgr.GetClient(),
+				Log:               ctrl.LoggerFrom(ctx).WithName("controllers").WithName("ConfigMaps"),
+				Scheme:            mgr.GetScheme(),
+				DataplaneClient:   dataplaneClient,
+				CacheSyncTimeout:  c.CacheSyncTimeout,
+				ReferenceIndexers: referenceIndexers,
+			},
+		},
 		// ---------------------------------------------------------------------------
 		// Kong API Controllers
 		// ---------------------------------------------------------------------------
@@ -204,8 +231,7 @@ func setupControllers(
 				DataplaneClient:   dataplaneClient,
 				CacheSyncTimeout:  c.CacheSyncTimeout,
 				ReferenceIndexers: referenceIndexers,
-				// TODO https://github.com/Kong/kubernetes-ingress-controller/issues/4578
-				// StatusQueue:       kubernetesStatusQueue,
+				StatusQueue:       kubernetesStatusQueue,
 			},
 		},
 		{
@@ -247,8 +273,7 @@ func setupControllers(
 				DisableIngressClassLookups: !c.IngressClassNetV1Enabled,
 				CacheSyncTimeout:           c.CacheSyncTimeout,
 				ReferenceIndexers:          referenceIndexers,
-				// TODO https://github.com/Kong/kubernetes-ingress-controller/issues/4578
-				// StatusQueue:       kubernetesStatusQueue,
+				StatusQueue:                kubernetesStatusQueue,
 			},
 		},
 		// KongUpstreamPolicy controller.
@@ -283,6 +308,19 @@ func setupControllers(
 				StatusQueue:                kubernetesStatusQueue,
 			},
 		},
+		{
+			Enabled: featureGates.Enabled(featuregates.KongUpstreamTarget) && c.KongUpstreamTargetEnabled,
+			Controller: &configuration.IncubatorV1Alpha1KongUpstreamTargetReconciler{
+				Client:                     mgr.GetClient(),
+				Log:                        ctrl.LoggerFrom(ctx).WithName("controllers").WithName("KongUpstreamTarget"),
+				Scheme:                     mgr.GetScheme(),
+				DataplaneClient:            dataplaneClient,
+				CacheSyncTimeout:           c.CacheSyncTimeout,
+				IngressClassName:           c.IngressClassName,
+				DisableIngressClassLookups: !c.IngressClassNetV1Enabled,
+				StatusQueue:                kubernetesStatusQueue,
+			},
+		},
 		{
 			Enabled: c.KongVaultEnabled,
 			Controller: &configuration.KongV1Alpha1KongVaultReconciler{
@@ -321,18 +359,28 @@ func setupControllers(
 				CacheSyncTimeout: c.CacheSyncTimeout,
 				RequiredCRDs:     baseGatewayCRDs(),
 				Controller: &gateway.GatewayReconciler{
-					Client:               mgr.GetClient(),
-					Log:                  ctrl.LoggerFrom(ctx).WithName("controllers").WithName("Gateway"),
-					Scheme:               mgr.GetScheme(),
-					DataplaneClient:      dataplaneClient,
-					PublishServiceRef:    c.PublishService.OrEmpty(),
-					PublishServiceUDPRef: c.PublishServiceUDP,
-					AddressOverrides:     c.PublishStatusAddress,
-					AddressOverridesUDP:  c.PublishStatusAddressUDP,
-					WatchNamespaces:      c.WatchNamespaces,
-					CacheSyncTimeout:     c.CacheSyncTimeout,
-					ReferenceIndexers:    referenceIndexers,
-					GatewayNN:            controllers.NewOptionalNamespacedName(c.GatewayToReconcile),
+					Client:                     mgr.GetClient(),
+					Log:                        ctrl.LoggerFrom(ctx).WithName("controllers").WithName("Gateway"),
+					Scheme:                     mgr.GetScheme(),
+					DataplaneClient:            dataplaneClient,
+					PublishServiceRef:          c.PublishService.OrEmpty(),
+					PublishServiceUDPRef:       c.PublishServiceUDP,
+					AddressOverrides:           c.PublishStatusAddress,
+					AddressOverridesUDP:        c.PublishStatusAddressUDP,
+					WatchNamespaces:            c.WatchNamespaces,
+					CacheSyncTimeout:           c.CacheSyncTimeout,
+					ReferenceIndexers:          referenceIndexers,
+					GatewayNN:                  controllers.NewOptionalNamespacedName(c.GatewayToReconcile),
+					ManagedGatewaysEnabled:     featureGates.Enabled(featuregates.ManagedGateways),
+					ManagedAdminAPIsDiscoverer: managedAdminAPIsDiscoverer,
+					ManagedAdminAPIsNotifier:   managedAdminAPIsNotifier,
+					GatewayClassSupportedFeatures: gateway.GetSupportedFeatures(gateway.SupportedFeaturesOptions{
+						TranslatorFeatures:    translatorFeatureFlags,
+						HTTPRouteEnabled:      c.GatewayAPIHTTPRouteController,
+						GRPCRouteEnabled:      c.GatewayAPIGRPCRouteController,
+						ReferenceGrantEnabled: c.GatewayAPIReferenceGrantController,
+						GatewayAlphaEnabled:   featureGates.Enabled(featuregates.GatewayAlphaFeature),
+					}),
 				},
 			},
 		},
@@ -348,13 +396,16 @@ func setupControllers(
 					Resource: "httproutes",
 				}),
 				Controller: &gateway.HTTPRouteReconciler{
-					Client:           mgr.GetClient(),
-					Log:              ctrl.LoggerFrom(ctx).WithName("controllers").WithName("HTTPRoute"),
-					Scheme:           mgr.GetScheme(),
-					DataplaneClient:  dataplaneClient,
-					CacheSyncTimeout: c.CacheSyncTimeout,
-					StatusQueue:      kubernetesStatusQueue,
-					GatewayNN:        controllers.NewOptionalNamespacedName(c.GatewayToReconcile),
+					Client:                    mgr.GetClient(),
+					Log:                       ctrl.LoggerFrom(ctx).WithName("controllers").WithName("HTTPRoute"),
+					Scheme:                    mgr.GetScheme(),
+					DataplaneClient:           dataplaneClient,
+					CacheSyncTimeout:          c.CacheSyncTimeout,
+					StatusQueue:               kubernetesStatusQueue,
+					GatewayNN:                 controllers.NewOptionalNamespacedName(c.GatewayToReconcile),
+					KongServiceFacadeEnabled:  featureGates.Enabled(featuregates.KongServiceFacade) && c.KongServiceFacadeEnabled,
+					KongUpstreamTargetEnabled: featureGates.Enabled(featuregates.KongUpstreamTarget) && c.KongUpstreamTargetEnabled,
+					KongUpstreamPolicyEnabled: c.KongUpstreamPolicyEnabled,
 				},
 			},
 		},
@@ -469,6 +520,27 @@ func setupControllers(
 				},
 			},
 		},
+		{
+			Enabled: featureGates.Enabled(featuregates.GatewayAlphaFeature),
+			Controller: &crds.DynamicCRDController{
+				Manager:          mgr,
+				Log:              ctrl.LoggerFrom(ctx).WithName("controllers").WithName("Dynamic/BackendTLSPolicy"),
+				CacheSyncTimeout: c.CacheSyncTimeout,
+				RequiredCRDs: append(baseGatewayCRDs(), schema.GroupVersionResource{
+					Group:    gatewayv1alpha3.GroupVersion.Group,
+					Version:  gatewayv1alpha3.GroupVersion.Version,
+					Resource: "backendtlspolicies",
+				}),
+				Controller: &gateway.BackendTLSPolicyReconciler{
+					Client:            mgr.GetClient(),
+					Log:               ctrl.LoggerFrom(ctx).WithName("controllers").WithName("BackendTLSPolicy"),
+					Scheme:            mgr.GetScheme(),
+					DataplaneClient:   dataplaneClient,
+					ReferenceIndexers: referenceIndexers,
+					CacheSyncTimeout:  c.CacheSyncTimeout,
+				},
+			},
+		},
 	}
 
 	return controllers
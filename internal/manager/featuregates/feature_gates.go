@@ -39,6 +39,26 @@ const (
 	// https://github.com/Kong/kubernetes-ingress-controller/issues/6124
 	KongCustomEntity = "KongCustomEntity"
 
+	// KongUpstreamTarget is the name of the feature-gate for enabling KongUpstreamTarget CR reconciliation
+	// and its usage as a Gateway API routes' backend.
+	KongUpstreamTarget = "KongUpstreamTarget"
+
+	// ManagedGateways is the name of the feature-gate that enables provisioning Kong Gateway data planes
+	// for Gateways whose GatewayClass refers to a KongGatewayConfiguration.
+	ManagedGateways = "ManagedGateways"
+
+	// IncrementalTranslation is the name of the feature-gate that enables caching results of Kubernetes objects
+	// translation so that only objects affected by a change are translated again.
+	IncrementalTranslation = "IncrementalTranslation"
+
+	// MultiWorkspace is the name of the feature-gate that enables configuring Kubernetes objects in Kong workspaces
+	// assigned to their namespaces with the konghq.com/workspace annotation. It's supported in DB mode only.
+	MultiWorkspace = "MultiWorkspace"
+
+	// GatewaySharding is the name of the feature-gate that enables configuring routes and certificates of Gateways
+	// only in Kong nodes backing their publish Services. It's supported in DB-less mode only.
+	GatewaySharding = "GatewaySharding"
+
 	// DocsURL provides a link to the documentation for feature gates in the KIC repository.
 	DocsURL = "https://github.com/Kong/kubernetes-ingress-controller/blob/main/FEATURE_GATES.md"
 )
@@ -86,5 +106,10 @@ func GetFeatureGatesDefaults() FeatureGates {
 		SanitizeKonnectConfigDumps: true,
 		FallbackConfiguration:      false,
 		KongCustomEntity:           false,
+		KongUpstreamTarget:         false,
+		ManagedGateways:            false,
+		IncrementalTranslation:     false,
+		MultiWorkspace:             false,
+		GatewaySharding:            false,
 	}
 }
@@ -79,6 +79,12 @@ func Run(
 	if err != nil {
 		return fmt.Errorf("failed to create admin apis discoverer: %w", err)
 	}
+	managedAdminAPIsDiscoverer, err := adminapi.NewDiscoverer(
+		sets.New(gateway.ManagedDataPlaneAdminPortName), c.GatewayDiscoveryDNSStrategy,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create managed gateways admin apis discoverer: %w", err)
+	}
 
 	err = c.Resolve()
 	if err != nil {
@@ -109,6 +115,12 @@ func Run(
 		return fmt.Errorf("could not validate Kong admin root(s) configuration: %w", err)
 	}
 	dbMode := kongStartUpConfig.DBMode
+	if featureGates.Enabled(featuregates.MultiWorkspace) && dbMode.IsDBLessMode() {
+		return fmt.Errorf("%s feature gate is supported in DB mode only", featuregates.MultiWorkspace)
+	}
+	if featureGates.Enabled(featuregates.GatewaySharding) && dbMode.IsDBBacked() {
+		return fmt.Errorf("%s feature gate is supported in DB-less mode only", featuregates.GatewaySharding)
+	}
 	routerFlavor := kongStartUpConfig.RouterFlavor
 	v := kongStartUpConfig.Version
 
@@ -125,6 +137,17 @@ func Run(
 		SanitizeKonnectConfigDumps:    featureGates.Enabled(featuregates.SanitizeKonnectConfigDumps),
 		FallbackConfiguration:         featureGates.Enabled(featuregates.FallbackConfiguration),
 		UseLastValidConfigForFallback: c.UseLastValidConfigForFallback,
+		GatewaySharding:               featureGates.Enabled(featuregates.GatewaySharding),
+		CanaryRollout: sendconfig.CanaryRolloutConfig{
+			Count:      c.CanaryRolloutCount,
+			Percentage: c.CanaryRolloutPercentage,
+			SoakPeriod: c.CanaryRolloutSoakPeriod,
+		},
+		AdaptivePush: sendconfig.AdaptivePushConfig{
+			MinInterval:         c.ConfigPushMinInterval,
+			MaxWorkersMemoryMiB: c.ConfigPushMaxMemoryMiB,
+			SlowReloadThreshold: c.ConfigPushSlowReload,
+		},
 	}
 
 	setupLog.Info("Configuring and building the controller manager")
@@ -176,6 +199,7 @@ func Run(
 		c.UpdateStatus,
 		kongStartUpConfig.Version.IsKongGatewayEnterprise(),
 	)
+	translatorFeatureFlags.ObjectsProvenance = diagnostic.ObjectsProvenance != nil
 
 	referenceIndexers := ctrlref.NewCacheIndexers(setupLog.WithName("reference-indexers"))
 	cache := store.NewCacheStores()
@@ -212,6 +236,12 @@ func Run(
 	if err != nil {
 		return fmt.Errorf("failed to initialize kong data-plane client: %w", err)
 	}
+	if featureGates.Enabled(featuregates.MultiWorkspace) {
+		dataplaneClient.EnableWorkspaces(adminAPIClientsFactory)
+	}
+	if c.CertificateExpiryWarningDays > 0 {
+		dataplaneClient.EnableCertificateExpiryWarnings(time.Duration(c.CertificateExpiryWarningDays) * 24 * time.Hour)
+	}
 
 	setupLog.Info("Initializing Dataplane Synchronizer")
 	synchronizer, err := setupDataplaneSynchronizer(logger, mgr, dataplaneClient, c.ProxySyncSeconds, c.InitCacheSyncDuration)
@@ -245,8 +275,11 @@ func Run(
 		kubernetesStatusQueue,
 		c,
 		featureGates,
+		translatorFeatureFlags,
 		clientsManager,
 		adminAPIsDiscoverer,
+		managedAdminAPIsDiscoverer,
+		clientsManager,
 	)
 	for _, c := range controllers {
 		if err := c.MaybeSetupWithManager(mgr); err != nil {
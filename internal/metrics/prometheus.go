@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/deckerrors"
@@ -34,6 +35,21 @@ type CtrlFuncMetrics struct {
 	FallbackCacheGeneratingDuration    *prometheus.HistogramVec
 	ProcessedConfigSnapshotCacheHit    prometheus.Counter
 	ProcessedConfigSnapshotCacheMiss   prometheus.Counter
+
+	// Config rollout metrics.
+	ConfigRolloutPhaseCount    *prometheus.CounterVec
+	ConfigRolloutPhaseDuration *prometheus.HistogramVec
+
+	// Config push scheduler metrics.
+	ConfigPushSchedulerDecisionCount *prometheus.CounterVec
+	ConfigPushCoalescedCount         *prometheus.CounterVec
+
+	// Workspace config push metrics.
+	WorkspaceConfigPushCount       *prometheus.CounterVec
+	WorkspaceConfigPushSuccessTime *prometheus.GaugeVec
+
+	// Certificate metrics.
+	CertificateExpiryTimestamp *prometheus.GaugeVec
 }
 
 const (
@@ -72,11 +88,60 @@ const (
 	FailureReasonKey string = "failure_reason"
 )
 
+// RolloutPhase is a phase of a progressive configuration rollout to gateways.
+type RolloutPhase string
+
+const (
+	// RolloutPhaseCanary indicates pushing configuration to canary gateways.
+	RolloutPhaseCanary RolloutPhase = "canary"
+	// RolloutPhaseSoak indicates waiting for canary gateways' readiness and the soak period.
+	RolloutPhaseSoak RolloutPhase = "soak"
+	// RolloutPhaseRemaining indicates pushing configuration to the remaining gateways after canaries succeeded.
+	RolloutPhaseRemaining RolloutPhase = "remaining"
+	// RolloutPhaseRollback indicates rolling canary gateways back to the last valid configuration.
+	RolloutPhaseRollback RolloutPhase = "rollback"
+
+	// RolloutPhaseKey defines the key of the metric label indicating the phase of a configuration rollout.
+	RolloutPhaseKey string = "phase"
+)
+
+// PushDecision is a decision of the adaptive configuration push scheduler on whether to push configuration
+// to a gateway.
+type PushDecision string
+
+const (
+	// PushDecisionPush indicates that configuration was pushed to the gateway.
+	PushDecisionPush PushDecision = "push"
+	// PushDecisionDeferredMinInterval indicates that the push was deferred because the minimum interval between pushes
+	// to the gateway hasn't elapsed yet.
+	PushDecisionDeferredMinInterval PushDecision = "deferred_min_interval"
+	// PushDecisionDeferredHighMemory indicates that the push was deferred because the gateway reported high memory usage.
+	PushDecisionDeferredHighMemory PushDecision = "deferred_high_memory"
+	// PushDecisionDeferredSlowReload indicates that the push was deferred because the previous configuration reload
+	// of the gateway was slow.
+	PushDecisionDeferredSlowReload PushDecision = "deferred_slow_reload"
+
+	// PushDecisionKey defines the key of the metric label indicating the push scheduler's decision.
+	PushDecisionKey string = "decision"
+)
+
 const (
 	// DataplaneKey defines the name of the metric label indicating which dataplane this time series is relevant for.
 	DataplaneKey string = "dataplane"
 )
 
+const (
+	// WorkspaceKey defines the name of the metric label indicating which Kong workspace this time series is relevant for.
+	WorkspaceKey string = "workspace"
+)
+
+const (
+	// SecretNamespaceKey defines the name of the metric label indicating the namespace of a Secret.
+	SecretNamespaceKey string = "secret_namespace"
+	// SecretNameKey defines the name of the metric label indicating the name of a Secret.
+	SecretNameKey string = "secret_name"
+)
+
 // Regular config push metrics names.
 const (
 	MetricNameConfigPushCount            = "ingress_controller_configuration_push_count"
@@ -100,6 +165,29 @@ const (
 	MetricNameProcessedConfigSnapshotCacheMiss   = "ingress_controller_processed_config_snapshot_cache_miss"
 )
 
+// Config rollout metrics names.
+const (
+	MetricNameConfigRolloutPhaseCount    = "ingress_controller_configuration_rollout_phase_count"
+	MetricNameConfigRolloutPhaseDuration = "ingress_controller_configuration_rollout_phase_duration_milliseconds"
+)
+
+// Config push scheduler metrics names.
+const (
+	MetricNameConfigPushSchedulerDecisionCount = "ingress_controller_configuration_push_scheduler_decision_count"
+	MetricNameConfigPushCoalescedCount         = "ingress_controller_configuration_push_coalesced_count"
+)
+
+// Workspace config push metrics names.
+const (
+	MetricNameWorkspaceConfigPushCount       = "ingress_controller_workspace_configuration_push_count"
+	MetricNameWorkspaceConfigPushSuccessTime = "ingress_controller_workspace_configuration_push_last_successful"
+)
+
+// Certificate metrics names.
+const (
+	MetricNameCertificateExpiryTimestamp = "kic_certificate_expiry_timestamp_seconds"
+)
+
 var _lock sync.Mutex
 
 func NewCtrlFuncMetrics() *CtrlFuncMetrics {
@@ -295,6 +383,97 @@ func NewCtrlFuncMetrics() *CtrlFuncMetrics {
 		},
 	)
 
+	controllerMetrics.ConfigRolloutPhaseCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: MetricNameConfigRolloutPhaseCount,
+			Help: fmt.Sprintf(
+				"Count of successful/failed phases of canary configuration rollouts to Kong. "+
+					"`%s` describes the phase of the rollout (one of `%s`, `%s`, `%s`, `%s`). "+
+					"`%s` describes whether the phase succeeded (`%s`) or not (`%s`).",
+				RolloutPhaseKey, RolloutPhaseCanary, RolloutPhaseSoak, RolloutPhaseRemaining, RolloutPhaseRollback,
+				SuccessKey, SuccessTrue, SuccessFalse,
+			),
+		},
+		[]string{RolloutPhaseKey, SuccessKey},
+	)
+
+	controllerMetrics.ConfigRolloutPhaseDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: MetricNameConfigRolloutPhaseDuration,
+			Help: fmt.Sprintf(
+				"How long phases of canary configuration rollouts to Kong took, in milliseconds. "+
+					"`%s` describes the phase of the rollout (one of `%s`, `%s`, `%s`, `%s`). "+
+					"`%s` describes whether the phase succeeded (`%s`) or not (`%s`).",
+				RolloutPhaseKey, RolloutPhaseCanary, RolloutPhaseSoak, RolloutPhaseRemaining, RolloutPhaseRollback,
+				SuccessKey, SuccessTrue, SuccessFalse,
+			),
+			Buckets: prometheus.ExponentialBuckets(100, 1.33, 30),
+		},
+		[]string{RolloutPhaseKey, SuccessKey},
+	)
+
+	controllerMetrics.ConfigPushSchedulerDecisionCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: MetricNameConfigPushSchedulerDecisionCount,
+			Help: fmt.Sprintf(
+				"Count of decisions made by the adaptive configuration push scheduler. "+
+					"`%s` describes the decision (one of `%s`, `%s`, `%s`, `%s`). "+
+					"`%s` describes the data-plane the decision was made for.",
+				PushDecisionKey, PushDecisionPush, PushDecisionDeferredMinInterval, PushDecisionDeferredHighMemory,
+				PushDecisionDeferredSlowReload, DataplaneKey,
+			),
+		},
+		[]string{PushDecisionKey, DataplaneKey},
+	)
+
+	controllerMetrics.ConfigPushCoalescedCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: MetricNameConfigPushCoalescedCount,
+			Help: fmt.Sprintf(
+				"Count of configuration updates that were superseded by newer ones while their push was deferred "+
+					"by the adaptive configuration push scheduler. `%s` describes the data-plane the update was meant for.",
+				DataplaneKey,
+			),
+		},
+		[]string{DataplaneKey},
+	)
+
+	controllerMetrics.WorkspaceConfigPushCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: MetricNameWorkspaceConfigPushCount,
+			Help: fmt.Sprintf(
+				"Count of successful/failed configuration pushes to Kong workspaces assigned to namespaces. "+
+					"`%s` describes the workspace. `%s` describes whether the push succeeded (`%s`) or not (`%s`).",
+				WorkspaceKey, SuccessKey, SuccessTrue, SuccessFalse,
+			),
+		},
+		[]string{WorkspaceKey, SuccessKey},
+	)
+
+	controllerMetrics.WorkspaceConfigPushSuccessTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricNameWorkspaceConfigPushSuccessTime,
+			Help: fmt.Sprintf(
+				"Time of last successful configuration push to a Kong workspace assigned to namespaces. "+
+					"`%s` describes the workspace.",
+				WorkspaceKey,
+			),
+		},
+		[]string{WorkspaceKey},
+	)
+
+	controllerMetrics.CertificateExpiryTimestamp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricNameCertificateExpiryTimestamp,
+			Help: fmt.Sprintf(
+				"Expiry time (Unix timestamp in seconds) of the certificate from a TLS Secret translated into a Kong certificate. "+
+					"`%s` and `%s` describe the Secret.",
+				SecretNamespaceKey, SecretNameKey,
+			),
+		},
+		[]string{SecretNamespaceKey, SecretNameKey},
+	)
+
 	allMetrics := []prometheus.Collector{
 		controllerMetrics.ConfigPushCount,
 		controllerMetrics.ConfigPushBrokenResources,
@@ -311,6 +490,13 @@ func NewCtrlFuncMetrics() *CtrlFuncMetrics {
 		controllerMetrics.FallbackCacheGeneratingDuration,
 		controllerMetrics.ProcessedConfigSnapshotCacheHit,
 		controllerMetrics.ProcessedConfigSnapshotCacheMiss,
+		controllerMetrics.ConfigRolloutPhaseCount,
+		controllerMetrics.ConfigRolloutPhaseDuration,
+		controllerMetrics.ConfigPushSchedulerDecisionCount,
+		controllerMetrics.ConfigPushCoalescedCount,
+		controllerMetrics.WorkspaceConfigPushCount,
+		controllerMetrics.WorkspaceConfigPushSuccessTime,
+		controllerMetrics.CertificateExpiryTimestamp,
 	}
 	for _, m := range allMetrics {
 		metrics.Registry.Unregister(m)
@@ -413,6 +599,57 @@ func (c *CtrlFuncMetrics) RecordFallbackCacheGenerationDuration(d time.Duration,
 	c.FallbackCacheGeneratingDuration.With(labels).Observe(float64(d.Milliseconds()))
 }
 
+// RecordConfigRolloutPhase records a phase of a canary configuration rollout.
+func (c *CtrlFuncMetrics) RecordConfigRolloutPhase(phase RolloutPhase, d time.Duration, err error) {
+	labels := prometheus.Labels{
+		RolloutPhaseKey: string(phase),
+		SuccessKey:      SuccessTrue,
+	}
+	if err != nil {
+		labels[SuccessKey] = SuccessFalse
+	}
+	c.ConfigRolloutPhaseCount.With(labels).Inc()
+	c.ConfigRolloutPhaseDuration.With(labels).Observe(float64(d.Milliseconds()))
+}
+
+// RecordPushSchedulerDecision records a decision of the adaptive configuration push scheduler. If coalesced is true,
+// a previously deferred configuration update was superseded by the current one.
+func (c *CtrlFuncMetrics) RecordPushSchedulerDecision(decision PushDecision, dataplane string, coalesced bool) {
+	c.ConfigPushSchedulerDecisionCount.With(prometheus.Labels{
+		PushDecisionKey: string(decision),
+		DataplaneKey:    dataplane,
+	}).Inc()
+	if coalesced {
+		c.ConfigPushCoalescedCount.With(prometheus.Labels{DataplaneKey: dataplane}).Inc()
+	}
+}
+
+// RecordWorkspacePush records a configuration push to a Kong workspace assigned to namespaces.
+func (c *CtrlFuncMetrics) RecordWorkspacePush(workspace string, err error) {
+	labels := prometheus.Labels{
+		WorkspaceKey: workspace,
+		SuccessKey:   SuccessTrue,
+	}
+	if err != nil {
+		labels[SuccessKey] = SuccessFalse
+	} else {
+		c.WorkspaceConfigPushSuccessTime.With(prometheus.Labels{WorkspaceKey: workspace}).SetToCurrentTime()
+	}
+	c.WorkspaceConfigPushCount.With(labels).Inc()
+}
+
+// RecordCertificateExpiries records expiry times of certificates from TLS Secrets translated into Kong certificates.
+// Secrets missing from the input are removed from the metric.
+func (c *CtrlFuncMetrics) RecordCertificateExpiries(expiries map[k8stypes.NamespacedName]time.Time) {
+	c.CertificateExpiryTimestamp.Reset()
+	for secret, notAfter := range expiries {
+		c.CertificateExpiryTimestamp.With(prometheus.Labels{
+			SecretNamespaceKey: secret.Namespace,
+			SecretNameKey:      secret.Name,
+		}).Set(float64(notAfter.Unix()))
+	}
+}
+
 type recordOption func(prometheus.Labels) prometheus.Labels
 
 func withError(err error) recordOption {
@@ -10,7 +10,10 @@ import (
 
 	deckutils "github.com/kong/go-database-reconciler/pkg/utils"
 	"github.com/kong/go-kong/kong"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/deckerrors"
 )
@@ -38,6 +41,68 @@ func TestRecordPush(t *testing.T) {
 	})
 }
 
+func TestRecordConfigRolloutPhase(t *testing.T) {
+	m := NewCtrlFuncMetrics()
+	t.Run("recording successful rollout phase works", func(t *testing.T) {
+		require.NotPanics(t, func() {
+			m.RecordConfigRolloutPhase(RolloutPhaseCanary, time.Millisecond, nil)
+		})
+	})
+	t.Run("recording failed rollout phase works", func(t *testing.T) {
+		require.NotPanics(t, func() {
+			m.RecordConfigRolloutPhase(RolloutPhaseSoak, time.Millisecond, fmt.Errorf("canary not ready"))
+		})
+	})
+}
+
+func TestRecordPushSchedulerDecision(t *testing.T) {
+	m := NewCtrlFuncMetrics()
+	t.Run("recording push decision works", func(t *testing.T) {
+		require.NotPanics(t, func() {
+			m.RecordPushSchedulerDecision(PushDecisionPush, "https://kong:8444", false)
+		})
+	})
+	t.Run("recording coalesced deferral works", func(t *testing.T) {
+		require.NotPanics(t, func() {
+			m.RecordPushSchedulerDecision(PushDecisionDeferredMinInterval, "https://kong:8444", true)
+		})
+	})
+}
+
+func TestRecordWorkspacePush(t *testing.T) {
+	m := NewCtrlFuncMetrics()
+	t.Run("recording successful workspace push works", func(t *testing.T) {
+		require.NotPanics(t, func() {
+			m.RecordWorkspacePush("tenant-a", nil)
+		})
+	})
+	t.Run("recording failed workspace push works", func(t *testing.T) {
+		require.NotPanics(t, func() {
+			m.RecordWorkspacePush("tenant-a", fmt.Errorf("custom error"))
+		})
+	})
+}
+
+func TestRecordCertificateExpiries(t *testing.T) {
+	m := NewCtrlFuncMetrics()
+	notAfter := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	m.RecordCertificateExpiries(map[k8stypes.NamespacedName]time.Time{
+		{Namespace: "default", Name: "cert-1"}: notAfter,
+		{Namespace: "default", Name: "cert-2"}: notAfter,
+	})
+	require.Equal(t, 2, testutil.CollectAndCount(m.CertificateExpiryTimestamp))
+	require.Equal(t, float64(notAfter.Unix()), testutil.ToFloat64(m.CertificateExpiryTimestamp.With(prometheus.Labels{
+		SecretNamespaceKey: "default",
+		SecretNameKey:      "cert-1",
+	})))
+
+	t.Log("Verifying that Secrets no longer translated are removed from the metric")
+	m.RecordCertificateExpiries(map[k8stypes.NamespacedName]time.Time{
+		{Namespace: "default", Name: "cert-1"}: notAfter,
+	})
+	require.Equal(t, 1, testutil.CollectAndCount(m.CertificateExpiryTimestamp))
+}
+
 func TestRecordTranslation(t *testing.T) {
 	m := NewCtrlFuncMetrics()
 	t.Run("recording translation success works", func(t *testing.T) {
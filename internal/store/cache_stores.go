@@ -3,6 +3,7 @@ package store
 import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured/unstructuredscheme"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	serializer "k8s.io/apimachinery/pkg/runtime/serializer/json"
 	yamlserializer "k8s.io/apimachinery/pkg/runtime/serializer/yaml"
 
@@ -60,3 +61,9 @@ func NewCacheStoresFromObjs(objs ...runtime.Object) (CacheStores, error) {
 	}
 	return c, nil
 }
+
+// IsObjectKindSupported tells whether objects of the given GroupVersionKind can be stored in CacheStores.
+func IsObjectKindSupported(gvk schema.GroupVersionKind) bool {
+	_, err := mkObjFromGVK(gvk)
+	return err == nil
+}
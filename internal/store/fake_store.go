@@ -17,6 +17,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	"sigs.k8s.io/yaml"
 
@@ -39,12 +40,15 @@ type FakeObjects struct {
 	GRPCRoutes                     []*gatewayapi.GRPCRoute
 	ReferenceGrants                []*gatewayapi.ReferenceGrant
 	Gateways                       []*gatewayapi.Gateway
+	BackendTLSPolicies             []*gatewayapi.BackendTLSPolicy
 	TCPIngresses                   []*kongv1beta1.TCPIngress
 	UDPIngresses                   []*kongv1beta1.UDPIngress
 	IngressClassParametersV1alpha1 []*kongv1alpha1.IngressClassParameters
 	Services                       []*corev1.Service
 	EndpointSlices                 []*discoveryv1.EndpointSlice
+	Namespaces                     []*corev1.Namespace
 	Secrets                        []*corev1.Secret
+	ConfigMaps                     []*corev1.ConfigMap
 	KongPlugins                    []*kongv1.KongPlugin
 	KongClusterPlugins             []*kongv1.KongClusterPlugin
 	KongIngresses                  []*kongv1.KongIngress
@@ -52,6 +56,7 @@ type FakeObjects struct {
 	KongConsumerGroups             []*kongv1beta1.KongConsumerGroup
 	KongUpstreamPolicies           []*kongv1beta1.KongUpstreamPolicy
 	KongServiceFacades             []*incubatorv1alpha1.KongServiceFacade
+	KongUpstreamTargets            []*incubatorv1alpha1.KongUpstreamTarget
 	KongVaults                     []*kongv1alpha1.KongVault
 	KongCustomEntities             []*kongv1alpha1.KongCustomEntity
 }
@@ -125,6 +130,12 @@ func NewFakeStore(
 			return nil, err
 		}
 	}
+	backendTLSPolicyStore := cache.NewStore(namespacedKeyFunc)
+	for _, p := range objects.BackendTLSPolicies {
+		if err := backendTLSPolicyStore.Add(p); err != nil {
+			return nil, err
+		}
+	}
 	tcpIngressStore := cache.NewStore(namespacedKeyFunc)
 	for _, ingress := range objects.TCPIngresses {
 		err := tcpIngressStore.Add(ingress)
@@ -152,6 +163,18 @@ func NewFakeStore(
 			return nil, err
 		}
 	}
+	namespacesStore := cache.NewStore(clusterWideKeyFunc)
+	for _, ns := range objects.Namespaces {
+		if err := namespacesStore.Add(ns); err != nil {
+			return nil, err
+		}
+	}
+	configMapsStore := cache.NewStore(namespacedKeyFunc)
+	for _, cm := range objects.ConfigMaps {
+		if err := configMapsStore.Add(cm); err != nil {
+			return nil, err
+		}
+	}
 	endpointSliceStore := cache.NewStore(namespacedKeyFunc)
 	for _, e := range objects.EndpointSlices {
 		err := endpointSliceStore.Add(e)
@@ -208,6 +231,12 @@ func NewFakeStore(
 			return nil, err
 		}
 	}
+	kongUpstreamTargetStore := cache.NewStore(namespacedKeyFunc)
+	for _, t := range objects.KongUpstreamTargets {
+		if err := kongUpstreamTargetStore.Add(t); err != nil {
+			return nil, err
+		}
+	}
 	kongVaultStore := cache.NewStore(clusterWideKeyFunc)
 	for _, v := range objects.KongVaults {
 		err := kongVaultStore.Add(v)
@@ -233,11 +262,14 @@ func NewFakeStore(
 			GRPCRoute:                      grpcrouteStore,
 			ReferenceGrant:                 referencegrantStore,
 			Gateway:                        gatewayStore,
+			BackendTLSPolicy:               backendTLSPolicyStore,
 			TCPIngress:                     tcpIngressStore,
 			UDPIngress:                     udpIngressStore,
 			Service:                        serviceStore,
 			EndpointSlice:                  endpointSliceStore,
+			Namespace:                      namespacesStore,
 			Secret:                         secretsStore,
+			ConfigMap:                      configMapsStore,
 			Plugin:                         kongPluginsStore,
 			ClusterPlugin:                  kongClusterPluginsStore,
 			Consumer:                       consumerStore,
@@ -246,6 +278,7 @@ func NewFakeStore(
 			IngressClassParametersV1alpha1: IngressClassParametersV1alpha1Store,
 			KongUpstreamPolicy:             kongUpstreamPolicyStore,
 			KongServiceFacade:              kongServiceFacade,
+			KongUpstreamTarget:             kongUpstreamTargetStore,
 			KongVault:                      kongVaultStore,
 			KongCustomEntity:               kongCustomEntityStore,
 		},
@@ -273,12 +306,15 @@ func (objects FakeObjects) MarshalToYAML() ([]byte, error) {
 		reflect.TypeOf(&gatewayapi.GRPCRoute{}):                gatewayv1.SchemeGroupVersion.WithKind("GRPCRoute"),
 		reflect.TypeOf(&gatewayapi.ReferenceGrant{}):           gatewayv1beta1.SchemeGroupVersion.WithKind("ReferenceGrant"),
 		reflect.TypeOf(&gatewayapi.Gateway{}):                  gatewayv1.SchemeGroupVersion.WithKind("Gateway"),
+		reflect.TypeOf(&gatewayapi.BackendTLSPolicy{}):         gatewayv1alpha3.SchemeGroupVersion.WithKind("BackendTLSPolicy"),
 		reflect.TypeOf(&kongv1beta1.TCPIngress{}):              kongv1beta1.SchemeGroupVersion.WithKind("TCPIngress"),
 		reflect.TypeOf(&kongv1beta1.UDPIngress{}):              kongv1beta1.SchemeGroupVersion.WithKind("UDPIngress"),
 		reflect.TypeOf(&kongv1alpha1.IngressClassParameters{}): kongv1alpha1.SchemeGroupVersion.WithKind("IngressClassParameters"),
 		reflect.TypeOf(&corev1.Service{}):                      corev1.SchemeGroupVersion.WithKind("Service"),
 		reflect.TypeOf(&discoveryv1.EndpointSlice{}):           discoveryv1.SchemeGroupVersion.WithKind("EndpointSlice"),
+		reflect.TypeOf(&corev1.Namespace{}):                    corev1.SchemeGroupVersion.WithKind("Namespace"),
 		reflect.TypeOf(&corev1.Secret{}):                       corev1.SchemeGroupVersion.WithKind("Secret"),
+		reflect.TypeOf(&corev1.ConfigMap{}):                    corev1.SchemeGroupVersion.WithKind("ConfigMap"),
 		reflect.TypeOf(&kongv1.KongPlugin{}):                   kongv1.SchemeGroupVersion.WithKind("KongPlugin"),
 		reflect.TypeOf(&kongv1.KongClusterPlugin{}):            kongv1.SchemeGroupVersion.WithKind("KongClusterPlugin"),
 		reflect.TypeOf(&kongv1.KongIngress{}):                  kongv1.SchemeGroupVersion.WithKind("KongIngress"),
@@ -317,12 +353,15 @@ func (objects FakeObjects) MarshalToYAML() ([]byte, error) {
 	allObjects = append(allObjects, lo.ToAnySlice(objects.GRPCRoutes)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.ReferenceGrants)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.Gateways)...)
+	allObjects = append(allObjects, lo.ToAnySlice(objects.BackendTLSPolicies)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.TCPIngresses)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.UDPIngresses)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.IngressClassParametersV1alpha1)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.Services)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.EndpointSlices)...)
+	allObjects = append(allObjects, lo.ToAnySlice(objects.Namespaces)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.Secrets)...)
+	allObjects = append(allObjects, lo.ToAnySlice(objects.ConfigMaps)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.KongPlugins)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.KongClusterPlugins)...)
 	allObjects = append(allObjects, lo.ToAnySlice(objects.KongIngresses)...)
@@ -34,6 +34,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	"sigs.k8s.io/yaml"
 
@@ -57,8 +58,10 @@ const (
 // about ingresses, services, secrets and ingress annotations.
 type Storer interface {
 	UpdateCache(cs CacheStores)
+	CacheStores() CacheStores
 
 	GetSecret(namespace, name string) (*corev1.Secret, error)
+	GetConfigMap(namespace, name string) (*corev1.ConfigMap, error)
 	GetService(namespace, name string) (*corev1.Service, error)
 	GetEndpointSlicesForService(namespace, name string) ([]*discoveryv1.EndpointSlice, error)
 	GetKongIngress(namespace, name string) (*kongv1.KongIngress, error)
@@ -72,6 +75,7 @@ type Storer interface {
 	GetGateway(namespace string, name string) (*gatewayapi.Gateway, error)
 	GetKongUpstreamPolicy(namespace, name string) (*kongv1beta1.KongUpstreamPolicy, error)
 	GetKongServiceFacade(namespace, name string) (*incubatorv1alpha1.KongServiceFacade, error)
+	GetKongUpstreamTarget(namespace, name string) (*incubatorv1alpha1.KongUpstreamTarget, error)
 	GetKongVault(name string) (*kongv1alpha1.KongVault, error)
 	GetKongCustomEntity(namespace, name string) (*kongv1alpha1.KongCustomEntity, error)
 
@@ -84,6 +88,7 @@ type Storer interface {
 	ListTLSRoutes() ([]*gatewayapi.TLSRoute, error)
 	ListGRPCRoutes() ([]*gatewayapi.GRPCRoute, error)
 	ListReferenceGrants() ([]*gatewayapi.ReferenceGrant, error)
+	ListBackendTLSPolicies() ([]*gatewayapi.BackendTLSPolicy, error)
 	ListGateways() ([]*gatewayapi.Gateway, error)
 	ListTCPIngresses() ([]*kongv1beta1.TCPIngress, error)
 	ListUDPIngresses() ([]*kongv1beta1.UDPIngress, error)
@@ -95,6 +100,7 @@ type Storer interface {
 	ListCACerts() ([]*corev1.Secret, error)
 	ListKongVaults() []*kongv1alpha1.KongVault
 	ListKongCustomEntities() []*kongv1alpha1.KongCustomEntity
+	ListNamespaces() []*corev1.Namespace
 }
 
 // Store implements Storer and can be used to list Ingress, Services
@@ -132,6 +138,11 @@ func (s *Store) UpdateCache(cs CacheStores) {
 	s.stores = cs
 }
 
+// CacheStores returns the cache stores used by the Store.
+func (s Store) CacheStores() CacheStores {
+	return s.stores
+}
+
 // GetSecret returns a Secret using the namespace and name as key.
 func (s Store) GetSecret(namespace, name string) (*corev1.Secret, error) {
 	key := fmt.Sprintf("%v/%v", namespace, name)
@@ -145,6 +156,19 @@ func (s Store) GetSecret(namespace, name string) (*corev1.Secret, error) {
 	return secret.(*corev1.Secret), nil
 }
 
+// GetConfigMap returns a ConfigMap using the namespace and name as key.
+func (s Store) GetConfigMap(namespace, name string) (*corev1.ConfigMap, error) {
+	key := fmt.Sprintf("%v/%v", namespace, name)
+	configMap, exists, err := s.stores.ConfigMap.GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, NotFoundError{fmt.Sprintf("ConfigMap %v not found", key)}
+	}
+	return configMap.(*corev1.ConfigMap), nil
+}
+
 // GetService returns a Service using the namespace and name as key.
 func (s Store) GetService(namespace, name string) (*corev1.Service, error) {
 	key := fmt.Sprintf("%v/%v", namespace, name)
@@ -287,6 +311,8 @@ func GetTypedStore[T client.Object](cs CacheStores) (cache.Store, error) {
 		return cs.ReferenceGrant, nil
 	case *gatewayapi.Gateway:
 		return cs.Gateway, nil
+	case *gatewayapi.BackendTLSPolicy:
+		return cs.BackendTLSPolicy, nil
 	case *kongv1.KongPlugin:
 		return cs.Plugin, nil
 	default:
@@ -324,6 +350,11 @@ func (s Store) ListReferenceGrants() ([]*gatewayapi.ReferenceGrant, error) {
 	return List[*gatewayapi.ReferenceGrant](s.stores)
 }
 
+// ListBackendTLSPolicies returns the list of BackendTLSPolicies in the BackendTLSPolicy cache store.
+func (s Store) ListBackendTLSPolicies() ([]*gatewayapi.BackendTLSPolicy, error) {
+	return List[*gatewayapi.BackendTLSPolicy](s.stores)
+}
+
 // ListGateways returns the list of Gateways in the Gateway cache store.
 func (s Store) ListGateways() ([]*gatewayapi.Gateway, error) {
 	return List[*gatewayapi.Gateway](s.stores)
@@ -502,6 +533,18 @@ func (s Store) GetKongServiceFacade(namespace, name string) (*incubatorv1alpha1.
 	return p.(*incubatorv1alpha1.KongServiceFacade), nil
 }
 
+func (s Store) GetKongUpstreamTarget(namespace, name string) (*incubatorv1alpha1.KongUpstreamTarget, error) {
+	key := fmt.Sprintf("%v/%v", namespace, name)
+	p, exists, err := s.stores.KongUpstreamTarget.GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, NotFoundError{fmt.Sprintf("KongUpstreamTarget %v not found", key)}
+	}
+	return p.(*incubatorv1alpha1.KongUpstreamTarget), nil
+}
+
 // GetIngressClassParametersV1Alpha1 returns IngressClassParameters for provided
 // IngressClass.
 func (s Store) GetIngressClassParametersV1Alpha1(ingressClass *netv1.IngressClass) (*kongv1alpha1.IngressClassParameters, error) {
@@ -705,6 +748,17 @@ func (s Store) ListKongCustomEntities() []*kongv1alpha1.KongCustomEntity {
 	return kongCustomEntities
 }
 
+// ListNamespaces returns the list of Namespaces in the cache.
+func (s Store) ListNamespaces() []*corev1.Namespace {
+	var namespaces []*corev1.Namespace
+	for _, obj := range s.stores.Namespace.List() {
+		if namespace, ok := obj.(*corev1.Namespace); ok {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return namespaces
+}
+
 // getIngressClassHandling returns annotations.ExactOrEmptyClassMatch if an IngressClass is the default class, or
 // annotations.ExactClassMatch if the IngressClass is not default or does not exist.
 func (s Store) getIngressClassHandling() annotations.ClassMatching {
@@ -752,6 +806,8 @@ func mkObjFromGVK(gvk schema.GroupVersionKind) (runtime.Object, error) {
 		return &corev1.Service{}, nil
 	case corev1.SchemeGroupVersion.WithKind("Secret"):
 		return &corev1.Secret{}, nil
+	case corev1.SchemeGroupVersion.WithKind("ConfigMap"):
+		return &corev1.ConfigMap{}, nil
 	// ----------------------------------------------------------------------------
 	// Kubernetes Discovery APIs
 	// ----------------------------------------------------------------------------
@@ -772,6 +828,8 @@ func mkObjFromGVK(gvk schema.GroupVersionKind) (runtime.Object, error) {
 		return &gatewayapi.TLSRoute{}, nil
 	case gatewayv1beta1.SchemeGroupVersion.WithKind("ReferenceGrant"):
 		return &gatewayapi.ReferenceGrant{}, nil
+	case gatewayv1alpha3.SchemeGroupVersion.WithKind("BackendTLSPolicy"):
+		return &gatewayapi.BackendTLSPolicy{}, nil
 	// ----------------------------------------------------------------------------
 	// Kong APIs
 	// ----------------------------------------------------------------------------
@@ -795,6 +853,8 @@ func mkObjFromGVK(gvk schema.GroupVersionKind) (runtime.Object, error) {
 		return &kongv1beta1.KongUpstreamPolicy{}, nil
 	case incubatorv1alpha1.SchemeGroupVersion.WithKind("KongServiceFacade"):
 		return &incubatorv1alpha1.KongServiceFacade{}, nil
+	case incubatorv1alpha1.SchemeGroupVersion.WithKind(incubatorv1alpha1.KongUpstreamTargetKind):
+		return &incubatorv1alpha1.KongUpstreamTarget{}, nil
 	case kongv1alpha1.GroupVersion.WithKind(kongv1alpha1.KongCustomEntityKind):
 		return &kongv1alpha1.KongCustomEntity{}, nil
 	case kongv1alpha1.GroupVersion.WithKind("KongVault"):
@@ -27,7 +27,9 @@ type CacheStores struct {
 	IngressClassV1                 cache.Store
 	Service                        cache.Store
 	Secret                         cache.Store
+	ConfigMap                      cache.Store
 	EndpointSlice                  cache.Store
+	Namespace                      cache.Store
 	HTTPRoute                      cache.Store
 	UDPRoute                       cache.Store
 	TCPRoute                       cache.Store
@@ -35,6 +37,7 @@ type CacheStores struct {
 	GRPCRoute                      cache.Store
 	ReferenceGrant                 cache.Store
 	Gateway                        cache.Store
+	BackendTLSPolicy               cache.Store
 	Plugin                         cache.Store
 	ClusterPlugin                  cache.Store
 	Consumer                       cache.Store
@@ -45,6 +48,7 @@ type CacheStores struct {
 	KongUpstreamPolicy             cache.Store
 	IngressClassParametersV1alpha1 cache.Store
 	KongServiceFacade              cache.Store
+	KongUpstreamTarget             cache.Store
 	KongVault                      cache.Store
 	KongCustomEntity               cache.Store
 
@@ -58,7 +62,9 @@ func NewCacheStores() CacheStores {
 		IngressClassV1:                 cache.NewStore(clusterWideKeyFunc),
 		Service:                        cache.NewStore(namespacedKeyFunc),
 		Secret:                         cache.NewStore(namespacedKeyFunc),
+		ConfigMap:                      cache.NewStore(namespacedKeyFunc),
 		EndpointSlice:                  cache.NewStore(namespacedKeyFunc),
+		Namespace:                      cache.NewStore(clusterWideKeyFunc),
 		HTTPRoute:                      cache.NewStore(namespacedKeyFunc),
 		UDPRoute:                       cache.NewStore(namespacedKeyFunc),
 		TCPRoute:                       cache.NewStore(namespacedKeyFunc),
@@ -66,6 +72,7 @@ func NewCacheStores() CacheStores {
 		GRPCRoute:                      cache.NewStore(namespacedKeyFunc),
 		ReferenceGrant:                 cache.NewStore(namespacedKeyFunc),
 		Gateway:                        cache.NewStore(namespacedKeyFunc),
+		BackendTLSPolicy:               cache.NewStore(namespacedKeyFunc),
 		Plugin:                         cache.NewStore(namespacedKeyFunc),
 		ClusterPlugin:                  cache.NewStore(clusterWideKeyFunc),
 		Consumer:                       cache.NewStore(namespacedKeyFunc),
@@ -76,6 +83,7 @@ func NewCacheStores() CacheStores {
 		KongUpstreamPolicy:             cache.NewStore(namespacedKeyFunc),
 		IngressClassParametersV1alpha1: cache.NewStore(namespacedKeyFunc),
 		KongServiceFacade:              cache.NewStore(namespacedKeyFunc),
+		KongUpstreamTarget:             cache.NewStore(namespacedKeyFunc),
 		KongVault:                      cache.NewStore(clusterWideKeyFunc),
 		KongCustomEntity:               cache.NewStore(namespacedKeyFunc),
 
@@ -97,8 +105,12 @@ func (c CacheStores) Get(obj runtime.Object) (item interface{}, exists bool, err
 		return c.Service.Get(obj)
 	case *corev1.Secret:
 		return c.Secret.Get(obj)
+	case *corev1.ConfigMap:
+		return c.ConfigMap.Get(obj)
 	case *discoveryv1.EndpointSlice:
 		return c.EndpointSlice.Get(obj)
+	case *corev1.Namespace:
+		return c.Namespace.Get(obj)
 	case *gatewayapi.HTTPRoute:
 		return c.HTTPRoute.Get(obj)
 	case *gatewayapi.UDPRoute:
@@ -113,6 +125,8 @@ func (c CacheStores) Get(obj runtime.Object) (item interface{}, exists bool, err
 		return c.ReferenceGrant.Get(obj)
 	case *gatewayapi.Gateway:
 		return c.Gateway.Get(obj)
+	case *gatewayapi.BackendTLSPolicy:
+		return c.BackendTLSPolicy.Get(obj)
 	case *kongv1.KongPlugin:
 		return c.Plugin.Get(obj)
 	case *kongv1.KongClusterPlugin:
@@ -133,6 +147,8 @@ func (c CacheStores) Get(obj runtime.Object) (item interface{}, exists bool, err
 		return c.IngressClassParametersV1alpha1.Get(obj)
 	case *incubatorv1alpha1.KongServiceFacade:
 		return c.KongServiceFacade.Get(obj)
+	case *incubatorv1alpha1.KongUpstreamTarget:
+		return c.KongUpstreamTarget.Get(obj)
 	case *kongv1alpha1.KongVault:
 		return c.KongVault.Get(obj)
 	case *kongv1alpha1.KongCustomEntity:
@@ -156,8 +172,12 @@ func (c CacheStores) Add(obj runtime.Object) error {
 		return c.Service.Add(obj)
 	case *corev1.Secret:
 		return c.Secret.Add(obj)
+	case *corev1.ConfigMap:
+		return c.ConfigMap.Add(obj)
 	case *discoveryv1.EndpointSlice:
 		return c.EndpointSlice.Add(obj)
+	case *corev1.Namespace:
+		return c.Namespace.Add(obj)
 	case *gatewayapi.HTTPRoute:
 		return c.HTTPRoute.Add(obj)
 	case *gatewayapi.UDPRoute:
@@ -172,6 +192,8 @@ func (c CacheStores) Add(obj runtime.Object) error {
 		return c.ReferenceGrant.Add(obj)
 	case *gatewayapi.Gateway:
 		return c.Gateway.Add(obj)
+	case *gatewayapi.BackendTLSPolicy:
+		return c.BackendTLSPolicy.Add(obj)
 	case *kongv1.KongPlugin:
 		return c.Plugin.Add(obj)
 	case *kongv1.KongClusterPlugin:
@@ -192,6 +214,8 @@ func (c CacheStores) Add(obj runtime.Object) error {
 		return c.IngressClassParametersV1alpha1.Add(obj)
 	case *incubatorv1alpha1.KongServiceFacade:
 		return c.KongServiceFacade.Add(obj)
+	case *incubatorv1alpha1.KongUpstreamTarget:
+		return c.KongUpstreamTarget.Add(obj)
 	case *kongv1alpha1.KongVault:
 		return c.KongVault.Add(obj)
 	case *kongv1alpha1.KongCustomEntity:
@@ -215,8 +239,12 @@ func (c CacheStores) Delete(obj runtime.Object) error {
 		return c.Service.Delete(obj)
 	case *corev1.Secret:
 		return c.Secret.Delete(obj)
+	case *corev1.ConfigMap:
+		return c.ConfigMap.Delete(obj)
 	case *discoveryv1.EndpointSlice:
 		return c.EndpointSlice.Delete(obj)
+	case *corev1.Namespace:
+		return c.Namespace.Delete(obj)
 	case *gatewayapi.HTTPRoute:
 		return c.HTTPRoute.Delete(obj)
 	case *gatewayapi.UDPRoute:
@@ -231,6 +259,8 @@ func (c CacheStores) Delete(obj runtime.Object) error {
 		return c.ReferenceGrant.Delete(obj)
 	case *gatewayapi.Gateway:
 		return c.Gateway.Delete(obj)
+	case *gatewayapi.BackendTLSPolicy:
+		return c.BackendTLSPolicy.Delete(obj)
 	case *kongv1.KongPlugin:
 		return c.Plugin.Delete(obj)
 	case *kongv1.KongClusterPlugin:
@@ -251,6 +281,8 @@ func (c CacheStores) Delete(obj runtime.Object) error {
 		return c.IngressClassParametersV1alpha1.Delete(obj)
 	case *incubatorv1alpha1.KongServiceFacade:
 		return c.KongServiceFacade.Delete(obj)
+	case *incubatorv1alpha1.KongUpstreamTarget:
+		return c.KongUpstreamTarget.Delete(obj)
 	case *kongv1alpha1.KongVault:
 		return c.KongVault.Delete(obj)
 	case *kongv1alpha1.KongCustomEntity:
@@ -266,7 +298,9 @@ func (c CacheStores) ListAllStores() []cache.Store {
 		c.IngressClassV1,
 		c.Service,
 		c.Secret,
+		c.ConfigMap,
 		c.EndpointSlice,
+		c.Namespace,
 		c.HTTPRoute,
 		c.UDPRoute,
 		c.TCPRoute,
@@ -274,6 +308,7 @@ func (c CacheStores) ListAllStores() []cache.Store {
 		c.GRPCRoute,
 		c.ReferenceGrant,
 		c.Gateway,
+		c.BackendTLSPolicy,
 		c.Plugin,
 		c.ClusterPlugin,
 		c.Consumer,
@@ -284,6 +319,7 @@ func (c CacheStores) ListAllStores() []cache.Store {
 		c.KongUpstreamPolicy,
 		c.IngressClassParametersV1alpha1,
 		c.KongServiceFacade,
+		c.KongUpstreamTarget,
 		c.KongVault,
 		c.KongCustomEntity,
 	}
@@ -296,7 +332,9 @@ func (c CacheStores) SupportedTypes() []client.Object {
 		&netv1.IngressClass{},
 		&corev1.Service{},
 		&corev1.Secret{},
+		&corev1.ConfigMap{},
 		&discoveryv1.EndpointSlice{},
+		&corev1.Namespace{},
 		&gatewayapi.HTTPRoute{},
 		&gatewayapi.UDPRoute{},
 		&gatewayapi.TCPRoute{},
@@ -304,6 +342,7 @@ func (c CacheStores) SupportedTypes() []client.Object {
 		&gatewayapi.GRPCRoute{},
 		&gatewayapi.ReferenceGrant{},
 		&gatewayapi.Gateway{},
+		&gatewayapi.BackendTLSPolicy{},
 		&kongv1.KongPlugin{},
 		&kongv1.KongClusterPlugin{},
 		&kongv1.KongConsumer{},
@@ -314,6 +353,7 @@ func (c CacheStores) SupportedTypes() []client.Object {
 		&kongv1beta1.KongUpstreamPolicy{},
 		&kongv1alpha1.IngressClassParameters{},
 		&incubatorv1alpha1.KongServiceFacade{},
+		&incubatorv1alpha1.KongUpstreamTarget{},
 		&kongv1alpha1.KongVault{},
 		&kongv1alpha1.KongCustomEntity{},
 	}
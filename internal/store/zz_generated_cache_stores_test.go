@@ -45,11 +45,21 @@ func TestCacheStores(t *testing.T) {
 			objectToStore: &corev1.Secret{},
 		},
 
+		{
+			name:          "ConfigMap",
+			objectToStore: &corev1.ConfigMap{},
+		},
+
 		{
 			name:          "EndpointSlice",
 			objectToStore: &discoveryv1.EndpointSlice{},
 		},
 
+		{
+			name:          "Namespace",
+			objectToStore: &corev1.Namespace{},
+		},
+
 		{
 			name:          "HTTPRoute",
 			objectToStore: &gatewayapi.HTTPRoute{},
@@ -85,6 +95,11 @@ func TestCacheStores(t *testing.T) {
 			objectToStore: &gatewayapi.Gateway{},
 		},
 
+		{
+			name:          "BackendTLSPolicy",
+			objectToStore: &gatewayapi.BackendTLSPolicy{},
+		},
+
 		{
 			name:          "KongPlugin",
 			objectToStore: &kongv1.KongPlugin{},
@@ -135,6 +150,11 @@ func TestCacheStores(t *testing.T) {
 			objectToStore: &incubatorv1alpha1.KongServiceFacade{},
 		},
 
+		{
+			name:          "KongUpstreamTarget",
+			objectToStore: &incubatorv1alpha1.KongUpstreamTarget{},
+		},
+
 		{
 			name:          "KongVault",
 			objectToStore: &kongv1alpha1.KongVault{},
@@ -2,6 +2,7 @@ package util
 
 import (
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -17,3 +18,22 @@ func ExtractKongCredentialType(secret *corev1.Secret) (string, error) {
 	}
 	return credType, nil
 }
+
+const (
+	vaultReferencePrefix = "{vault://"
+	vaultReferenceSuffix = "}"
+)
+
+// ParseVaultReference checks whether the value is a Kong vault reference ({vault://<prefix>/<path>}).
+// For a reference, it returns the prefix of the referenced vault or an error if the reference is malformed.
+func ParseVaultReference(value string) (prefix string, isReference bool, err error) {
+	if !strings.HasPrefix(value, vaultReferencePrefix) || !strings.HasSuffix(value, vaultReferenceSuffix) {
+		return "", false, nil
+	}
+	reference := strings.TrimSuffix(strings.TrimPrefix(value, vaultReferencePrefix), vaultReferenceSuffix)
+	prefix, path, found := strings.Cut(reference, "/")
+	if !found || prefix == "" || path == "" {
+		return "", true, fmt.Errorf("malformed vault reference %q, expected {vault://<prefix>/<path>}", value)
+	}
+	return prefix, true, nil
+}
@@ -58,3 +58,31 @@ func TestExtractKongCredentialType(t *testing.T) {
 		})
 	}
 }
+
+func TestParseVaultReference(t *testing.T) {
+	tests := []struct {
+		value           string
+		wantPrefix      string
+		wantIsReference bool
+		wantErr         bool
+	}{
+		{value: "little-rabbits-be-good"},
+		{value: "vault://env/secret"},
+		{value: "{vault://env/secret}", wantPrefix: "env", wantIsReference: true},
+		{value: "{vault://aws-secrets/consumers/alice/key}", wantPrefix: "aws-secrets", wantIsReference: true},
+		{value: "{vault://env}", wantIsReference: true, wantErr: true},
+		{value: "{vault:///secret}", wantIsReference: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			prefix, isReference, err := ParseVaultReference(tt.value)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantPrefix, prefix)
+			require.Equal(t, tt.wantIsReference, isReference)
+		})
+	}
+}
@@ -32,6 +32,7 @@ import (
 
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/annotations"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
+	incubatorv1alpha1 "github.com/kong/kubernetes-ingress-controller/v3/pkg/apis/incubator/v1alpha1"
 )
 
 // ParseNameNS parses a string searching a namespace and name.
@@ -124,11 +125,12 @@ func GetPodDetails(ctx context.Context, kubeClient clientset.Interface) (*PodInf
 	}, nil
 }
 
-// map of all the supported Group/Kinds for the backend. At the moment, only
-// core services are supported, but to provide support to other kinds, it is
-// enough to add entries to this map.
+// map of all the supported Group/Kinds for the backend. To provide support to
+// other kinds, it is enough to add entries to this map.
 var backendRefSupportedGroupKinds = map[string]struct{}{
 	"core/Service": {},
+	incubatorv1alpha1.GroupVersion.Group + "/" + incubatorv1alpha1.KongServiceFacadeKind:  {},
+	incubatorv1alpha1.GroupVersion.Group + "/" + incubatorv1alpha1.KongUpstreamTargetKind: {},
 }
 
 // IsBackendRefGroupKindSupported checks if the GroupKind of the object used as
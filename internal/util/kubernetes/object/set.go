@@ -26,6 +26,9 @@ const (
 // (succeeded, failed, unknown) of kubernetes objects.
 type ConfigurationStatusSet struct {
 	store map[gvk]map[k8stypes.NamespacedName]objectConfigurationStatus
+
+	// configHash is the hash of the configuration the statuses were reported for.
+	configHash string
 }
 
 func NewConfigurationStatusSet() *ConfigurationStatusSet {
@@ -86,3 +89,13 @@ func (s *ConfigurationStatusSet) Get(obj client.Object) ConfigurationStatus {
 
 	return ConfigurationStatusSucceeded
 }
+
+// SetConfigHash sets the hash of the configuration the statuses in the set were reported for.
+func (s *ConfigurationStatusSet) SetConfigHash(hash string) {
+	s.configHash = hash
+}
+
+// ConfigHash returns the hash of the configuration the statuses in the set were reported for.
+func (s *ConfigurationStatusSet) ConfigHash() string {
+	return s.configHash
+}
@@ -58,8 +58,8 @@ type KongPlugin struct {
 	// +kubebuilder:validation:Type=object
 	Config apiextensionsv1.JSON `json:"config,omitempty"`
 
-	// ConfigFrom references a secret containing the plugin configuration.
-	// This should be used when the plugin configuration contains sensitive information,
+	// ConfigFrom references a secret or a ConfigMap containing the plugin configuration.
+	// A secret should be used when the plugin configuration contains sensitive information,
 	// such as AWS credentials in the Lambda plugin or the client secret in the OIDC plugin.
 	// Only one of `config` or `configFrom` may be used in a KongPlugin, not both at once.
 	ConfigFrom *ConfigSource `json:"configFrom,omitempty"`
@@ -67,7 +67,7 @@ type KongPlugin struct {
 	// ConfigPatches represents JSON patches to the configuration of the plugin.
 	// Each item means a JSON patch to add something in the configuration,
 	// where path is specified in `path` and value is in `valueFrom` referencing
-	// a key in a secret.
+	// a key in a secret or a ConfigMap.
 	// When Config is specified, patches will be applied to the configuration in Config.
 	// Otherwise, patches will be applied to an empty object.
 	ConfigPatches []ConfigPatch `json:"configPatches,omitempty"`
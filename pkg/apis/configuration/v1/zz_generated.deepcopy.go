@@ -26,10 +26,25 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapValueFromSource) DeepCopyInto(out *ConfigMapValueFromSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapValueFromSource.
+func (in *ConfigMapValueFromSource) DeepCopy() *ConfigMapValueFromSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapValueFromSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigPatch) DeepCopyInto(out *ConfigPatch) {
 	*out = *in
-	out.ValueFrom = in.ValueFrom
+	in.ValueFrom.DeepCopyInto(&out.ValueFrom)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigPatch.
@@ -46,6 +61,11 @@ func (in *ConfigPatch) DeepCopy() *ConfigPatch {
 func (in *ConfigSource) DeepCopyInto(out *ConfigSource) {
 	*out = *in
 	out.SecretValue = in.SecretValue
+	if in.ConfigMapValue != nil {
+		in, out := &in.ConfigMapValue, &out.ConfigMapValue
+		*out = new(ConfigMapValueFromSource)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigSource.
@@ -67,12 +87,14 @@ func (in *KongClusterPlugin) DeepCopyInto(out *KongClusterPlugin) {
 	if in.ConfigFrom != nil {
 		in, out := &in.ConfigFrom, &out.ConfigFrom
 		*out = new(NamespacedConfigSource)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ConfigPatches != nil {
 		in, out := &in.ConfigPatches, &out.ConfigPatches
 		*out = make([]NamespacedConfigPatch, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Protocols != nil {
 		in, out := &in.Protocols, &out.Protocols
@@ -559,12 +581,14 @@ func (in *KongPlugin) DeepCopyInto(out *KongPlugin) {
 	if in.ConfigFrom != nil {
 		in, out := &in.ConfigFrom, &out.ConfigFrom
 		*out = new(ConfigSource)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ConfigPatches != nil {
 		in, out := &in.ConfigPatches, &out.ConfigPatches
 		*out = make([]ConfigPatch, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Protocols != nil {
 		in, out := &in.Protocols, &out.Protocols
@@ -651,10 +675,25 @@ func (in *KongPluginStatus) DeepCopy() *KongPluginStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedConfigMapValueFromSource) DeepCopyInto(out *NamespacedConfigMapValueFromSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedConfigMapValueFromSource.
+func (in *NamespacedConfigMapValueFromSource) DeepCopy() *NamespacedConfigMapValueFromSource {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedConfigMapValueFromSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespacedConfigPatch) DeepCopyInto(out *NamespacedConfigPatch) {
 	*out = *in
-	out.ValueFrom = in.ValueFrom
+	in.ValueFrom.DeepCopyInto(&out.ValueFrom)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedConfigPatch.
@@ -671,6 +710,11 @@ func (in *NamespacedConfigPatch) DeepCopy() *NamespacedConfigPatch {
 func (in *NamespacedConfigSource) DeepCopyInto(out *NamespacedConfigSource) {
 	*out = *in
 	out.SecretValue = in.SecretValue
+	if in.ConfigMapValue != nil {
+		in, out := &in.ConfigMapValue, &out.ConfigMapValue
+		*out = new(NamespacedConfigMapValueFromSource)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedConfigSource.
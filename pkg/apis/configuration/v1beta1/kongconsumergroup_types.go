@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -34,6 +35,9 @@ type KongConsumerGroup struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
+	// Spec is the specification of the KongConsumerGroup.
+	Spec KongConsumerGroupSpec `json:"spec,omitempty"`
+
 	// Status represents the current status of the KongConsumerGroup resource.
 	Status KongConsumerGroupStatus `json:"status,omitempty"`
 }
@@ -47,6 +51,41 @@ type KongConsumerGroupList struct {
 	Items           []KongConsumerGroup `json:"items"`
 }
 
+// KongConsumerGroupSpec defines the specification of the KongConsumerGroup.
+type KongConsumerGroupSpec struct {
+	// Name is the name of the ConsumerGroup in Kong.
+	// When not set, the name of the KongConsumerGroup object is used.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name,omitempty"`
+
+	// Tags is an optional set of tags applied to the ConsumerGroup in Kong,
+	// in addition to the tags generated by the controller.
+	// +optional
+	// +kubebuilder:validation:MaxItems=20
+	// +kubebuilder:validation:items:MaxLength=128
+	Tags []string `json:"tags,omitempty"`
+
+	// Plugins is a list of plugins scoped to the ConsumerGroup. Each of them is configured in Kong
+	// for the consumers of the group only, overriding the configuration of the same plugin applied
+	// more broadly (e.g. per-group limits of rate-limiting-advanced).
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Plugins []KongConsumerGroupPlugin `json:"plugins,omitempty"`
+}
+
+// KongConsumerGroupPlugin is a plugin configuration scoped to a KongConsumerGroup.
+type KongConsumerGroupPlugin struct {
+	// Name is the name of the plugin in Kong (e.g. rate-limiting-advanced).
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Config is the configuration of the plugin for the consumers of the group.
+	// Its schema varies for different plugins.
+	Config apiextensionsv1.JSON `json:"config,omitempty"`
+}
+
 // KongConsumerGroupStatus represents the current status of the KongConsumerGroup resource.
 type KongConsumerGroupStatus struct {
 	// Conditions describe the current conditions of the KongConsumerGroup.
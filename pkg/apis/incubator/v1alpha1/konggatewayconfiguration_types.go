@@ -0,0 +1,92 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// KongGatewayConfigurationKind is the string value representing the KongGatewayConfiguration kind in Kubernetes.
+	KongGatewayConfigurationKind = "KongGatewayConfiguration"
+)
+
+func init() {
+	SchemeBuilder.Register(&KongGatewayConfiguration{}, &KongGatewayConfigurationList{})
+}
+
+// KongGatewayConfiguration defines how Kong Gateway data planes should be provisioned for
+// Gateways of a GatewayClass referring to it via its `spec.parametersRef` field.
+// For every such Gateway, the controller creates a Deployment running Kong Gateway and
+// a Service exposing it, both of which are removed along with the Gateway.
+//
+// +genclient
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories=kong-ingress-controller
+// +kubebuilder:storageversion
+type KongGatewayConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              KongGatewayConfigurationSpec `json:"spec,omitempty"`
+}
+
+// KongGatewayConfigurationList contains a list of KongGatewayConfiguration.
+// +kubebuilder:object:root=true
+type KongGatewayConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KongGatewayConfiguration `json:"items"`
+}
+
+// KongGatewayConfigurationSpec defines the desired state of KongGatewayConfiguration.
+type KongGatewayConfigurationSpec struct {
+	// DataPlane holds the configuration of the Kong Gateway Deployment provisioned for a Gateway.
+	// +optional
+	DataPlane KongGatewayDataPlaneOptions `json:"dataPlane,omitempty"`
+
+	// Service holds the configuration of the Service exposing the provisioned Kong Gateway.
+	// +optional
+	Service KongGatewayServiceOptions `json:"service,omitempty"`
+}
+
+// KongGatewayDataPlaneOptions defines the configuration of a provisioned Kong Gateway Deployment.
+type KongGatewayDataPlaneOptions struct {
+	// Image is the Kong Gateway container image.
+	// +optional
+	// +kubebuilder:default="kong:3.7"
+	Image string `json:"image,omitempty"`
+
+	// Replicas is the number of Kong Gateway replicas.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// PodLabels are additional labels set on Kong Gateway Pods. They can be used to make the Pods
+	// selected by the Admin API Service the controller discovers Kong Gateways with (`--kong-admin-svc`),
+	// so that they receive the configuration.
+	// +optional
+	PodLabels map[string]string `json:"podLabels,omitempty"`
+
+	// Env is a list of additional environment variables set in the Kong Gateway container.
+	// They take precedence over the variables set by the controller.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Resources are the compute resources required by the Kong Gateway container.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// KongGatewayServiceOptions defines the configuration of a Service exposing a provisioned Kong Gateway.
+type KongGatewayServiceOptions struct {
+	// Type is the type of the Service.
+	// +optional
+	// +kubebuilder:default=LoadBalancer
+	// +kubebuilder:validation:Enum=ClusterIP;NodePort;LoadBalancer
+	Type corev1.ServiceType `json:"type,omitempty"`
+
+	// Annotations are annotations set on the Service.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
@@ -0,0 +1,88 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// KongUpstreamTargetKind is the string value representing the KongUpstreamTarget kind in Kubernetes.
+	KongUpstreamTargetKind = "KongUpstreamTarget"
+)
+
+func init() {
+	SchemeBuilder.Register(&KongUpstreamTarget{}, &KongUpstreamTargetList{})
+}
+
+// KongUpstreamTarget defines a static set of endpoints that can be used as a backend
+// of Gateway API routes (via their `backendRefs`). It's designed to enable routing
+// traffic to backends that live outside the Kubernetes cluster without the need to
+// create a Kubernetes Service of type ExternalName. Every endpoint is translated to
+// a Kong Upstream Target.
+//
+// KongUpstreamTarget requires `kubernetes.io/ingress.class` annotation with a value
+// matching the ingressClass of the Kong Ingress Controller (`kong` by default) to be reconciled.
+//
+// +genclient
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories=kong-ingress-controller
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+type KongUpstreamTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              KongUpstreamTargetSpec   `json:"spec"`
+	Status            KongUpstreamTargetStatus `json:"status,omitempty"`
+}
+
+// KongUpstreamTargetList contains a list of KongUpstreamTarget.
+// +kubebuilder:object:root=true
+type KongUpstreamTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KongUpstreamTarget `json:"items"`
+}
+
+// KongUpstreamTargetSpec defines the desired state of KongUpstreamTarget.
+type KongUpstreamTargetSpec struct {
+	// Targets is a list of static endpoints traffic should be load balanced across.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Targets []KongUpstreamTargetEndpoint `json:"targets"`
+}
+
+// KongUpstreamTargetEndpoint is a single static endpoint of a KongUpstreamTarget.
+type KongUpstreamTargetEndpoint struct {
+	// Host is a hostname or an IP address of the endpoint.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+
+	// Port is the port of the endpoint.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// Weight is the weight of the endpoint used for load balancing. Defaults to 100.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// KongUpstreamTargetStatus defines the observed state of KongUpstreamTarget.
+type KongUpstreamTargetStatus struct {
+	// Conditions describe the current conditions of the KongUpstreamTarget.
+	//
+	// Known condition types are:
+	//
+	// * "Programmed"
+	//
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:default={{type: "Programmed", status: "Unknown", reason:"Pending", message:"Waiting for controller", lastTransitionTime: "1970-01-01T00:00:00Z"}}
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
@@ -21,10 +21,147 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongGatewayConfiguration) DeepCopyInto(out *KongGatewayConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongGatewayConfiguration.
+func (in *KongGatewayConfiguration) DeepCopy() *KongGatewayConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KongGatewayConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KongGatewayConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongGatewayConfigurationList) DeepCopyInto(out *KongGatewayConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KongGatewayConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongGatewayConfigurationList.
+func (in *KongGatewayConfigurationList) DeepCopy() *KongGatewayConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(KongGatewayConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KongGatewayConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongGatewayConfigurationSpec) DeepCopyInto(out *KongGatewayConfigurationSpec) {
+	*out = *in
+	in.DataPlane.DeepCopyInto(&out.DataPlane)
+	in.Service.DeepCopyInto(&out.Service)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongGatewayConfigurationSpec.
+func (in *KongGatewayConfigurationSpec) DeepCopy() *KongGatewayConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KongGatewayConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongGatewayDataPlaneOptions) DeepCopyInto(out *KongGatewayDataPlaneOptions) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PodLabels != nil {
+		in, out := &in.PodLabels, &out.PodLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongGatewayDataPlaneOptions.
+func (in *KongGatewayDataPlaneOptions) DeepCopy() *KongGatewayDataPlaneOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(KongGatewayDataPlaneOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongGatewayServiceOptions) DeepCopyInto(out *KongGatewayServiceOptions) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongGatewayServiceOptions.
+func (in *KongGatewayServiceOptions) DeepCopy() *KongGatewayServiceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(KongGatewayServiceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KongServiceFacade) DeepCopyInto(out *KongServiceFacade) {
 	*out = *in
@@ -136,3 +273,126 @@ func (in *KongServiceFacadeStatus) DeepCopy() *KongServiceFacadeStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongUpstreamTarget) DeepCopyInto(out *KongUpstreamTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongUpstreamTarget.
+func (in *KongUpstreamTarget) DeepCopy() *KongUpstreamTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(KongUpstreamTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KongUpstreamTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongUpstreamTargetEndpoint) DeepCopyInto(out *KongUpstreamTargetEndpoint) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongUpstreamTargetEndpoint.
+func (in *KongUpstreamTargetEndpoint) DeepCopy() *KongUpstreamTargetEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(KongUpstreamTargetEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongUpstreamTargetList) DeepCopyInto(out *KongUpstreamTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KongUpstreamTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongUpstreamTargetList.
+func (in *KongUpstreamTargetList) DeepCopy() *KongUpstreamTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(KongUpstreamTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KongUpstreamTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongUpstreamTargetSpec) DeepCopyInto(out *KongUpstreamTargetSpec) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]KongUpstreamTargetEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongUpstreamTargetSpec.
+func (in *KongUpstreamTargetSpec) DeepCopy() *KongUpstreamTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KongUpstreamTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KongUpstreamTargetStatus) DeepCopyInto(out *KongUpstreamTargetStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongUpstreamTargetStatus.
+func (in *KongUpstreamTargetStatus) DeepCopy() *KongUpstreamTargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KongUpstreamTargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -28,10 +28,18 @@ type FakeIncubatorV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeIncubatorV1alpha1) KongGatewayConfigurations(namespace string) v1alpha1.KongGatewayConfigurationInterface {
+	return &FakeKongGatewayConfigurations{c, namespace}
+}
+
 func (c *FakeIncubatorV1alpha1) KongServiceFacades(namespace string) v1alpha1.KongServiceFacadeInterface {
 	return &FakeKongServiceFacades{c, namespace}
 }
 
+func (c *FakeIncubatorV1alpha1) KongUpstreamTargets(namespace string) v1alpha1.KongUpstreamTargetInterface {
+	return &FakeKongUpstreamTargets{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeIncubatorV1alpha1) RESTClient() rest.Interface {
@@ -18,4 +18,8 @@ limitations under the License.
 
 package v1alpha1
 
+type KongGatewayConfigurationExpansion interface{}
+
 type KongServiceFacadeExpansion interface{}
+
+type KongUpstreamTargetExpansion interface{}
@@ -28,7 +28,9 @@ import (
 
 type IncubatorV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	KongGatewayConfigurationsGetter
 	KongServiceFacadesGetter
+	KongUpstreamTargetsGetter
 }
 
 // IncubatorV1alpha1Client is used to interact with features provided by the incubator.ingress-controller.konghq.com group.
@@ -36,10 +38,18 @@ type IncubatorV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *IncubatorV1alpha1Client) KongGatewayConfigurations(namespace string) KongGatewayConfigurationInterface {
+	return newKongGatewayConfigurations(c, namespace)
+}
+
 func (c *IncubatorV1alpha1Client) KongServiceFacades(namespace string) KongServiceFacadeInterface {
 	return newKongServiceFacades(c, namespace)
 }
 
+func (c *IncubatorV1alpha1Client) KongUpstreamTargets(namespace string) KongUpstreamTargetInterface {
+	return newKongUpstreamTargets(c, namespace)
+}
+
 // NewForConfig creates a new IncubatorV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -7,6 +7,7 @@ import (
 	"path"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/gateway-api/conformance"
@@ -16,7 +17,9 @@ import (
 	"sigs.k8s.io/gateway-api/pkg/features"
 	"sigs.k8s.io/yaml"
 
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/controllers/gateway"
 	dpconf "github.com/kong/kubernetes-ingress-controller/v3/internal/dataplane/config"
+	"github.com/kong/kubernetes-ingress-controller/v3/internal/gatewayapi"
 	"github.com/kong/kubernetes-ingress-controller/v3/internal/manager/metadata"
 	"github.com/kong/kubernetes-ingress-controller/v3/test/internal/testenv"
 )
@@ -41,34 +44,25 @@ var skippedTestsForExpressionRoutes = []string{
 	tests.GRPCRouteListenerHostnameMatching.ShortName,
 }
 
-var traditionalRoutesSupportedFeatures = []features.SupportedFeature{
-	// core features
-	features.SupportGateway,
-	features.SupportHTTPRoute,
-	features.SupportGRPCRoute,
-	// extended features
-	features.SupportHTTPRouteResponseHeaderModification,
-	features.SupportHTTPRoutePathRewrite,
-	features.SupportHTTPRouteHostRewrite,
-	// TODO: https://github.com/Kong/kubernetes-ingress-controller/issues/5868
-	// Temporarily disabled and tracking through the following issue.
-	// suite.SupportHTTPRouteBackendTimeout,
+// conformanceSupportedFeaturesOptions are the options the supported features are computed with for the conformance
+// tests. Gateway API alpha kinds are left out as only the HTTP and GRPC conformance profiles are run.
+//
+// TODO: https://github.com/Kong/kubernetes-ingress-controller/issues/5868
+// HTTPRouteBackendTimeout is temporarily not supported and tracked through the issue above.
+var conformanceSupportedFeaturesOptions = gateway.SupportedFeaturesOptions{
+	HTTPRouteEnabled:      true,
+	GRPCRouteEnabled:      true,
+	ReferenceGrantEnabled: true,
 }
 
-var expressionRoutesSupportedFeatures = []features.SupportedFeature{
-	// core features
-	features.SupportGateway,
-	features.SupportHTTPRoute,
-	features.SupportGRPCRoute,
-	// extended features
-	features.SupportHTTPRouteQueryParamMatching,
-	features.SupportHTTPRouteMethodMatching,
-	features.SupportHTTPRouteResponseHeaderModification,
-	features.SupportHTTPRoutePathRewrite,
-	features.SupportHTTPRouteHostRewrite,
-	// TODO: https://github.com/Kong/kubernetes-ingress-controller/issues/5868
-	// Temporarily disabled and tracking through the following issue.
-	// features.SupportHTTPRouteBackendTimeout,
+// conformanceSupportedFeatures returns the Gateway API features the controller reports in GatewayClass status for the given
+// router flavor, so that the conformance tests and the reported features can't drift apart.
+func conformanceSupportedFeatures(expressionRoutes bool) []features.SupportedFeature {
+	opts := conformanceSupportedFeaturesOptions
+	opts.TranslatorFeatures.ExpressionRoutes = expressionRoutes
+	return lo.Map(gateway.GetSupportedFeatures(opts), func(f gatewayapi.SupportedFeature, _ int) features.SupportedFeature {
+		return features.SupportedFeature(f)
+	})
 }
 
 func TestGatewayConformance(t *testing.T) {
@@ -84,11 +78,11 @@ func TestGatewayConformance(t *testing.T) {
 	switch rf := testenv.KongRouterFlavor(); rf {
 	case dpconf.RouterFlavorTraditionalCompatible:
 		skippedTests = skippedTestsForTraditionalRoutes
-		supportedFeatures = traditionalRoutesSupportedFeatures
+		supportedFeatures = conformanceSupportedFeatures(false)
 		mode = string(dpconf.RouterFlavorTraditionalCompatible)
 	case dpconf.RouterFlavorExpressions:
 		skippedTests = skippedTestsForExpressionRoutes
-		supportedFeatures = expressionRoutesSupportedFeatures
+		supportedFeatures = conformanceSupportedFeatures(true)
 		mode = string(dpconf.RouterFlavorExpressions)
 	default:
 		t.Fatalf("unsupported KongRouterFlavor: %s", rf)
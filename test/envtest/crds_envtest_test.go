@@ -518,6 +518,52 @@ func TestCRDValidations(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "KongPlugin - with configFrom referencing a ConfigMap is allowed",
+			scenario: func(ctx context.Context, t *testing.T, ns string) {
+				err := createKongPlugin(ctx, ctrlClient, ns, &kongv1.KongPlugin{
+					PluginName: "key-auth",
+					ConfigFrom: &kongv1.ConfigSource{
+						ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+							ConfigMap: "configmap-name",
+							Key:       "key-name",
+						},
+					},
+				})
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "KongPlugin - with configFrom referencing both a Secret and a ConfigMap is rejected",
+			scenario: func(ctx context.Context, t *testing.T, ns string) {
+				err := createKongPlugin(ctx, ctrlClient, ns, &kongv1.KongPlugin{
+					PluginName: "key-auth",
+					ConfigFrom: &kongv1.ConfigSource{
+						SecretValue: kongv1.SecretValueFromSource{
+							Secret: "secret-name",
+							Key:    "key-name",
+						},
+						ConfigMapValue: &kongv1.ConfigMapValueFromSource{
+							ConfigMap: "configmap-name",
+							Key:       "key-name",
+						},
+					},
+				})
+				assert.Error(t, err)
+				assert.ErrorContains(t, err, "Exactly one of secretKeyRef or configMapKeyRef must be set.")
+			},
+		},
+		{
+			name: "KongPlugin - with configFrom referencing neither a Secret nor a ConfigMap is rejected",
+			scenario: func(ctx context.Context, t *testing.T, ns string) {
+				err := createKongPlugin(ctx, ctrlClient, ns, &kongv1.KongPlugin{
+					PluginName: "key-auth",
+					ConfigFrom: &kongv1.ConfigSource{},
+				})
+				assert.Error(t, err)
+				assert.ErrorContains(t, err, "Exactly one of secretKeyRef or configMapKeyRef must be set.")
+			},
+		},
 		{
 			name: "KongPlugin - with configFrom and config is rejected",
 			scenario: func(ctx context.Context, t *testing.T, ns string) {
@@ -658,6 +704,33 @@ func TestCRDValidations(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "KongClusterPlugin - with configPatches referencing both a Secret and a ConfigMap is rejected",
+			scenario: func(ctx context.Context, t *testing.T, ns string) {
+				err := createKongClusterPlugin(ctx, ctrlClient, ns, &kongv1.KongClusterPlugin{
+					PluginName: "key-auth",
+					ConfigPatches: []kongv1.NamespacedConfigPatch{
+						{
+							Path: "/key_names",
+							ValueFrom: kongv1.NamespacedConfigSource{
+								SecretValue: kongv1.NamespacedSecretValueFromSource{
+									Secret:    "secret-name",
+									Key:       "key-name",
+									Namespace: "ns",
+								},
+								ConfigMapValue: &kongv1.NamespacedConfigMapValueFromSource{
+									ConfigMap: "configmap-name",
+									Key:       "key-name",
+									Namespace: "ns",
+								},
+							},
+						},
+					},
+				})
+				assert.Error(t, err)
+				assert.ErrorContains(t, err, "Exactly one of secretKeyRef or configMapKeyRef must be set.")
+			},
+		},
 		{
 			name: "KongClusterPlugin - with configFrom and config is rejected",
 			scenario: func(ctx context.Context, t *testing.T, ns string) {
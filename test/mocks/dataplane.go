@@ -16,6 +16,8 @@ type Dataplane struct {
 	// https://github.com/Kong/kubernetes-ingress-controller/issues/3793
 	// which requires the status to be reported for route objects.
 	ObjectsStatuses map[string]map[string]k8sobj.ConfigurationStatus
+	// ConfigurationHash is the hash of the configuration the statuses were reported for.
+	ConfigurationHash string
 }
 
 func (d Dataplane) UpdateObject(_ client.Object) error {
@@ -46,3 +48,7 @@ func (d Dataplane) KubernetesObjectConfigurationStatus(obj client.Object) k8sobj
 func (d Dataplane) KubernetesObjectIsConfigured(obj client.Object) bool {
 	return d.ObjectsStatuses[obj.GetNamespace()][obj.GetName()] == k8sobj.ConfigurationStatusSucceeded
 }
+
+func (d Dataplane) KubernetesObjectsConfigurationHash() string {
+	return d.ConfigurationHash
+}